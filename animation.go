@@ -0,0 +1,197 @@
+package mst
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+// ==================== ANIMATION FRAMES ====================
+
+// Frame is the state of an algorithm run after one trace step
+type Frame struct {
+	Event TraceEvent
+	Tree  []*Edge // edges accepted so far
+
+	inTree map[edgeKey]bool
+}
+
+// FrameFormat selects the output format of exported frames
+type FrameFormat string
+
+const (
+	// FrameDOT renders frames as Graphviz DOT files
+	FrameDOT FrameFormat = "dot"
+	// FrameSVG renders frames as standalone SVG images with a circular layout
+	FrameSVG FrameFormat = "svg"
+)
+
+// BuildFrames replays trace events into one frame per step
+func (g *Graph) BuildFrames(events []TraceEvent) []Frame {
+	frames := make([]Frame, 0, len(events))
+	var tree []*Edge
+	inTree := make(map[edgeKey]bool)
+	for _, ev := range events {
+		if ev.Kind == EventEdgeAccepted {
+			// Frames share the tree prefix, so copy the set before extending it
+			next := make(map[edgeKey]bool, len(inTree)+1)
+			for k := range inTree {
+				next[k] = true
+			}
+			next[g.eventKey(ev)] = true
+			inTree = next
+			tree = append(tree[:len(tree):len(tree)], ev.Edge)
+		}
+		frames = append(frames, Frame{Event: ev, Tree: tree, inTree: inTree})
+	}
+	return frames
+}
+
+// eventKey returns the edge key of an edge event
+func (g *Graph) eventKey(ev TraceEvent) edgeKey {
+	from, to := ev.From, ev.To
+	if !g.Directed && from > to {
+		from, to = to, from
+	}
+	return edgeKey{from: from, to: to, weight: ev.Weight}
+}
+
+// frameStyle classifies an edge within a frame
+// It returns "tree", "current", "rejected", or ""
+func (g *Graph) frameStyle(f Frame, e *Edge) string {
+	key := keyOf(e, g.Directed)
+	isCurrent := f.Event.Kind != EventVertexAdded && g.eventKey(f.Event) == key
+	switch {
+	case isCurrent && f.Event.Kind == EventEdgeRejected:
+		return "rejected"
+	case isCurrent && f.Event.Kind == EventEdgeConsidered:
+		return "current"
+	case f.inTree[key]:
+		return "tree"
+	default:
+		return ""
+	}
+}
+
+// WriteDOTFrame writes a frame in DOT format with tree edges highlighted
+func (g *Graph) WriteDOTFrame(w io.Writer, f Frame) error {
+	return g.writeDOT(w, func(e *Edge) string {
+		switch g.frameStyle(f, e) {
+		case "tree":
+			return `color="red", penwidth=3`
+		case "current":
+			return `color="orange", penwidth=2`
+		case "rejected":
+			return `color="gray", style="dashed"`
+		default:
+			return ""
+		}
+	})
+}
+
+// WriteSVGFrame writes a frame as an SVG image, placing vertices on a circle
+func (g *Graph) WriteSVGFrame(w io.Writer, f Frame) error {
+	const size, radius = 400.0, 160.0
+	bw := bufio.NewWriter(w)
+
+	ids := g.SortedVertexIDs()
+	pos := make(map[int][2]float64, len(ids))
+	for i, id := range ids {
+		angle := 2 * math.Pi * float64(i) / float64(len(ids))
+		pos[id] = [2]float64{size/2 + radius*math.Cos(angle), size/2 + radius*math.Sin(angle)}
+	}
+
+	fmt.Fprintf(bw, "<svg xmlns=\"http://www.w3.org/2000/svg\" width=\"%.0f\" height=\"%.0f\">\n", size, size)
+	fmt.Fprintf(bw, "  <text x=\"10\" y=\"20\" font-size=\"14\">step %d: %s</text>\n", f.Event.Step, f.Event.Kind)
+
+	for _, e := range g.Edges {
+		stroke, width, dash := "#999999", 1, ""
+		switch g.frameStyle(f, e) {
+		case "tree":
+			stroke, width = "red", 3
+		case "current":
+			stroke, width = "orange", 2
+		case "rejected":
+			dash = ` stroke-dasharray="4,4"`
+		}
+		a, b := pos[e.From.ID], pos[e.To.ID]
+		fmt.Fprintf(bw, "  <line x1=\"%.1f\" y1=\"%.1f\" x2=\"%.1f\" y2=\"%.1f\" stroke=\"%s\" stroke-width=\"%d\"%s/>\n",
+			a[0], a[1], b[0], b[1], stroke, width, dash)
+		fmt.Fprintf(bw, "  <text x=\"%.1f\" y=\"%.1f\" font-size=\"11\">%d</text>\n",
+			(a[0]+b[0])/2, (a[1]+b[1])/2, e.Weight)
+	}
+
+	for _, id := range ids {
+		p := pos[id]
+		fill := "white"
+		if f.Event.Kind == EventVertexAdded && f.Event.Vertex == id {
+			fill = "orange"
+		}
+		name := g.Vertices[id].Name
+		if name == "" {
+			name = strconv.Itoa(id)
+		}
+		fmt.Fprintf(bw, "  <circle cx=\"%.1f\" cy=\"%.1f\" r=\"14\" fill=\"%s\" stroke=\"black\"/>\n", p[0], p[1], fill)
+		fmt.Fprintf(bw, "  <text x=\"%.1f\" y=\"%.1f\" font-size=\"11\" text-anchor=\"middle\">%s</text>\n",
+			p[0], p[1]+4, svgEscape(name))
+	}
+
+	fmt.Fprintln(bw, "</svg>")
+	return bw.Flush()
+}
+
+// ExportFrames writes one file per trace step into dir and returns the file paths
+// Files are named frame_0001.dot, frame_0002.dot, ... so they sort in playback order
+func (g *Graph) ExportFrames(dir string, events []TraceEvent, format FrameFormat) ([]string, error) {
+	if format != FrameDOT && format != FrameSVG {
+		return nil, fmt.Errorf("unsupported frame format %q", format)
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	frames := g.BuildFrames(events)
+	paths := make([]string, 0, len(frames))
+	for i, f := range frames {
+		path := filepath.Join(dir, fmt.Sprintf("frame_%04d.%s", i+1, format))
+		file, err := os.Create(path)
+		if err != nil {
+			return paths, err
+		}
+		if format == FrameDOT {
+			err = g.WriteDOTFrame(file, f)
+		} else {
+			err = g.WriteSVGFrame(file, f)
+		}
+		if cerr := file.Close(); err == nil {
+			err = cerr
+		}
+		if err != nil {
+			return paths, err
+		}
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
+
+// svgEscape escapes text for use inside an SVG element
+func svgEscape(s string) string {
+	out := make([]byte, 0, len(s))
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '<':
+			out = append(out, "&lt;"...)
+		case '>':
+			out = append(out, "&gt;"...)
+		case '&':
+			out = append(out, "&amp;"...)
+		default:
+			out = append(out, s[i])
+		}
+	}
+	return string(out)
+}
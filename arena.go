@@ -0,0 +1,103 @@
+package mst
+
+// ==================== EDGE ARENA ====================
+
+// defaultSlabSize is the number of edges per arena slab when none is given
+const defaultSlabSize = 4096
+
+// EdgeArena hands out Edge structs from large preallocated slabs
+// A graph using an arena makes one allocation per slab instead of one or
+// two per AddEdge call, and Reset lets the next load reuse the slabs, which
+// keeps the garbage collector quiet across repeated bulk loads
+// An arena is not safe for concurrent use and should serve a single graph
+type EdgeArena struct {
+	slabs    [][]Edge
+	slab     int // slab currently being filled
+	used     int // edges handed out from that slab
+	slabSize int
+}
+
+// NewEdgeArena creates an arena whose slabs hold slabSize edges each
+// A slabSize of 0 or less uses 4096; pass the expected edge count (twice
+// that for undirected graphs) to preallocate everything in one slab
+func NewEdgeArena(slabSize int) *EdgeArena {
+	if slabSize <= 0 {
+		slabSize = defaultSlabSize
+	}
+	return &EdgeArena{
+		slabs:    [][]Edge{make([]Edge, slabSize)},
+		slabSize: slabSize,
+	}
+}
+
+// alloc returns n contiguous zeroed edges
+func (a *EdgeArena) alloc(n int) []Edge {
+	for a.used+n > len(a.slabs[a.slab]) {
+		if a.used == 0 {
+			// Too small even when empty: replace it with a slab that fits
+			a.slabs[a.slab] = make([]Edge, max(n, a.slabSize))
+			break
+		}
+		a.slab++
+		a.used = 0
+		if a.slab == len(a.slabs) {
+			a.slabs = append(a.slabs, make([]Edge, max(n, a.slabSize)))
+		}
+	}
+	edges := a.slabs[a.slab][a.used : a.used+n : a.used+n]
+	a.used += n
+	return edges
+}
+
+// Len returns the number of edges handed out since the last Reset
+func (a *EdgeArena) Len() int {
+	n := a.used
+	for _, s := range a.slabs[:a.slab] {
+		n += len(s)
+	}
+	return n
+}
+
+// Reset zeroes every slab and makes it available again
+// Edges handed out before must no longer be used
+func (a *EdgeArena) Reset() {
+	for _, s := range a.slabs[:a.slab+1] {
+		clear(s)
+	}
+	a.slab, a.used = 0, 0
+}
+
+// SetEdgeArena makes AddEdge and AddEdges allocate from arena; nil restores
+// ordinary allocation
+func (g *Graph) SetEdgeArena(arena *EdgeArena) {
+	g.arena = arena
+}
+
+// allocEdges returns n zeroed edges, from the arena if one is set
+func (g *Graph) allocEdges(n int) []Edge {
+	if g.arena != nil {
+		return g.arena.alloc(n)
+	}
+	return make([]Edge, n)
+}
+
+// Clear removes every vertex and edge so the graph can be loaded again
+// Its arena, if any, is reset for reuse, so edges of the old contents must
+// no longer be used; inside a transaction the arena is left alone so
+// Rollback can bring them back. Observers are kept and receive
+// MutationGraphCleared while the old contents are still in place
+func (g *Graph) Clear() {
+	g.notify(Mutation{Kind: MutationGraphCleared})
+	clear(g.Edges)
+	g.Edges = g.Edges[:0]
+	clear(g.Vertices)
+	g.index = nil
+	g.dense = nil
+	g.expiry = nil
+	if g.names != nil {
+		g.names.ids = make(map[string][]int)
+	}
+	if g.arena != nil && g.transactions == 0 {
+		g.arena.Reset()
+	}
+}
@@ -0,0 +1,119 @@
+package mst
+
+import (
+	"fmt"
+	"testing"
+)
+
+// TestEdgeArena tests allocating edges from an arena and reusing it after Clear
+func TestEdgeArena(t *testing.T) {
+	fmt.Println("\n=== EDGE ARENA TEST ===")
+
+	arena := NewEdgeArena(8)
+	g := NewGraph(false)
+	g.SetEdgeArena(arena)
+
+	load := func() {
+		v := make([]Vertex, 6)
+		for i := range v {
+			v[i] = Vertex{ID: i}
+		}
+		for i := 0; i < 5; i++ {
+			g.AddEdge(Edge{From: &v[i], To: &v[i+1], Weight: i + 1})
+		}
+		g.AddEdges([]Edge{{From: &v[0], To: &v[5], Weight: 10}, {From: &v[1], To: &v[4], Weight: 20}})
+	}
+
+	load()
+	// 7 undirected edges use 14 arena slots across two slabs
+	if arena.Len() != 14 {
+		t.Errorf("Expected 14 arena edges, got %d", arena.Len())
+	}
+	if _, total := g.Kruskal(); total != 15 {
+		t.Errorf("Expected MST weight 15, got %d", total)
+	}
+	reverse := g.Vertices[1].Edges[0]
+	if reverse.To.ID != 0 || reverse.twin != g.Edges[0] {
+		t.Error("Reverse copy should be linked to its forward edge")
+	}
+
+	slabs := len(arena.slabs)
+	g.Clear()
+	if g.VertexCount() != 0 || g.EdgeCount() != 0 || arena.Len() != 0 {
+		t.Fatal("Clear should empty the graph and reset the arena")
+	}
+	load()
+	if len(arena.slabs) != slabs {
+		t.Errorf("Reload should reuse %d slabs, now %d", slabs, len(arena.slabs))
+	}
+	if _, total := g.Kruskal(); total != 15 || g.EdgeCount() != 7 {
+		t.Errorf("Reloaded graph: expected weight 15 and 7 edges, got %d and %d", total, g.EdgeCount())
+	}
+
+	built, err := NewBuilder(WithEdgeArena(NewEdgeArena(0))).AddEdge(0, 1, 3, nil).Build()
+	if err != nil || built.arena == nil || built.arena.Len() != 2 {
+		t.Errorf("Builder should allocate from the arena, got %v", err)
+	}
+}
+
+// TestClearObservers tests that Clear empties a cached MST, is undone by
+// Rollback and advances graph versions
+func TestClearObservers(t *testing.T) {
+	g := NewGraph(false)
+	g.SetEdgeArena(NewEdgeArena(4))
+	a, b, c := Vertex{ID: 0, Name: "A"}, Vertex{ID: 1, Name: "B"}, Vertex{ID: 2, Name: "C"}
+	g.AddEdge(Edge{From: &a, To: &b, Weight: 1, Weights: map[string]int{"latency": 4}})
+	g.AddEdge(Edge{From: &b, To: &c, Weight: 2})
+	g.AddEdge(Edge{From: &a, To: &c, Weight: 5})
+	before := g.Hash()
+
+	cache := NewCachedMST(&g)
+	defer cache.Close()
+	cache.MST()
+	v1 := g.Snapshot()
+
+	tx := g.Begin()
+	g.Clear()
+	if tree, total := cache.MST(); len(tree) != 0 || total != 0 {
+		t.Errorf("Expected an empty cached tree after Clear, got %d edges of weight %d", len(tree), total)
+	}
+	if v2 := g.Snapshot(); v2.Seq() != v1.Seq()+1 || v2.EdgeCount() != 0 {
+		t.Errorf("Expected an empty version after Clear, got seq %d with %d edges", v2.Seq(), v2.EdgeCount())
+	}
+
+	if err := tx.Rollback(); err != nil {
+		t.Fatalf("Rollback failed: %v", err)
+	}
+	if g.Hash() != before {
+		t.Error("Expected rollback to restore the cleared graph")
+	}
+	if tree, total := cache.MST(); len(tree) != 2 || total != 3 {
+		t.Errorf("Expected the restored tree of weight 3, got %d edges of weight %d", len(tree), total)
+	}
+	if e, ok := g.GetEdge(0, 1); !ok || e.Weights["latency"] != 4 {
+		t.Error("Expected rollback to restore named criteria")
+	}
+	if v3 := g.Snapshot(); v3.EdgeCount() != 3 || v3.VertexCount() != 3 {
+		t.Errorf("Expected the restored version to have 3 vertices and 3 edges, got %d and %d", v3.VertexCount(), v3.EdgeCount())
+	}
+}
+
+// BenchmarkAddEdgeArena benchmarks reloading a graph edge by edge with an arena
+func BenchmarkAddEdgeArena(b *testing.B) {
+	edges := benchmarkEdgeBatch(25000)
+	for _, useArena := range []bool{false, true} {
+		b.Run(fmt.Sprintf("arena=%v", useArena), func(b *testing.B) {
+			g := NewGraph(false)
+			if useArena {
+				g.SetEdgeArena(NewEdgeArena(2 * len(edges)))
+			}
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				g.Clear()
+				for _, e := range edges {
+					g.AddEdge(e)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,168 @@
+// Package arrowmst loads mst graphs from Apache Arrow record batches and
+// Parquet files
+// It lives in its own module so that the core mst package does not depend
+// on Arrow
+package arrowmst
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/apache/arrow-go/v18/parquet"
+	"github.com/apache/arrow-go/v18/parquet/file"
+	"github.com/apache/arrow-go/v18/parquet/pqarrow"
+
+	"github.com/l00pss/mst"
+)
+
+// Columns names the edge list columns; empty names default to "from", "to", and "weight"
+// Endpoint columns may hold any integer type; the weight column may also
+// hold floats, which are rounded to the nearest integer
+type Columns struct {
+	From   string
+	To     string
+	Weight string
+}
+
+func (c Columns) names() (from, to, weight string) {
+	from, to, weight = c.From, c.To, c.Weight
+	if from == "" {
+		from = "from"
+	}
+	if to == "" {
+		to = "to"
+	}
+	if weight == "" {
+		weight = "weight"
+	}
+	return from, to, weight
+}
+
+// LoadRecord adds every row of a record batch to g as an edge and returns the number added
+// Columns are read straight from the Arrow buffers without copying them;
+// null values are rejected
+func LoadRecord(g *mst.Graph, rec arrow.RecordBatch, cols Columns) (int, error) {
+	fromName, toName, weightName := cols.names()
+	from, err := column(rec, fromName, false)
+	if err != nil {
+		return 0, err
+	}
+	to, err := column(rec, toName, false)
+	if err != nil {
+		return 0, err
+	}
+	weight, err := column(rec, weightName, true)
+	if err != nil {
+		return 0, err
+	}
+
+	n := int(rec.NumRows())
+	vertices := make([]mst.Vertex, 2*n)
+	edges := make([]mst.Edge, n)
+	for i := range n {
+		vertices[2*i].ID = int(from(i))
+		vertices[2*i+1].ID = int(to(i))
+		edges[i] = mst.Edge{From: &vertices[2*i], To: &vertices[2*i+1], Weight: int(weight(i))}
+	}
+	g.AddEdges(edges)
+	return n, nil
+}
+
+// LoadRecords adds the rows of every batch from rdr to g and returns the number of edges added
+// On error the edges of earlier batches stay in the graph
+func LoadRecords(g *mst.Graph, rdr array.RecordReader, cols Columns) (int, error) {
+	loaded := 0
+	for rdr.Next() {
+		n, err := LoadRecord(g, rdr.RecordBatch(), cols)
+		loaded += n
+		if err != nil {
+			return loaded, err
+		}
+	}
+	return loaded, rdr.Err()
+}
+
+// LoadParquet reads the edge list columns of a Parquet file into g and
+// returns the number of edges added
+// Only the three named columns are decoded, batch by batch
+func LoadParquet(ctx context.Context, g *mst.Graph, r parquet.ReaderAtSeeker, cols Columns) (int, error) {
+	pf, err := file.NewParquetReader(r)
+	if err != nil {
+		return 0, err
+	}
+	defer pf.Close()
+
+	fromName, toName, weightName := cols.names()
+	indices := make([]int, 0, 3)
+	for _, name := range []string{fromName, toName, weightName} {
+		i := pf.MetaData().Schema.ColumnIndexByName(name)
+		if i < 0 {
+			return 0, fmt.Errorf("arrowmst: parquet file has no column %q", name)
+		}
+		indices = append(indices, i)
+	}
+
+	fr, err := pqarrow.NewFileReader(pf, pqarrow.ArrowReadProperties{BatchSize: 64 * 1024}, memory.DefaultAllocator)
+	if err != nil {
+		return 0, err
+	}
+	rdr, err := fr.GetRecordReader(ctx, indices, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer rdr.Release()
+	return LoadRecords(g, rdr, cols)
+}
+
+// column returns an accessor for a numeric column of rec
+// Float columns are only accepted for weights and are rounded
+func column(rec arrow.RecordBatch, name string, weight bool) (func(i int) int64, error) {
+	indices := rec.Schema().FieldIndices(name)
+	if len(indices) == 0 {
+		return nil, fmt.Errorf("arrowmst: record has no column %q", name)
+	}
+	col := rec.Column(indices[0])
+	if col.NullN() > 0 {
+		return nil, fmt.Errorf("arrowmst: column %q has %d nulls", name, col.NullN())
+	}
+
+	switch a := col.(type) {
+	case *array.Int64:
+		return values(a.Int64Values()), nil
+	case *array.Int32:
+		return values(a.Int32Values()), nil
+	case *array.Int16:
+		return values(a.Int16Values()), nil
+	case *array.Int8:
+		return values(a.Int8Values()), nil
+	case *array.Uint64:
+		return values(a.Uint64Values()), nil
+	case *array.Uint32:
+		return values(a.Uint32Values()), nil
+	case *array.Uint16:
+		return values(a.Uint16Values()), nil
+	case *array.Uint8:
+		return values(a.Uint8Values()), nil
+	case *array.Float64:
+		if weight {
+			return rounded(a.Float64Values()), nil
+		}
+	case *array.Float32:
+		if weight {
+			return rounded(a.Float32Values()), nil
+		}
+	}
+	return nil, fmt.Errorf("arrowmst: column %q has unsupported type %s", name, col.DataType())
+}
+
+func values[T int8 | int16 | int32 | int64 | uint8 | uint16 | uint32 | uint64](vals []T) func(i int) int64 {
+	return func(i int) int64 { return int64(vals[i]) }
+}
+
+func rounded[T float32 | float64](vals []T) func(i int) int64 {
+	return func(i int) int64 { return int64(math.Round(float64(vals[i]))) }
+}
@@ -0,0 +1,86 @@
+package arrowmst
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/apache/arrow-go/v18/parquet/pqarrow"
+
+	"github.com/l00pss/mst"
+)
+
+// newEdgeRecord builds a record batch of the triangle 0-1-2 with the given weights
+func newEdgeRecord(weights []float64) arrow.RecordBatch {
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "src", Type: arrow.PrimitiveTypes.Int64},
+		{Name: "dst", Type: arrow.PrimitiveTypes.Int32},
+		{Name: "cost", Type: arrow.PrimitiveTypes.Float64},
+	}, nil)
+	b := array.NewRecordBuilder(memory.DefaultAllocator, schema)
+	defer b.Release()
+	b.Field(0).(*array.Int64Builder).AppendValues([]int64{0, 1, 0}, nil)
+	b.Field(1).(*array.Int32Builder).AppendValues([]int32{1, 2, 2}, nil)
+	b.Field(2).(*array.Float64Builder).AppendValues(weights, nil)
+	return b.NewRecordBatch()
+}
+
+// TestLoadRecord tests loading an Arrow record batch
+func TestLoadRecord(t *testing.T) {
+	fmt.Println("\n=== ARROW RECORD TEST ===")
+
+	rec := newEdgeRecord([]float64{4, 2, 2.6})
+	defer rec.Release()
+
+	g := mst.NewGraph(false)
+	n, err := LoadRecord(&g, rec, Columns{From: "src", To: "dst", Weight: "cost"})
+	if err != nil || n != 3 {
+		t.Fatalf("Expected 3 edges, got %d (%v)", n, err)
+	}
+	if e, _ := g.GetEdge(0, 2); e == nil || e.Weight != 3 {
+		t.Errorf("Expected rounded weight 3 on 0-2, got %v", e)
+	}
+	if _, total := g.Kruskal(); total != 5 {
+		t.Errorf("Expected MST weight 5, got %d", total)
+	}
+
+	if _, err := LoadRecord(&g, rec, Columns{}); err == nil {
+		t.Error("Expected an error for missing default columns")
+	}
+	if _, err := LoadRecord(&g, rec, Columns{From: "cost", To: "dst", Weight: "src"}); err == nil {
+		t.Error("Expected an error for a float endpoint column")
+	}
+}
+
+// TestLoadParquet tests loading an edge list written to Parquet
+func TestLoadParquet(t *testing.T) {
+	fmt.Println("\n=== PARQUET TEST ===")
+
+	rec := newEdgeRecord([]float64{4, 2, 3})
+	defer rec.Release()
+	table := array.NewTableFromRecords(rec.Schema(), []arrow.RecordBatch{rec})
+	defer table.Release()
+
+	var buf bytes.Buffer
+	if err := pqarrow.WriteTable(table, &buf, 2, nil, pqarrow.DefaultWriterProps()); err != nil {
+		t.Fatalf("WriteTable failed: %v", err)
+	}
+
+	g := mst.NewGraph(false)
+	n, err := LoadParquet(context.Background(), &g, bytes.NewReader(buf.Bytes()),
+		Columns{From: "src", To: "dst", Weight: "cost"})
+	if err != nil || n != 3 {
+		t.Fatalf("Expected 3 edges, got %d (%v)", n, err)
+	}
+	if g.VertexCount() != 3 || !g.HasEdge(1, 2) {
+		t.Errorf("Unexpected graph: %d vertices", g.VertexCount())
+	}
+
+	if _, err := LoadParquet(context.Background(), &g, bytes.NewReader(buf.Bytes()), Columns{}); err == nil {
+		t.Error("Expected an error for a missing column")
+	}
+}
@@ -0,0 +1,194 @@
+package mst
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+)
+
+// ==================== ATTRIBUTES ====================
+
+// Attributes is a set of named vertex or edge attributes
+// Values are usually strings, numbers, or booleans so that they map onto
+// interchange formats such as DOT, GraphML, or node-link JSON
+type Attributes map[string]any
+
+// Get returns the raw value of an attribute
+func (a Attributes) Get(key string) (any, bool) {
+	v, ok := a[key]
+	return v, ok
+}
+
+// GetString returns a string attribute
+// Other values are formatted with fmt, so any present attribute yields a string
+func (a Attributes) GetString(key string) (string, bool) {
+	v, ok := a[key]
+	if !ok {
+		return "", false
+	}
+	if s, isString := v.(string); isString {
+		return s, true
+	}
+	return fmt.Sprint(v), true
+}
+
+// GetFloat returns a numeric attribute as float64
+// Integer and float types convert, strings are parsed, anything else fails
+func (a Attributes) GetFloat(key string) (float64, bool) {
+	switch v := a[key].(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint:
+		return float64(v), true
+	case uint32:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		return f, err == nil
+	}
+	return 0, false
+}
+
+// GetInt returns a numeric attribute as int
+// Floats are accepted only when they hold a whole number
+func (a Attributes) GetInt(key string) (int, bool) {
+	if s, isString := a[key].(string); isString {
+		n, err := strconv.Atoi(s)
+		return n, err == nil
+	}
+	f, ok := a.GetFloat(key)
+	if !ok || f != float64(int(f)) {
+		return 0, false
+	}
+	return int(f), true
+}
+
+// GetBool returns a boolean attribute; the strings accepted by strconv.ParseBool also count
+func (a Attributes) GetBool(key string) (bool, bool) {
+	switch v := a[key].(type) {
+	case bool:
+		return v, true
+	case string:
+		b, err := strconv.ParseBool(v)
+		return b, err == nil
+	}
+	return false, false
+}
+
+// Keys returns the attribute names in sorted order
+func (a Attributes) Keys() []string {
+	return slices.Sorted(maps.Keys(a))
+}
+
+// SetAttr sets an attribute on an edge, shared with its reverse copy
+// MST results hold the graph's own edges, so they see the same attributes.
+// Observers are not notified, so use Graph.SetEdgeAttr for an edge already in a graph
+func (e *Edge) SetAttr(key string, v any) {
+	if e.Attrs == nil {
+		e.Attrs = make(Attributes)
+		if e.twin != nil {
+			e.twin.Attrs = e.Attrs
+		}
+	}
+	e.Attrs[key] = v
+}
+
+// GetString returns a string attribute of the edge
+func (e *Edge) GetString(key string) (string, bool) {
+	return e.Attrs.GetString(key)
+}
+
+// GetFloat returns a numeric attribute of the edge as float64
+func (e *Edge) GetFloat(key string) (float64, bool) {
+	return e.Attrs.GetFloat(key)
+}
+
+// SetAttr sets an attribute on a vertex value
+// Vertices are stored by value, so use Graph.SetVertexAttr for one already in a graph
+func (v *Vertex) SetAttr(key string, value any) {
+	if v.Attrs == nil {
+		v.Attrs = make(Attributes)
+	}
+	v.Attrs[key] = value
+}
+
+// GetString returns a string attribute of the vertex
+func (v *Vertex) GetString(key string) (string, bool) {
+	return v.Attrs.GetString(key)
+}
+
+// GetFloat returns a numeric attribute of the vertex as float64
+func (v *Vertex) GetFloat(key string) (float64, bool) {
+	return v.Attrs.GetFloat(key)
+}
+
+// SetEdgeAttr sets an attribute on an edge of the graph and its reverse copy
+// Attribute values need not be comparable, so observers are notified with
+// MutationEdgeAttrChanged even when the new value equals the old one
+func (g *Graph) SetEdgeAttr(edge *Edge, key string, value any) {
+	old, present := edge.Attrs[key]
+	edge.SetAttr(key, value)
+	g.notify(Mutation{Kind: MutationEdgeAttrChanged, Edge: edge, Attr: key, OldValue: old, OldPresent: present})
+}
+
+// unsetEdgeAttr removes an attribute from an edge, undoing the
+// SetEdgeAttr call that added it
+func (g *Graph) unsetEdgeAttr(edge *Edge, key string) {
+	old, present := edge.Attrs[key]
+	if !present {
+		return
+	}
+	delete(edge.Attrs, key)
+	g.notify(Mutation{Kind: MutationEdgeAttrChanged, Edge: edge, Attr: key, OldValue: old, OldPresent: true})
+}
+
+// SetVertexAttr sets an attribute on a vertex of the graph and notifies
+// observers with MutationVertexAttrChanged
+// It reports whether the vertex exists
+func (g *Graph) SetVertexAttr(id int, key string, value any) bool {
+	v, exists := g.Vertices[id]
+	if !exists {
+		return false
+	}
+	old, present := v.Attrs[key]
+	v.SetAttr(key, value)
+	g.Vertices[id] = v
+	g.notify(Mutation{Kind: MutationVertexAttrChanged, Vertex: &v, Attr: key, OldValue: old, OldPresent: present})
+	return true
+}
+
+// unsetVertexAttr removes an attribute from a vertex, undoing the
+// SetVertexAttr call that added it
+func (g *Graph) unsetVertexAttr(id int, key string) {
+	v, exists := g.Vertices[id]
+	if !exists {
+		return
+	}
+	old, present := v.Attrs[key]
+	if !present {
+		return
+	}
+	delete(v.Attrs, key)
+	g.notify(Mutation{Kind: MutationVertexAttrChanged, Vertex: &v, Attr: key, OldValue: old, OldPresent: true})
+}
+
+// dotAttrs renders attributes as a DOT attribute list suffix, in key order
+func dotAttrs(a Attributes) string {
+	s := ""
+	for _, key := range a.Keys() {
+		value, _ := a.GetString(key)
+		s += ", " + strconv.Quote(key) + "=" + strconv.Quote(value)
+	}
+	return s
+}
@@ -0,0 +1,104 @@
+package mst
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// TestAttributes tests typed attribute access and propagation
+func TestAttributes(t *testing.T) {
+	fmt.Println("\n=== ATTRIBUTES TEST ===")
+
+	g := NewGraph(false)
+	a := Vertex{ID: 0, Name: "A"}
+	a.SetAttr("city", "Baku")
+	b := Vertex{ID: 1, Name: "B"}
+	edge := g.AddEdge(Edge{From: &a, To: &b, Weight: 3})
+	edge.SetAttr("capacity", 2.5)
+	edge.SetAttr("cable", "fiber")
+	g.SetVertexAttr(1, "population", 42)
+
+	if city, ok := g.Vertices[0].Attrs.GetString("city"); !ok || city != "Baku" {
+		t.Errorf("Expected city Baku, got %q", city)
+	}
+	if n, ok := g.Vertices[1].Attrs.GetInt("population"); !ok || n != 42 {
+		t.Errorf("Expected population 42, got %d", n)
+	}
+	if f, ok := g.Vertices[1].Attrs.GetFloat("population"); !ok || f != 42 {
+		t.Errorf("Expected population 42.0, got %v", f)
+	}
+	if _, ok := edge.Attrs.GetInt("capacity"); ok {
+		t.Error("2.5 should not convert to an int")
+	}
+	if _, ok := edge.GetFloat("cable"); ok {
+		t.Error("A non-numeric string should not convert to a float")
+	}
+
+	// The reverse adjacency copy and the MST share the attributes
+	reverse := g.Vertices[1].Edges[0]
+	if c, _ := reverse.GetFloat("capacity"); c != 2.5 {
+		t.Errorf("Reverse copy should see capacity 2.5, got %v", c)
+	}
+	mst, _ := g.Kruskal()
+	if s, _ := mst[0].GetString("cable"); s != "fiber" {
+		t.Errorf("MST edge should keep cable=fiber, got %q", s)
+	}
+
+	var buf bytes.Buffer
+	if err := g.WriteDOT(&buf); err != nil {
+		t.Fatalf("WriteDOT failed: %v", err)
+	}
+	fmt.Print(buf.String())
+	for _, want := range []string{`"city"="Baku"`, `"cable"="fiber", "capacity"="2.5"`, `"population"="42"`} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("DOT output missing %s", want)
+		}
+	}
+	if g.SetVertexAttr(99, "x", 1) {
+		t.Error("SetVertexAttr on a missing vertex should fail")
+	}
+}
+
+// TestAttributesCopied tests that graphs never share maps with the caller or with each other
+func TestAttributesCopied(t *testing.T) {
+	fmt.Println("\n=== ATTRIBUTES COPIED TEST ===")
+
+	attrs := Attributes{"label": "x"}
+	weights := map[string]int{"latency": 5}
+	g := NewGraph(false)
+	g.AddVertex(Vertex{ID: 0, Attrs: attrs})
+	g.AddEdge(Edge{From: &Vertex{ID: 0}, To: &Vertex{ID: 1}, Weight: 2, Weights: weights, Attrs: attrs})
+	g.AddEdges([]Edge{{From: &Vertex{ID: 1}, To: &Vertex{ID: 2, Attrs: attrs}, Weight: 3, Weights: weights, Attrs: attrs}})
+
+	attrs["label"] = "changed"
+	weights["latency"] = 50
+	if s, _ := g.Vertices[0].Attrs.GetString("label"); s != "x" {
+		t.Errorf("AddVertex should copy Attrs, got label %q", s)
+	}
+	if s, _ := g.Vertices[2].Attrs.GetString("label"); s != "x" {
+		t.Errorf("AddEdges should copy vertex Attrs, got label %q", s)
+	}
+	for _, e := range g.Edges {
+		if s, _ := e.GetString("label"); s != "x" || e.Weights["latency"] != 5 {
+			t.Errorf("Edge %d-%d should keep label x and latency 5, got %q and %d", e.From.ID, e.To.ID, s, e.Weights["latency"])
+		}
+	}
+
+	// Copies of g made by SubgraphByWeight and Complement are independent of it
+	sub := g.SubgraphByWeight(0, 10, true)
+	sub.Edges[0].SetAttr("label", "sub")
+	sub.Edges[0].Weights["latency"] = 7
+	sub.SetVertexAttr(0, "label", "sub")
+	comp := g.Complement(nil)
+	comp.SetVertexAttr(2, "label", "comp")
+	if s, _ := g.Edges[0].GetString("label"); s != "x" || g.Edges[0].Weights["latency"] != 5 {
+		t.Errorf("Subgraph edits should not reach g, got label %q and latency %d", s, g.Edges[0].Weights["latency"])
+	}
+	for _, id := range []int{0, 2} {
+		if s, _ := g.Vertices[id].Attrs.GetString("label"); s != "x" {
+			t.Errorf("Vertex %d edits in a copy should not reach g, got label %q", id, s)
+		}
+	}
+}
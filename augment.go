@@ -0,0 +1,184 @@
+package mst
+
+import (
+	"container/heap"
+	"math"
+	"slices"
+)
+
+// ==================== 2-EDGE-CONNECTED AUGMENTATION ====================
+
+// Augmentation is a set of extra links that leaves a spanning tree
+// without bridges, so that no single link failure disconnects it
+type Augmentation struct {
+	Edges   []*Edge // non-tree edges of g to add, in the order picked
+	Weight  int     // total weight of Edges
+	Bridges []*Edge // tree edges no extra edge can protect, bridges of g itself
+}
+
+// TwoEdgeConnectedAugmentation suggests cheap non-tree edges of g that,
+// added to tree, make every tree edge part of a cycle
+// A non-tree edge protects the tree edges on the path between its
+// endpoints. Finding the cheapest set is NP-hard, so edges are picked
+// greedily by newly protected tree edges per unit of weight, then picks
+// that later ones made redundant are dropped, most expensive first. Edges
+// of weight 0 or less are picked before any other. tree is usually an MST
+// from Kruskal; non-tree edges joining two of its trees protect nothing
+func (g *Graph) TwoEdgeConnectedAugmentation(tree []*Edge) Augmentation {
+	if g.Directed {
+		panic("TwoEdgeConnectedAugmentation only works for undirected graphs")
+	}
+	inTree := make(map[*Edge]bool, 2*len(tree))
+	for _, e := range tree {
+		inTree[e] = true
+		if e.twin != nil {
+			inTree[e.twin] = true
+		}
+	}
+	rf := rootForest(tree, 0)
+
+	// Tree edges are known by their child vertex
+	candidates := make([]augmentCandidate, 0)
+	for _, e := range g.Edges {
+		if inTree[e] || e.From.ID == e.To.ID {
+			continue
+		}
+		if path := treePath(rf, e.From.ID, e.To.ID); len(path) > 0 {
+			candidates = append(candidates, augmentCandidate{edge: e, path: path})
+		}
+	}
+
+	// Lazy greedy: gains only shrink, so a candidate whose fresh gain still
+	// beats every cached one is the best pick
+	covers := make(map[int]int)
+	gain := func(c *augmentCandidate) int {
+		n := 0
+		for _, child := range c.path {
+			if covers[child] == 0 {
+				n++
+			}
+		}
+		return n
+	}
+	queue := make(augmentQueue, len(candidates))
+	for i := range candidates {
+		candidates[i].order = i
+		candidates[i].ratio = augmentRatio(len(candidates[i].path), candidates[i].edge.Weight)
+		queue[i] = &candidates[i]
+	}
+	heap.Init(&queue)
+	picked := make([]*augmentCandidate, 0)
+	for queue.Len() > 0 {
+		c := heap.Pop(&queue).(*augmentCandidate)
+		n := gain(c)
+		if n == 0 {
+			continue
+		}
+		if ratio := augmentRatio(n, c.edge.Weight); ratio < c.ratio {
+			c.ratio = ratio
+			heap.Push(&queue, c)
+			continue
+		}
+		picked = append(picked, c)
+		for _, child := range c.path {
+			covers[child]++
+		}
+	}
+
+	// Drop picks whose every tree edge another pick also protects
+	byWeight := slices.Clone(picked)
+	slices.SortStableFunc(byWeight, func(a, b *augmentCandidate) int { return b.edge.Weight - a.edge.Weight })
+	dropped := make(map[*augmentCandidate]bool)
+	for _, c := range byWeight {
+		redundant := true
+		for _, child := range c.path {
+			if covers[child] < 2 {
+				redundant = false
+				break
+			}
+		}
+		if redundant {
+			dropped[c] = true
+			for _, child := range c.path {
+				covers[child]--
+			}
+		}
+	}
+
+	a := Augmentation{Edges: make([]*Edge, 0, len(picked)), Bridges: make([]*Edge, 0)}
+	for _, c := range picked {
+		if !dropped[c] {
+			a.Edges = append(a.Edges, c.edge)
+			a.Weight += c.edge.Weight
+		}
+	}
+	for _, e := range tree {
+		child := e.To.ID
+		if rf.parentEdge[child] != e && rf.parentEdge[child] != e.twin {
+			child = e.From.ID
+		}
+		if covers[child] == 0 {
+			a.Bridges = append(a.Bridges, e)
+		}
+	}
+	return a
+}
+
+// treePath returns the child vertices of the tree edges between u and v,
+// or nil when they lie in different trees
+func treePath(rf *rootedForest, u, v int) []int {
+	path := make([]int, 0)
+	for u != v {
+		if rf.depth[u] < rf.depth[v] {
+			u, v = v, u
+		}
+		if rf.parentEdge[u] == nil {
+			return nil
+		}
+		path = append(path, u)
+		u = rf.parent[u]
+	}
+	return path
+}
+
+// augmentRatio is the protected tree edges per unit of weight, infinite
+// for free edges
+func augmentRatio(protected, weight int) float64 {
+	if weight <= 0 {
+		return math.Inf(1)
+	}
+	return float64(protected) / float64(weight)
+}
+
+// augmentCandidate is a non-tree edge with the tree path it protects and
+// its last known ratio
+type augmentCandidate struct {
+	edge  *Edge
+	path  []int
+	ratio float64
+	order int // position in g.Edges order, breaking ties
+}
+
+// augmentQueue is a max-heap of candidates by ratio, then lighter and
+// earlier edges first
+type augmentQueue []*augmentCandidate
+
+func (q augmentQueue) Len() int { return len(q) }
+func (q augmentQueue) Less(i, j int) bool {
+	a, b := q[i], q[j]
+	if a.ratio != b.ratio {
+		return a.ratio > b.ratio
+	}
+	if a.edge.Weight != b.edge.Weight {
+		return a.edge.Weight < b.edge.Weight
+	}
+	return a.order < b.order
+}
+func (q augmentQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+func (q *augmentQueue) Push(x any)   { *q = append(*q, x.(*augmentCandidate)) }
+func (q *augmentQueue) Pop() any {
+	old := *q
+	c := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return c
+}
@@ -0,0 +1,96 @@
+package mst
+
+import (
+	"fmt"
+	"testing"
+)
+
+// hasBridge reports whether removing any single edge disconnects the graph
+func hasBridge(edges []*Edge) bool {
+	for skip := range edges {
+		uf := NewUnionFind()
+		for _, e := range edges {
+			uf.MakeSet(e.From.ID)
+			uf.MakeSet(e.To.ID)
+		}
+		for i, e := range edges {
+			if i != skip {
+				uf.Union(e.From.ID, e.To.ID)
+			}
+		}
+		if uf.Find(edges[skip].From.ID) != uf.Find(edges[skip].To.ID) {
+			return true
+		}
+	}
+	return false
+}
+
+// TestTwoEdgeConnectedAugmentation tests that the suggestion removes every bridge
+func TestTwoEdgeConnectedAugmentation(t *testing.T) {
+	fmt.Println("\n=== 2-EDGE-CONNECTED AUGMENTATION TEST ===")
+
+	// A ring of cheap links closed by one expensive link, with two chords
+	// that each protect half of it for a little less
+	g := NewGraph(false)
+	for i := range 6 {
+		g.AddEdge(Edge{From: &Vertex{ID: i}, To: &Vertex{ID: i + 1}, Weight: 1})
+	}
+	closing := g.AddEdge(Edge{From: &Vertex{ID: 0}, To: &Vertex{ID: 6}, Weight: 8})
+	g.AddEdge(Edge{From: &Vertex{ID: 0}, To: &Vertex{ID: 3}, Weight: 5})
+	g.AddEdge(Edge{From: &Vertex{ID: 3}, To: &Vertex{ID: 6}, Weight: 5})
+
+	mst, _ := g.Kruskal()
+	a := g.TwoEdgeConnectedAugmentation(mst)
+	fmt.Printf("Extra edges: %d, weight %d\n", len(a.Edges), a.Weight)
+	if len(a.Edges) != 1 || a.Edges[0] != closing || a.Weight != 8 {
+		t.Errorf("Expected the single closing link, got %v with weight %d", a.Edges, a.Weight)
+	}
+	if len(a.Bridges) != 0 || hasBridge(append(mst, a.Edges...)) {
+		t.Error("Expected no bridges left")
+	}
+
+	// A cheaper closing link makes the greedy pick it first
+	g.SetEdgeWeight(closing, 3)
+	if a := g.TwoEdgeConnectedAugmentation(mst); len(a.Edges) != 1 || a.Weight != 3 {
+		t.Errorf("Expected the cheaper closing link alone, got %v", a.Edges)
+	}
+}
+
+// TestTwoEdgeConnectedAugmentationLarger tests a complete graph and
+// bridges that no edge can protect
+func TestTwoEdgeConnectedAugmentationLarger(t *testing.T) {
+	fmt.Println("\n=== LARGER AUGMENTATION TEST ===")
+
+	g := buildCompleteGraph(25)
+	mst, weight := g.Kruskal()
+	a := g.TwoEdgeConnectedAugmentation(mst)
+	fmt.Printf("MST weight %d plus %d extra edges of weight %d\n", weight, len(a.Edges), a.Weight)
+	if len(a.Bridges) != 0 || hasBridge(append(mst, a.Edges...)) {
+		t.Fatal("Expected the augmented tree to have no bridges")
+	}
+	// A 2-edge-connected spanning subgraph needs at least V edges
+	if len(mst)+len(a.Edges) < 25 || len(a.Edges) > 24 {
+		t.Errorf("Expected between 1 and 24 extra edges, got %d", len(a.Edges))
+	}
+
+	// Every extra edge is needed: dropping any brings a bridge back
+	for skip := range a.Edges {
+		rest := append([]*Edge{}, mst...)
+		for i, e := range a.Edges {
+			if i != skip {
+				rest = append(rest, e)
+			}
+		}
+		if !hasBridge(rest) {
+			t.Errorf("Extra edge %d-%d is redundant", a.Edges[skip].From.ID, a.Edges[skip].To.ID)
+		}
+	}
+
+	// A pendant vertex hangs on a true bridge
+	g.AddEdge(Edge{From: &Vertex{ID: 0}, To: &Vertex{ID: 99}, Weight: 1})
+	mst, _ = g.Kruskal()
+	a = g.TwoEdgeConnectedAugmentation(mst)
+	if len(a.Bridges) != 1 || !containsPair(a.Bridges, 0, 99) {
+		t.Errorf("Expected 0-99 to be reported as a bridge, got %v", a.Bridges)
+	}
+}
@@ -0,0 +1,162 @@
+package mst
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+)
+
+// ==================== EDGE BETWEENNESS ====================
+
+// EdgeBetweenness returns, for every edge of g.Edges, the number of
+// shortest paths between vertex pairs that run through it
+// Pairs joined by several shortest paths split their share evenly, so the
+// values are fractional; each unordered pair counts once in undirected
+// graphs and each ordered pair in directed ones. Weights are path lengths
+// and must be positive. Brandes' algorithm runs one Dijkstra per vertex,
+// in O(V·E log V)
+func (g *Graph) EdgeBetweenness() map[*Edge]float64 {
+	betweenness := make(map[*Edge]float64, len(g.Edges))
+	for _, e := range g.Edges {
+		betweenness[e] = 0
+	}
+	canonical := func(e *Edge) *Edge {
+		if _, ok := betweenness[e]; !ok && e.twin != nil {
+			return e.twin
+		}
+		return e
+	}
+
+	type arc struct {
+		from int
+		edge *Edge
+	}
+	for source := range g.Vertices {
+		dist := map[int]int{source: 0}
+		paths := map[int]float64{source: 1}
+		preds := make(map[int][]arc)
+		settled := make([]int, 0, len(g.Vertices))
+		done := make(map[int]bool, len(g.Vertices))
+
+		heap := NewDAryHeap(4, len(g.Vertices))
+		heap.Push(source, 0, nil)
+		for heap.Len() > 0 {
+			v, d, _ := heap.PopMin()
+			done[v] = true
+			settled = append(settled, v)
+			for _, e := range g.Vertices[v].Edges {
+				w := e.To.ID
+				if done[w] {
+					continue
+				}
+				old, seen := dist[w]
+				switch next := d + e.Weight; {
+				case !seen || next < old:
+					dist[w], paths[w] = next, paths[v]
+					preds[w] = append(preds[w][:0], arc{from: v, edge: e})
+					heap.Push(w, next, e)
+				case next == old:
+					paths[w] += paths[v]
+					preds[w] = append(preds[w], arc{from: v, edge: e})
+				}
+			}
+		}
+
+		// Settled order puts every vertex after its predecessors
+		dependency := make(map[int]float64, len(settled))
+		for i := len(settled) - 1; i > 0; i-- {
+			w := settled[i]
+			for _, p := range preds[w] {
+				share := paths[p.from] / paths[w] * (1 + dependency[w])
+				betweenness[canonical(p.edge)] += share
+				dependency[p.from] += share
+			}
+		}
+	}
+
+	if !g.Directed {
+		for e := range betweenness {
+			betweenness[e] /= 2
+		}
+	}
+	return betweenness
+}
+
+// EdgeTraffic is an edge's betweenness and whether the MST uses it
+type EdgeTraffic struct {
+	Edge        *Edge
+	Betweenness float64
+	InTree      bool
+}
+
+// OverlapReport compares the edges carrying the most shortest paths with
+// the minimum spanning tree
+type OverlapReport struct {
+	Top    []EdgeTraffic // highest betweenness first
+	InTree int           // how many of Top the MST uses
+}
+
+// BetweennessOverlap ranks edges by EdgeBetweenness and reports which of
+// the top ones the Kruskal MST includes
+// Equal betweenness keeps g.Edges order; top of 0 or less, or beyond the
+// edge count, reports every edge
+func (g *Graph) BetweennessOverlap(top int) OverlapReport {
+	if g.Directed {
+		panic("BetweennessOverlap only works for undirected graphs")
+	}
+	betweenness := g.EdgeBetweenness()
+	tree, _ := g.Kruskal()
+	inTree := make(map[*Edge]bool, len(tree))
+	for _, e := range tree {
+		inTree[e] = true
+	}
+
+	ranked := make([]EdgeTraffic, len(g.Edges))
+	for i, e := range g.Edges {
+		ranked[i] = EdgeTraffic{Edge: e, Betweenness: betweenness[e], InTree: inTree[e]}
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return ranked[i].Betweenness > ranked[j].Betweenness
+	})
+	if top > 0 && top < len(ranked) {
+		ranked = ranked[:top]
+	}
+
+	r := OverlapReport{Top: ranked}
+	for _, t := range ranked {
+		if t.InTree {
+			r.InTree++
+		}
+	}
+	return r
+}
+
+// Excluded returns the top edges the MST leaves out, highest betweenness
+// first; these carry traffic the minimal design reroutes
+func (r OverlapReport) Excluded() []EdgeTraffic {
+	excluded := make([]EdgeTraffic, 0, len(r.Top)-r.InTree)
+	for _, t := range r.Top {
+		if !t.InTree {
+			excluded = append(excluded, t)
+		}
+	}
+	return excluded
+}
+
+// String formats the report as a table with one row per edge
+func (r OverlapReport) String() string {
+	var b strings.Builder
+	tw := tabwriter.NewWriter(&b, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "FROM\tTO\tWEIGHT\tBETWEENNESS\tMST\t")
+	for _, t := range r.Top {
+		mark := "✗"
+		if t.InTree {
+			mark = "✓"
+		}
+		fmt.Fprintf(tw, "%d\t%d\t%d\t%.2f\t%s\t\n", t.Edge.From.ID, t.Edge.To.ID, t.Edge.Weight, t.Betweenness, mark)
+	}
+	tw.Flush()
+	fmt.Fprintf(&b, "%d of %d top edges are in the MST\n", r.InTree, len(r.Top))
+	return b.String()
+}
@@ -0,0 +1,96 @@
+package mst
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+)
+
+// TestEdgeBetweenness tests shortest-path counts on small graphs
+func TestEdgeBetweenness(t *testing.T) {
+	fmt.Println("\n=== EDGE BETWEENNESS TEST ===")
+
+	// A path 0-1-2-3: the middle edge separates two pairs of vertices
+	v := make([]*Vertex, 4)
+	for i := range v {
+		v[i] = &Vertex{ID: i}
+	}
+	path := NewGraph(false)
+	for i := range 3 {
+		path.AddEdge(Edge{From: v[i], To: v[i+1], Weight: 1})
+	}
+	b := path.EdgeBetweenness()
+	for i, want := range []float64{3, 4, 3} {
+		if got := b[path.Edges[i]]; got != want {
+			t.Errorf("Path edge %d: expected %v, got %v", i, want, got)
+		}
+	}
+
+	// A square splits one opposite pair between two routes and the other
+	// between three, since the diagonal ties 0-1-2
+	square := NewGraph(false)
+	for i := range 4 {
+		square.AddEdge(Edge{From: v[i], To: v[(i+1)%4], Weight: 1})
+	}
+	diagonal := square.AddEdge(Edge{From: v[0], To: v[2], Weight: 2})
+	b = square.EdgeBetweenness()
+	for _, e := range square.Edges[:4] {
+		if math.Abs(b[e]-(1+1.0/3+1.0/2)) > 1e-9 {
+			t.Errorf("Square edge %d-%d: expected 11/6, got %v", e.From.ID, e.To.ID, b[e])
+		}
+	}
+	if math.Abs(b[diagonal]-1.0/3) > 1e-9 {
+		t.Errorf("Expected the tied diagonal to carry 1/3, got %v", b[diagonal])
+	}
+
+	// Directed graphs count ordered pairs
+	dg := NewGraph(true)
+	dg.AddEdge(Edge{From: v[0], To: v[1], Weight: 1})
+	dg.AddEdge(Edge{From: v[1], To: v[2], Weight: 1})
+	dg.AddEdge(Edge{From: v[0], To: v[2], Weight: 5})
+	b = dg.EdgeBetweenness()
+	if got := b[dg.Edges[0]]; got != 2 {
+		t.Errorf("Expected 0->1 on 2 shortest paths, got %v", got)
+	}
+	if got := b[dg.Edges[2]]; got != 0 {
+		t.Errorf("Expected the long edge 0->2 on no shortest path, got %v", got)
+	}
+}
+
+// TestBetweennessOverlap tests the MST overlap report
+func TestBetweennessOverlap(t *testing.T) {
+	fmt.Println("\n=== BETWEENNESS OVERLAP TEST ===")
+
+	// Two stars, around 0 and 5, joined by a cheap chain 0-10-11-12-5 and
+	// a direct link that is shorter but left out of the MST
+	v := make([]*Vertex, 13)
+	for i := range v {
+		v[i] = &Vertex{ID: i}
+	}
+	g := NewGraph(false)
+	for i := 1; i <= 4; i++ {
+		g.AddEdge(Edge{From: v[0], To: v[i], Weight: 1})
+		g.AddEdge(Edge{From: v[5], To: v[5+i], Weight: 1})
+	}
+	for _, pair := range [][2]int{{0, 10}, {10, 11}, {11, 12}, {12, 5}} {
+		g.AddEdge(Edge{From: v[pair[0]], To: v[pair[1]], Weight: 1})
+	}
+	shortcut := g.AddEdge(Edge{From: v[0], To: v[5], Weight: 3})
+
+	r := g.BetweennessOverlap(3)
+	fmt.Print(r)
+	if len(r.Top) != 3 || r.Top[0].Edge != shortcut {
+		t.Fatalf("Expected the shortcut to carry the most paths, got %v", r.Top)
+	}
+	excluded := r.Excluded()
+	if len(excluded) != 1 || excluded[0].Edge != shortcut || r.InTree != 2 {
+		t.Errorf("Expected only the shortcut outside the MST, got %v", excluded)
+	}
+	if !strings.Contains(r.String(), "2 of 3 top edges are in the MST") {
+		t.Errorf("Expected a summary line, got\n%s", r)
+	}
+	if all := g.BetweennessOverlap(0); len(all.Top) != g.EdgeCount() {
+		t.Errorf("Expected every edge with top 0, got %d", len(all.Top))
+	}
+}
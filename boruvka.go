@@ -0,0 +1,101 @@
+package mst
+
+import (
+	"maps"
+	"slices"
+)
+
+// ==================== BORŮVKA ALGORITHM ====================
+
+// Boruvka finds MST using Borůvka's algorithm
+// Every round picks the cheapest edge leaving each component and adds all of
+// them at once, so the number of components at least halves per round and
+// the algorithm runs in O(E log V) without sorting the edges
+// Equal weights are broken by position in g.Edges, which keeps the chosen
+// edges cycle-free; disconnected graphs yield a minimum spanning forest
+func (g *Graph) Boruvka(opts ...Option) ([]*Edge, int) {
+	if g.Directed {
+		panic("Boruvka algorithm only works for undirected graphs")
+	}
+
+	o := newOptions(opts)
+	o.prepareConstraints(g)
+
+	m := o.startMetrics("boruvka")
+	defer m.finish()
+	m.startPhase("rounds")
+	began := o.logStart("boruvka", g)
+
+	uf := NewUnionFind()
+	for id := range g.Vertices {
+		uf.MakeSet(id)
+	}
+	mst, totalWeight, _, _ := g.boruvkaRounds(o, m, uf, nil)
+
+	o.logFinish("boruvka", g, mst, totalWeight, len(mst) < g.VertexCount()-1, began)
+	return mst, totalWeight
+}
+
+// boruvkaRounds runs Borůvka rounds over the components of uf until no edge
+// joins two of them, returning the edges added, their weight, and the number
+// of completed rounds
+// If stop is non-nil it is polled while scanning; once it reports true the
+// round in progress is discarded and interrupted is true, but every edge
+// returned so far still belongs to a minimum spanning tree
+func (g *Graph) boruvkaRounds(o *options, m *runMetrics, uf *UnionFind, stop func() bool) (mst []*Edge, totalWeight, rounds int, interrupted bool) {
+	c := o.constraints
+
+	// better orders edges by constraint priority, then weight, then position
+	better := func(i, j int) bool {
+		a, b := g.Edges[i], g.Edges[j]
+		if c.less(a, b) {
+			return true
+		}
+		return !c.less(b, a) && i < j
+	}
+
+	mst = make([]*Edge, 0)
+	cheapest := make(map[int]int)
+	for len(mst) < g.VertexCount()-1 {
+		if stop != nil && stop() {
+			return mst, totalWeight, rounds, true
+		}
+		clear(cheapest)
+		for i, edge := range g.Edges {
+			if stop != nil && i%stopInterval == stopInterval-1 && stop() {
+				return mst, totalWeight, rounds, true
+			}
+			m.count(CounterEdgesScanned)
+			if !c.allowed(edge) {
+				continue
+			}
+			ru, rv := uf.Find(edge.From.ID), uf.Find(edge.To.ID)
+			if ru == rv {
+				continue
+			}
+			o.traceEdge("boruvka", EventEdgeConsidered, edge)
+			for _, root := range [2]int{ru, rv} {
+				if best, seen := cheapest[root]; !seen || better(i, best) {
+					cheapest[root] = i
+				}
+			}
+		}
+		if len(cheapest) == 0 {
+			break
+		}
+
+		// Add in g.Edges order so results and traces are deterministic
+		picked := slices.Sorted(maps.Values(cheapest))
+		for _, i := range slices.Compact(picked) {
+			edge := g.Edges[i]
+			m.count(CounterUnions)
+			if uf.Union(edge.From.ID, edge.To.ID) {
+				o.traceEdge("boruvka", EventEdgeAccepted, edge)
+				mst = append(mst, edge)
+				totalWeight += edge.Weight
+			}
+		}
+		rounds++
+	}
+	return mst, totalWeight, rounds, false
+}
@@ -0,0 +1,36 @@
+package mst
+
+import (
+	"fmt"
+	"testing"
+)
+
+// TestBoruvka tests Borůvka's algorithm against Kruskal, including ties and forests
+func TestBoruvka(t *testing.T) {
+	fmt.Println("\n=== BORUVKA TEST ===")
+
+	g := buildCompleteGraph(40)
+	_, want := g.Kruskal()
+	mst, total := g.Boruvka()
+	if total != want || len(mst) != 39 {
+		t.Errorf("Expected total %d with 39 edges, got %d with %d", want, total, len(mst))
+	}
+
+	// All weights equal: tie-breaking by position must still avoid cycles
+	square := NewGraph(false)
+	v := []Vertex{{ID: 0}, {ID: 1}, {ID: 2}, {ID: 3}, {ID: 7}, {ID: 8}}
+	for _, p := range [][2]int{{0, 1}, {1, 2}, {2, 3}, {3, 0}, {0, 2}, {4, 5}} {
+		square.AddEdge(Edge{From: &v[p[0]], To: &v[p[1]], Weight: 1})
+	}
+	forest, total := square.Boruvka()
+	PrintMST(forest, total, "BORUVKA")
+	if len(forest) != 4 || total != 4 {
+		t.Errorf("Expected a 4-edge forest, got %d edges of total %d", len(forest), total)
+	}
+
+	recorder := NewMetricsRecorder()
+	square.Boruvka(WithMetrics(recorder), WithForbiddenEdges(square.Edges[0]))
+	if recorder.Count("boruvka", CounterUnions) == 0 {
+		t.Error("Expected union counts to be recorded")
+	}
+}
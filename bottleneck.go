@@ -0,0 +1,49 @@
+package mst
+
+// ==================== MINIMUM BOTTLENECK PATHS ====================
+
+// BottleneckPath returns the path between two vertices that minimizes the
+// maximum edge weight, together with that limiting edge
+// Such a path always lies on an MST, so it is read off the tree built by
+// Kruskal. Path edges are listed from u to v but keep their stored orientation
+// It returns false if the vertices are not connected or u == v
+// For many queries on the same graph, build a PathMaxIndex from the MST once
+func (g *Graph) BottleneckPath(u, v int) ([]*Edge, *Edge, bool) {
+	if u == v {
+		return nil, nil, false
+	}
+	mst, _ := g.Kruskal()
+	rf := rootForest(mst, u)
+	if !rf.contains(u) || !rf.contains(v) || rf.rootOf(u) != rf.rootOf(v) {
+		return nil, nil, false
+	}
+
+	path, bottleneck := rf.path(u, v)
+	return path, bottleneck, true
+}
+
+// path returns the tree edges between u and v in travel order and the heaviest of them
+func (rf *rootedForest) path(u, v int) ([]*Edge, *Edge) {
+	fromU := make([]*Edge, 0)
+	fromV := make([]*Edge, 0)
+	for u != v {
+		if rf.depth[u] >= rf.depth[v] {
+			fromU = append(fromU, rf.parentEdge[u])
+			u = rf.parent[u]
+		} else {
+			fromV = append(fromV, rf.parentEdge[v])
+			v = rf.parent[v]
+		}
+	}
+
+	path := fromU
+	for i := len(fromV) - 1; i >= 0; i-- {
+		path = append(path, fromV[i])
+	}
+
+	var bottleneck *Edge
+	for _, e := range path {
+		bottleneck = heavier(bottleneck, e)
+	}
+	return path, bottleneck
+}
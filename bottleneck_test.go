@@ -0,0 +1,50 @@
+package mst
+
+import (
+	"fmt"
+	"testing"
+)
+
+// TestBottleneckPath tests the weakest link between city pairs
+func TestBottleneckPath(t *testing.T) {
+	fmt.Println("\n=== BOTTLENECK PATH TEST ===")
+
+	g, _ := buildConstraintGraph()
+
+	path, bottleneck, ok := g.BottleneckPath(0, 5)
+	if !ok {
+		t.Fatal("Expected a path between 0 and 5")
+	}
+	for _, e := range path {
+		fmt.Printf("  %s\n", e)
+	}
+	fmt.Printf("✓ Limiting edge: %s\n", bottleneck)
+
+	// MST path 0-2-1-3-4-5 has weights 2, 1, 5, 2, 3
+	if len(path) != 5 {
+		t.Errorf("Expected 5 edges on the path, got %d", len(path))
+	}
+	if bottleneck.Weight != 5 {
+		t.Errorf("Expected bottleneck weight 5, got %d", bottleneck.Weight)
+	}
+
+	// Consecutive edges must share a vertex, starting at 0 and ending at 5
+	at := 0
+	for _, e := range path {
+		switch at {
+		case e.From.ID:
+			at = e.To.ID
+		case e.To.ID:
+			at = e.From.ID
+		default:
+			t.Fatalf("Edge %s does not continue the path at %d", e, at)
+		}
+	}
+	if at != 5 {
+		t.Errorf("Expected the path to end at 5, got %d", at)
+	}
+
+	if _, _, ok := g.BottleneckPath(0, 42); ok {
+		t.Error("Expected no path to a missing vertex")
+	}
+}
@@ -0,0 +1,245 @@
+package mst
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ==================== GRAPH BUILDER ====================
+
+// DuplicatePolicy decides what happens to repeated edges between the same vertices
+type DuplicatePolicy int
+
+const (
+	// DuplicateAllow keeps parallel edges
+	DuplicateAllow DuplicatePolicy = iota
+	// DuplicateError makes Build fail on a parallel edge
+	DuplicateError
+	// DuplicateKeepFirst silently drops every edge after the first one
+	DuplicateKeepFirst
+	// DuplicateKeepMin keeps only the lightest edge
+	DuplicateKeepMin
+)
+
+// SelfLoopPolicy decides what happens to edges from a vertex to itself
+type SelfLoopPolicy int
+
+const (
+	// SelfLoopAllow keeps self-loops
+	SelfLoopAllow SelfLoopPolicy = iota
+	// SelfLoopError makes Build fail on a self-loop
+	SelfLoopError
+	// SelfLoopIgnore silently drops self-loops
+	SelfLoopIgnore
+)
+
+// BuilderOption configures a Builder
+type BuilderOption func(*Builder)
+
+// WithDirected sets whether the built graph is directed
+func WithDirected(directed bool) BuilderOption {
+	return func(b *Builder) {
+		b.directed = directed
+	}
+}
+
+// WithCapacity hints the expected number of vertices and edges
+func WithCapacity(vertices, edges int) BuilderOption {
+	return func(b *Builder) {
+		b.vertexCap = vertices
+		b.edgeCap = edges
+	}
+}
+
+// WithDuplicateEdges sets the policy for parallel edges (default DuplicateAllow)
+func WithDuplicateEdges(policy DuplicatePolicy) BuilderOption {
+	return func(b *Builder) {
+		b.duplicates = policy
+	}
+}
+
+// WithSelfLoops sets the policy for self-loops (default SelfLoopAllow)
+func WithSelfLoops(policy SelfLoopPolicy) BuilderOption {
+	return func(b *Builder) {
+		b.selfLoops = policy
+	}
+}
+
+// WithWeightValidator rejects edges whose weight makes validate return an error
+func WithWeightValidator(validate func(weight int) error) BuilderOption {
+	return func(b *Builder) {
+		b.validateWeight = validate
+	}
+}
+
+// WithUniqueNames makes Build fail if two vertices share a non-empty name,
+// and keeps the constraint on in the built graph (see SetUniqueNames)
+func WithUniqueNames() BuilderOption {
+	return func(b *Builder) {
+		b.uniqueNames = true
+	}
+}
+
+// WithWeightUnit sets how the built graph displays weights (see Graph.Unit)
+func WithWeightUnit(unit WeightUnit) BuilderOption {
+	return func(b *Builder) {
+		b.unit = unit
+	}
+}
+
+// WithEdgeArena makes the built graph allocate its edges from arena (see Graph.SetEdgeArena)
+func WithEdgeArena(arena *EdgeArena) BuilderOption {
+	return func(b *Builder) {
+		b.arena = arena
+	}
+}
+
+// NonNegativeWeights is a weight validator that rejects negative weights
+func NonNegativeWeights(weight int) error {
+	if weight < 0 {
+		return fmt.Errorf("negative weight %d", weight)
+	}
+	return nil
+}
+
+// Builder collects vertices and edges and produces a validated Graph
+type Builder struct {
+	directed       bool
+	vertexCap      int
+	edgeCap        int
+	duplicates     DuplicatePolicy
+	selfLoops      SelfLoopPolicy
+	validateWeight func(weight int) error
+	uniqueNames    bool
+	unit           WeightUnit
+	arena          *EdgeArena
+
+	vertices map[int]*Vertex
+	order    []int // vertex IDs in insertion order
+	edges    []builderEdge
+}
+
+// builderEdge is an edge waiting for Build
+type builderEdge struct {
+	from, to, weight int
+	data             any
+}
+
+// NewBuilder creates a Builder configured by opts
+func NewBuilder(opts ...BuilderOption) *Builder {
+	b := &Builder{}
+	for _, opt := range opts {
+		opt(b)
+	}
+	b.vertices = make(map[int]*Vertex, b.vertexCap)
+	b.order = make([]int, 0, b.vertexCap)
+	b.edges = make([]builderEdge, 0, b.edgeCap)
+	return b
+}
+
+// AddVertex declares a vertex; declaring the same ID again updates its name and data
+func (b *Builder) AddVertex(id int, name string, data any) *Builder {
+	if v, exists := b.vertices[id]; exists {
+		v.Name = name
+		v.Data = data
+		return b
+	}
+	b.vertices[id] = &Vertex{ID: id, Name: name, Data: data}
+	b.order = append(b.order, id)
+	return b
+}
+
+// AddEdge declares an edge; endpoints that were never declared are created without a name
+func (b *Builder) AddEdge(fromID, toID, weight int, data any) *Builder {
+	b.edges = append(b.edges, builderEdge{from: fromID, to: toID, weight: weight, data: data})
+	return b
+}
+
+// Build validates every edge against the configured policies and returns the graph
+// All problems are reported together, joined into a single error
+func (b *Builder) Build() (Graph, error) {
+	var problems []error
+
+	kept := make([]builderEdge, 0, len(b.edges))
+	seen := make(map[pairKey]int, len(b.edges)) // pair -> index in kept
+	for i, e := range b.edges {
+		if b.validateWeight != nil {
+			if err := b.validateWeight(e.weight); err != nil {
+				problems = append(problems, fmt.Errorf("edge %d (%d-%d): %w", i, e.from, e.to, err))
+				continue
+			}
+		}
+
+		if e.from == e.to {
+			switch b.selfLoops {
+			case SelfLoopError:
+				problems = append(problems, fmt.Errorf("edge %d: self-loop on vertex %d", i, e.from))
+				continue
+			case SelfLoopIgnore:
+				continue
+			}
+		}
+
+		key := pairKey{a: e.from, b: e.to}
+		if !b.directed && key.a > key.b {
+			key.a, key.b = key.b, key.a
+		}
+		if j, exists := seen[key]; exists && b.duplicates != DuplicateAllow {
+			switch b.duplicates {
+			case DuplicateError:
+				problems = append(problems, fmt.Errorf("edge %d: duplicate edge %d-%d", i, e.from, e.to))
+			case DuplicateKeepMin:
+				if e.weight < kept[j].weight {
+					kept[j] = e
+				}
+			}
+			continue
+		}
+		seen[key] = len(kept)
+		kept = append(kept, e)
+	}
+
+	if b.uniqueNames {
+		owner := make(map[string]int, len(b.order))
+		for _, id := range b.order {
+			name := b.vertices[id].Name
+			if first, taken := owner[name]; taken && name != "" {
+				problems = append(problems, fmt.Errorf("vertex %d: %w %q (also vertex %d)", id, ErrDuplicateName, name, first))
+				continue
+			}
+			owner[name] = id
+		}
+	}
+
+	if len(problems) > 0 {
+		return Graph{}, errors.Join(problems...)
+	}
+
+	// Create vertices referenced only by edges
+	for _, e := range kept {
+		for _, id := range [2]int{e.from, e.to} {
+			if _, exists := b.vertices[id]; !exists {
+				b.AddVertex(id, "", nil)
+			}
+		}
+	}
+
+	g := NewGraph(b.directed)
+	g.Unit = b.unit
+	g.SetEdgeArena(b.arena)
+	g.Vertices = make(map[int]Vertex, len(b.vertices))
+	g.Edges = make([]*Edge, 0, len(kept))
+	for _, id := range b.order {
+		g.AddVertex(*b.vertices[id])
+	}
+	if b.uniqueNames {
+		g.SetUniqueNames(true)
+	}
+
+	batch := make([]Edge, len(kept))
+	for i, e := range kept {
+		batch[i] = Edge{From: b.vertices[e.from], To: b.vertices[e.to], Weight: e.weight, Data: e.data}
+	}
+	g.AddEdges(batch)
+	return g, nil
+}
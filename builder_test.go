@@ -0,0 +1,71 @@
+package mst
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// TestBuilder tests building a graph with policies and capacity hints
+func TestBuilder(t *testing.T) {
+	fmt.Println("\n=== GRAPH BUILDER TEST ===")
+
+	g, err := NewBuilder(
+		WithCapacity(4, 6),
+		WithDuplicateEdges(DuplicateKeepMin),
+		WithSelfLoops(SelfLoopIgnore),
+		WithWeightValidator(NonNegativeWeights),
+	).
+		AddVertex(0, "Istanbul", nil).
+		AddVertex(1, "Ankara", nil).
+		AddVertex(2, "Izmir", nil).
+		AddEdge(0, 1, 450, nil).
+		AddEdge(1, 0, 400, nil). // duplicate, cheaper
+		AddEdge(0, 2, 330, nil).
+		AddEdge(2, 2, 1, nil).   // self-loop, dropped
+		AddEdge(2, 3, 500, nil). // vertex 3 created implicitly
+		Build()
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+	g.Print()
+
+	if g.VertexCount() != 4 || g.EdgeCount() != 3 {
+		t.Errorf("Expected 4 vertices and 3 edges, got %d and %d", g.VertexCount(), g.EdgeCount())
+	}
+	if _, w := g.Kruskal(); w != 1230 {
+		t.Errorf("Expected MST weight 1230, got %d", w)
+	}
+	if v, _ := g.GetVertex(0); v.Name != "Istanbul" {
+		t.Errorf("Expected vertex 0 to be Istanbul, got %q", v.Name)
+	}
+}
+
+// TestBuilderErrors tests that every violation is reported
+func TestBuilderErrors(t *testing.T) {
+	_, err := NewBuilder(
+		WithDirected(true),
+		WithDuplicateEdges(DuplicateError),
+		WithSelfLoops(SelfLoopError),
+		WithWeightValidator(NonNegativeWeights),
+	).
+		AddEdge(0, 1, 1, nil).
+		AddEdge(1, 0, 1, nil). // not a duplicate in a directed graph
+		AddEdge(0, 1, 2, nil).
+		AddEdge(3, 3, 1, nil).
+		AddEdge(1, 2, -4, nil).
+		Build()
+
+	if err == nil {
+		t.Fatal("Expected Build to fail")
+	}
+	fmt.Println(err)
+	for _, want := range []string{"duplicate edge 0-1", "self-loop on vertex 3", "negative weight -4"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("Expected error to mention %q", want)
+		}
+	}
+	if strings.Contains(err.Error(), "duplicate edge 1-0") {
+		t.Error("Expected reverse edge in a directed graph not to be a duplicate")
+	}
+}
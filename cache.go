@@ -0,0 +1,121 @@
+package mst
+
+import "slices"
+
+// ==================== CACHED MST ====================
+
+// CachedMST keeps the last MST of a graph and follows the graph's mutations
+// Isolated vertices and non-tree deletions leave the cached tree valid,
+// weight changes and new edges are repaired in place with UpdateMST, and
+// anything else marks the cache dirty so the next MST call runs Kruskal again
+type CachedMST struct {
+	g      *Graph
+	opts   []Option
+	repair bool // false when options make UpdateMST disagree with Kruskal
+
+	mst    []*Edge
+	weight int
+	valid  bool
+
+	recomputes int
+	repairs    int
+	cancel     func()
+}
+
+// NewCachedMST starts caching the MST of g computed with Kruskal(opts...)
+// Call Close to stop following g once the cache is no longer needed. With
+// constraints or tie breakers such as WithSecondaryCriterion every edge
+// change recomputes instead of being repaired
+func NewCachedMST(g *Graph, opts ...Option) *CachedMST {
+	o := newOptions(opts)
+	c := &CachedMST{
+		g:    g,
+		opts: opts,
+		// UpdateMST knows neither constraints nor tie breakers, so with
+		// either it could keep a tree that Kruskal(opts...) would not pick
+		repair: len(o.required) == 0 && len(o.forbidden) == 0 && len(o.tieBreakers) == 0 && o.weight == nil,
+	}
+	c.cancel = g.Observe(c)
+	return c
+}
+
+// MST returns the cached tree and its weight, recomputing it only when dirty
+// The returned slice is a copy and may be modified freely
+func (c *CachedMST) MST() ([]*Edge, int) {
+	if !c.valid {
+		c.mst, c.weight = c.g.Kruskal(c.opts...)
+		c.valid = true
+		c.recomputes++
+	}
+	return slices.Clone(c.mst), c.weight
+}
+
+// Invalidate forces the next MST call to recompute
+func (c *CachedMST) Invalidate() {
+	c.valid = false
+}
+
+// Recomputes returns how many times the MST was computed from scratch
+func (c *CachedMST) Recomputes() int {
+	return c.recomputes
+}
+
+// Repairs returns how many mutations were absorbed by an incremental repair
+func (c *CachedMST) Repairs() int {
+	return c.repairs
+}
+
+// Close stops following the graph; the cache stays usable but is never refreshed
+func (c *CachedMST) Close() {
+	if c.cancel != nil {
+		c.cancel()
+		c.cancel = nil
+	}
+	c.valid = false
+}
+
+// OnMutation updates the cache for a single graph change
+func (c *CachedMST) OnMutation(m Mutation) {
+	if m.Kind == MutationGraphCleared {
+		// Drop the old tree: its edges may be reused by the graph's arena
+		c.mst, c.weight, c.valid = nil, 0, false
+		return
+	}
+	if !c.valid {
+		return
+	}
+
+	switch m.Kind {
+	case MutationVertexAdded, MutationVertexWeightChanged, MutationVertexDataChanged, MutationVertexNameChanged, MutationVertexAttrChanged:
+		// No isolated vertex, vertex weight, data, name or attribute changes the tree
+	case MutationEdgeCriterionChanged, MutationEdgeAttrChanged:
+		// Named criteria and attributes only matter to tie breakers and a
+		// criterion chosen with KruskalBy, which are exactly what disables repair
+		if !c.repair {
+			c.valid = false
+		}
+	case MutationEdgeRemoved:
+		if c.inTree(m.Edge) {
+			c.valid = false
+		}
+	case MutationEdgeAdded, MutationEdgeWeightChanged:
+		if !c.repair || c.g.Directed {
+			c.valid = false
+			return
+		}
+		c.mst, c.weight = c.g.UpdateMST(c.mst, m.Edge)
+		c.repairs++
+	default:
+		c.valid = false
+	}
+}
+
+// inTree reports whether edge or its reverse copy is part of the cached tree
+func (c *CachedMST) inTree(edge *Edge) bool {
+	for _, e := range c.mst {
+		if e == edge || (edge.twin != nil && e == edge.twin) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,158 @@
+package mst
+
+import (
+	"cmp"
+	"fmt"
+	"testing"
+)
+
+// TestCachedMST tests that the cache follows mutations without recomputing
+func TestCachedMST(t *testing.T) {
+	fmt.Println("\n=== CACHED MST TEST ===")
+
+	g := NewGraph(false)
+	v := make([]Vertex, 5)
+	for i := range v {
+		v[i] = Vertex{ID: i}
+		g.AddVertex(v[i])
+	}
+	g.AddEdge(Edge{From: &v[0], To: &v[1], Weight: 4})
+	bc := g.AddEdge(Edge{From: &v[1], To: &v[2], Weight: 2})
+	ac := g.AddEdge(Edge{From: &v[0], To: &v[2], Weight: 3})
+	g.AddEdge(Edge{From: &v[2], To: &v[3], Weight: 6})
+
+	cache := NewCachedMST(&g)
+	defer cache.Close()
+
+	check := func(label string) {
+		t.Helper()
+		_, got := cache.MST()
+		_, want := g.Kruskal()
+		if got != want {
+			t.Errorf("%s: expected weight %d, got %d", label, want, got)
+		}
+	}
+
+	check("initial")
+	cache.MST()
+	if cache.Recomputes() != 1 {
+		t.Errorf("Expected 1 recompute, got %d", cache.Recomputes())
+	}
+
+	g.AddEdge(Edge{From: &v[3], To: &v[4], Weight: 1})
+	check("edge added")
+	g.AddEdge(Edge{From: &v[1], To: &v[3], Weight: 5})
+	check("cheaper edge added")
+	g.SetEdgeWeight(bc, 10)
+	check("tree edge heavier")
+	g.AddVertex(Vertex{ID: 9})
+	check("isolated vertex")
+	if cache.Recomputes() != 1 || cache.Repairs() != 3 {
+		t.Errorf("Expected 1 recompute and 3 repairs, got %d and %d", cache.Recomputes(), cache.Repairs())
+	}
+
+	g.RemoveEdge(ac)
+	check("tree edge removed")
+	if cache.Recomputes() != 2 {
+		t.Errorf("Expected removal to trigger a recompute, got %d", cache.Recomputes())
+	}
+}
+
+// TestCachedMSTTieBreakers tests that tie breakers make edge changes
+// recompute, so equal-weight choices match Kruskal
+func TestCachedMSTTieBreakers(t *testing.T) {
+	fmt.Println("\n=== CACHED MST TIE BREAKER TEST ===")
+
+	g := NewGraph(false)
+	v := make([]Vertex, 3)
+	for i := range v {
+		v[i] = Vertex{ID: i}
+	}
+	ab := g.AddEdge(Edge{From: &v[0], To: &v[1], Weight: 1, Weights: map[string]int{"latency": 5}})
+	g.AddEdge(Edge{From: &v[1], To: &v[2], Weight: 1, Weights: map[string]int{"latency": 5}})
+
+	opts := []Option{WithSecondaryCriterion("latency")}
+	cache := NewCachedMST(&g, opts...)
+	defer cache.Close()
+	cache.MST()
+
+	// Same weight as the tree edges, but a lower latency breaks the tie
+	g.AddEdge(Edge{From: &v[0], To: &v[2], Weight: 1, Weights: map[string]int{"latency": 1}})
+	got, _ := cache.MST()
+	want, _ := g.Kruskal(opts...)
+	if len(got) != len(want) {
+		t.Fatalf("Expected %d edges, got %d", len(want), len(got))
+	}
+	for _, e := range want {
+		if !containsPair(got, e.From.ID, e.To.ID) {
+			t.Errorf("Expected edge %d-%d from Kruskal in the cached tree, got %v", e.From.ID, e.To.ID, got)
+		}
+	}
+	if cache.Repairs() != 0 || cache.Recomputes() != 2 {
+		t.Errorf("Expected a recompute instead of a repair, got %d repairs and %d recomputes", cache.Repairs(), cache.Recomputes())
+	}
+	if containsPair(got, ab.From.ID, ab.To.ID) && containsPair(got, 1, 2) {
+		t.Error("Expected the low-latency edge to replace one of the tied edges")
+	}
+}
+
+// TestCachedMSTCriterionChange tests that changing a tie-breaking criterion
+// with SetEdgeWeightBy invalidates the cached tree
+func TestCachedMSTCriterionChange(t *testing.T) {
+	g := NewGraph(false)
+	v := make([]Vertex, 3)
+	for i := range v {
+		v[i] = Vertex{ID: i}
+	}
+	g.AddEdge(Edge{From: &v[0], To: &v[1], Weight: 1, Weights: map[string]int{"latency": 1}})
+	g.AddEdge(Edge{From: &v[1], To: &v[2], Weight: 1, Weights: map[string]int{"latency": 1}})
+	ac := g.AddEdge(Edge{From: &v[0], To: &v[2], Weight: 1, Weights: map[string]int{"latency": 9}})
+
+	opts := []Option{WithSecondaryCriterion("latency")}
+	cache := NewCachedMST(&g, opts...)
+	defer cache.Close()
+	if got, _ := cache.MST(); containsPair(got, 0, 2) {
+		t.Fatalf("Expected the high-latency edge outside the tree, got %v", got)
+	}
+
+	g.SetEdgeWeightBy(ac, "latency", 0)
+	got, _ := cache.MST()
+	if !containsPair(got, 0, 2) {
+		t.Errorf("Expected the now low-latency edge in the tree, got %v", got)
+	}
+	if cache.Recomputes() != 2 {
+		t.Errorf("Expected the criterion change to trigger a recompute, got %d", cache.Recomputes())
+	}
+}
+
+// TestCachedMSTAttrChange tests that changing an attribute a tie breaker
+// reads with SetEdgeAttr invalidates the cached tree
+func TestCachedMSTAttrChange(t *testing.T) {
+	g := NewGraph(false)
+	v := make([]Vertex, 3)
+	for i := range v {
+		v[i] = Vertex{ID: i}
+	}
+	g.AddEdge(Edge{From: &v[0], To: &v[1], Weight: 1, Attrs: Attributes{"rank": 1}})
+	g.AddEdge(Edge{From: &v[1], To: &v[2], Weight: 1, Attrs: Attributes{"rank": 1}})
+	ac := g.AddEdge(Edge{From: &v[0], To: &v[2], Weight: 1, Attrs: Attributes{"rank": 9}})
+
+	byRank := func(a, b *Edge) int {
+		x, _ := a.GetFloat("rank")
+		y, _ := b.GetFloat("rank")
+		return cmp.Compare(x, y)
+	}
+	cache := NewCachedMST(&g, WithTieBreakers(byRank))
+	defer cache.Close()
+	if got, _ := cache.MST(); containsPair(got, 0, 2) {
+		t.Fatalf("Expected the high-rank edge outside the tree, got %v", got)
+	}
+
+	g.SetEdgeAttr(ac, "rank", 0)
+	if got, _ := cache.MST(); !containsPair(got, 0, 2) {
+		t.Errorf("Expected the now low-rank edge in the tree, got %v", got)
+	}
+	if cache.Recomputes() != 2 {
+		t.Errorf("Expected the attribute change to trigger a recompute, got %d", cache.Recomputes())
+	}
+}
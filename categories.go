@@ -0,0 +1,359 @@
+package mst
+
+import (
+	"fmt"
+	"slices"
+)
+
+// ==================== EDGE CATEGORY CONSTRAINTS ====================
+
+// AttrCategory is the edge attribute holding its category, such as
+// "fiber", "leased", or "microwave"; set it with Edge.SetAttr
+const AttrCategory = "category"
+
+// Category returns the category label of an edge, or "" if it has none
+func (e *Edge) Category() string {
+	c, _ := e.GetString(AttrCategory)
+	return c
+}
+
+// categoryRule distinguishes the kinds of CategoryConstraint
+type categoryRule int
+
+const (
+	ruleAtMost categoryRule = iota
+	ruleAtLeast
+	ruleAtLeastPerVertex
+)
+
+// CategoryConstraint limits how many edges of one category a tree uses
+type CategoryConstraint struct {
+	rule     categoryRule
+	category string
+	count    int
+}
+
+// AtMostOfCategory allows at most n tree edges of a category
+func AtMostOfCategory(category string, n int) CategoryConstraint {
+	return CategoryConstraint{rule: ruleAtMost, category: category, count: n}
+}
+
+// AtLeastOfCategory requires at least n tree edges of a category
+func AtLeastOfCategory(category string, n int) CategoryConstraint {
+	return CategoryConstraint{rule: ruleAtLeast, category: category, count: n}
+}
+
+// AtLeastPerVertex requires every vertex to have at least n tree edges of a
+// category, e.g. one fiber uplink per site
+func AtLeastPerVertex(category string, n int) CategoryConstraint {
+	return CategoryConstraint{rule: ruleAtLeastPerVertex, category: category, count: n}
+}
+
+func (c CategoryConstraint) String() string {
+	switch c.rule {
+	case ruleAtMost:
+		return fmt.Sprintf("at most %d %q edges", c.count, c.category)
+	case ruleAtLeast:
+		return fmt.Sprintf("at least %d %q edges", c.count, c.category)
+	}
+	return fmt.Sprintf("at least %d %q edges per vertex", c.count, c.category)
+}
+
+// CategoryConstrainedMST finds a minimum spanning forest whose use of edge
+// categories satisfies every constraint, and returns it with its weight
+// Upper bounds alone are solved exactly: trees with at most n edges per
+// category are the common bases of the graphic and a partition matroid,
+// found by weighted matroid intersection. Lower bounds are then met
+// heuristically by swapping a tree edge for the non-tree edge of the
+// needed category that raises the weight least, while keeping every upper
+// bound. If the constraints cannot be met, an error wrapping
+// ErrInfeasibleConstraints is returned with the best-effort tree
+func (g *Graph) CategoryConstrainedMST(constraints ...CategoryConstraint) ([]*Edge, int, error) {
+	if g.Directed {
+		panic("CategoryConstrainedMST only works for undirected graphs")
+	}
+
+	caps := make(map[string]int)
+	lower := make([]CategoryConstraint, 0)
+	for _, c := range constraints {
+		if c.rule != ruleAtMost {
+			lower = append(lower, c)
+			continue
+		}
+		if limit, seen := caps[c.category]; !seen || c.count < limit {
+			caps[c.category] = c.count
+		}
+	}
+
+	tree, _ := g.Kruskal()
+	if !withinCaps(tree, caps) {
+		var spanning bool
+		tree, spanning = g.cappedSpanningForest(caps, len(tree))
+		if !spanning {
+			return tree, GetMSTWeight(tree), fmt.Errorf("%w: category limits leave no spanning tree", ErrInfeasibleConstraints)
+		}
+	}
+	tree, err := g.meetLowerBounds(tree, caps, lower)
+	return tree, GetMSTWeight(tree), err
+}
+
+// withinCaps reports whether a set of edges respects every category cap
+func withinCaps(edges []*Edge, caps map[string]int) bool {
+	used := make(map[string]int)
+	for _, e := range edges {
+		c := e.Category()
+		used[c]++
+		if limit, capped := caps[c]; capped && used[c] > limit {
+			return false
+		}
+	}
+	return true
+}
+
+// cappedSpanningForest runs weighted matroid intersection between the
+// graphic matroid of g and the partition matroid given by caps
+// Each augmentation along a shortest path of the exchange graph yields a
+// minimum-weight common independent set one edge larger, so stopping at
+// size edges gives a minimum spanning forest within the caps. It reports
+// whether that size was reached
+func (g *Graph) cappedSpanningForest(caps map[string]int, size int) ([]*Edge, bool) {
+	edges := g.Edges
+	m := len(edges)
+	inSet := make([]bool, m)
+	for chosen := 0; chosen < size; chosen++ {
+		var members []int
+		used := make(map[string]int)
+		for i, in := range inSet {
+			if in {
+				members = append(members, i)
+				used[edges[i].Category()]++
+			}
+		}
+		forest := newForestPaths(edges, members)
+		full := func(c string) bool {
+			limit, capped := caps[c]
+			return capped && used[c] >= limit
+		}
+
+		// Exchange graph arcs: member y -> outsider x when swapping keeps a
+		// forest, outsider x -> member y when it keeps the caps
+		arcs := make([][]int, m)
+		source := make([]bool, m)
+		sink := make([]bool, m)
+		for x := range m {
+			if inSet[x] {
+				continue
+			}
+			cycle, acyclic := forest.path(edges[x])
+			source[x] = acyclic
+			sink[x] = !full(edges[x].Category())
+			for _, y := range cycle {
+				arcs[y] = append(arcs[y], x)
+			}
+			for _, y := range members {
+				if sink[x] || edges[y].Category() == edges[x].Category() {
+					arcs[x] = append(arcs[x], y)
+				}
+			}
+		}
+
+		path := shortestExchangePath(edges, inSet, arcs, source, sink)
+		if path == nil {
+			return memberEdges(edges, inSet), false
+		}
+		for _, i := range path {
+			inSet[i] = !inSet[i]
+		}
+	}
+	return memberEdges(edges, inSet), true
+}
+
+// shortestExchangePath finds a source-to-sink path of least total length,
+// and fewest arcs among those, where outsiders cost their weight and
+// members the negated weight; it returns nil when no sink is reachable
+func shortestExchangePath(edges []*Edge, inSet []bool, arcs [][]int, source, sink []bool) []int {
+	type label struct{ dist, hops int }
+	better := func(a, b label) bool {
+		return a.dist < b.dist || (a.dist == b.dist && a.hops < b.hops)
+	}
+	length := func(i int) int {
+		if inSet[i] {
+			return -edges[i].Weight
+		}
+		return edges[i].Weight
+	}
+
+	m := len(edges)
+	labels := make([]label, m)
+	reached := make([]bool, m)
+	prev := make([]int, m)
+	queued := make([]bool, m)
+	queue := make([]int, 0)
+	for i := range m {
+		if source[i] {
+			labels[i], reached[i], prev[i] = label{dist: length(i)}, true, -1
+			queue = append(queue, i)
+			queued[i] = true
+		}
+	}
+	// The exchange graph has no negative cycles, so a label-correcting
+	// search terminates
+	for len(queue) > 0 {
+		i := queue[0]
+		queue = queue[1:]
+		queued[i] = false
+		for _, j := range arcs[i] {
+			next := label{dist: labels[i].dist + length(j), hops: labels[i].hops + 1}
+			if !reached[j] || better(next, labels[j]) {
+				labels[j], reached[j], prev[j] = next, true, i
+				if !queued[j] {
+					queue = append(queue, j)
+					queued[j] = true
+				}
+			}
+		}
+	}
+
+	end := -1
+	for i := range m {
+		if sink[i] && reached[i] && (end < 0 || better(labels[i], labels[end])) {
+			end = i
+		}
+	}
+	if end < 0 {
+		return nil
+	}
+	path := []int{}
+	for i := end; i >= 0; i = prev[i] {
+		path = append(path, i)
+	}
+	return path
+}
+
+func memberEdges(edges []*Edge, inSet []bool) []*Edge {
+	members := make([]*Edge, 0)
+	for i, in := range inSet {
+		if in {
+			members = append(members, edges[i])
+		}
+	}
+	return members
+}
+
+// forestPaths answers path queries in a forest given by edge indices
+type forestPaths struct {
+	adjacent map[int][]forestArc
+}
+
+type forestArc struct {
+	to, edge int
+}
+
+func newForestPaths(edges []*Edge, members []int) *forestPaths {
+	f := &forestPaths{adjacent: make(map[int][]forestArc)}
+	for _, i := range members {
+		a, b := edges[i].From.ID, edges[i].To.ID
+		f.adjacent[a] = append(f.adjacent[a], forestArc{to: b, edge: i})
+		f.adjacent[b] = append(f.adjacent[b], forestArc{to: a, edge: i})
+	}
+	return f
+}
+
+// path returns the forest edges joining the endpoints of e, or reports
+// that they lie in different trees
+func (f *forestPaths) path(e *Edge) ([]int, bool) {
+	from, to := e.From.ID, e.To.ID
+	if from == to {
+		return nil, false
+	}
+	via := map[int]forestArc{from: {to: -1, edge: -1}}
+	queue := []int{from}
+	for len(queue) > 0 {
+		v := queue[0]
+		if v == to {
+			break
+		}
+		queue = queue[1:]
+		for _, arc := range f.adjacent[v] {
+			if _, seen := via[arc.to]; !seen {
+				via[arc.to] = forestArc{to: v, edge: arc.edge}
+				queue = append(queue, arc.to)
+			}
+		}
+	}
+	if _, found := via[to]; !found {
+		return nil, true
+	}
+	path := make([]int, 0)
+	for v := to; v != from; v = via[v].to {
+		path = append(path, via[v].edge)
+	}
+	return path, false
+}
+
+// meetLowerBounds swaps edges into the tree until every lower bound holds
+// Each step adds the non-tree edge and drops the edge on the cycle it
+// closes that reduce the total shortfall while raising the weight least
+func (g *Graph) meetLowerBounds(tree []*Edge, caps map[string]int, lower []CategoryConstraint) ([]*Edge, error) {
+	shortfall := func(edges []*Edge) int {
+		total := 0
+		count := make(map[string]int)
+		perVertex := make(map[string]map[int]int)
+		for _, e := range edges {
+			c := e.Category()
+			count[c]++
+			if perVertex[c] == nil {
+				perVertex[c] = make(map[int]int)
+			}
+			perVertex[c][e.From.ID]++
+			perVertex[c][e.To.ID]++
+		}
+		for _, rule := range lower {
+			if rule.rule == ruleAtLeast {
+				total += max(0, rule.count-count[rule.category])
+				continue
+			}
+			for id := range g.Vertices {
+				total += max(0, rule.count-perVertex[rule.category][id])
+			}
+		}
+		return total
+	}
+
+	missing := shortfall(tree)
+	for missing > 0 {
+		inTree := make(map[*Edge]bool, len(tree))
+		indices := make([]int, len(tree))
+		for i, e := range tree {
+			inTree[e] = true
+			indices[i] = i
+		}
+		forest := newForestPaths(tree, indices)
+
+		bestAdd, bestDrop, bestCost, bestMissing := -1, -1, 0, missing
+		for i, e := range g.Edges {
+			if inTree[e] || inTree[e.twin] {
+				continue
+			}
+			cycle, _ := forest.path(e)
+			for _, j := range cycle {
+				candidate := slices.Clone(tree)
+				candidate[j] = e
+				if !withinCaps(candidate, caps) {
+					continue
+				}
+				after := shortfall(candidate)
+				cost := e.Weight - tree[j].Weight
+				if after < missing && (bestAdd < 0 || cost < bestCost || (cost == bestCost && after < bestMissing)) {
+					bestAdd, bestDrop, bestCost, bestMissing = i, j, cost, after
+				}
+			}
+		}
+		if bestAdd < 0 {
+			return tree, fmt.Errorf("%w: %d category requirements left unmet", ErrInfeasibleConstraints, missing)
+		}
+		tree[bestDrop] = g.Edges[bestAdd]
+		missing = bestMissing
+	}
+	return tree, nil
+}
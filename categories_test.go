@@ -0,0 +1,98 @@
+package mst
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// categorize labels the edges of g in turn with the given categories
+func categorize(g *Graph, categories ...string) {
+	for i, e := range g.Edges {
+		e.SetAttr(AttrCategory, categories[i%len(categories)])
+	}
+}
+
+// countCategory returns how many edges of a category a tree uses
+func countCategory(tree []*Edge, category string) int {
+	n := 0
+	for _, e := range tree {
+		if e.Category() == category {
+			n++
+		}
+	}
+	return n
+}
+
+// TestCategoryCapsExact tests upper bounds against every spanning tree
+func TestCategoryCapsExact(t *testing.T) {
+	fmt.Println("\n=== CATEGORY CAPS TEST ===")
+
+	g := buildCompleteGraph(6)
+	categorize(&g, "fiber", "leased", "radio", "leased")
+
+	for _, caps := range [][2]int{{0, 5}, {1, 1}, {2, 0}, {5, 5}} {
+		best := -1
+		for tree := range g.AllSpanningTrees(0) {
+			if countCategory(tree, "leased") <= caps[0] && countCategory(tree, "radio") <= caps[1] {
+				if w := GetMSTWeight(tree); best < 0 || w < best {
+					best = w
+				}
+			}
+		}
+
+		tree, total, err := g.CategoryConstrainedMST(AtMostOfCategory("leased", caps[0]), AtMostOfCategory("radio", caps[1]))
+		if err != nil {
+			t.Fatal(err)
+		}
+		fmt.Printf("leased <= %d, radio <= %d: weight %d (best %d)\n", caps[0], caps[1], total, best)
+		if total != best || !validSpanningTree(g, tree) {
+			t.Errorf("Expected the optimum %d, got %d", best, total)
+		}
+		if countCategory(tree, "leased") > caps[0] || countCategory(tree, "radio") > caps[1] {
+			t.Errorf("Expected the caps %v to hold", caps)
+		}
+	}
+
+	// Only four of the fifteen edges are fiber, too few to span six vertices
+	if _, _, err := g.CategoryConstrainedMST(AtMostOfCategory("leased", 0), AtMostOfCategory("radio", 0)); !errors.Is(err, ErrInfeasibleConstraints) {
+		t.Errorf("Expected ErrInfeasibleConstraints, got %v", err)
+	}
+}
+
+// TestCategoryLowerBounds tests per-vertex and total category requirements
+func TestCategoryLowerBounds(t *testing.T) {
+	fmt.Println("\n=== CATEGORY LOWER BOUNDS TEST ===")
+
+	// A cheap copper ring with an expensive fiber star around hub 0
+	g := NewGraph(false)
+	v := func(id int) *Vertex { return &Vertex{ID: id} }
+	for i := range 5 {
+		g.AddEdge(Edge{From: v(i), To: v((i + 1) % 5), Weight: 1}).SetAttr(AttrCategory, "copper")
+	}
+	for i := 1; i < 5; i++ {
+		g.AddEdge(Edge{From: v(0), To: v(i), Weight: 5}).SetAttr(AttrCategory, "fiber")
+	}
+
+	tree, total, err := g.CategoryConstrainedMST(AtLeastPerVertex("fiber", 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	PrintMST(tree, total, "ONE FIBER LINK PER SITE")
+	if total != 20 || countCategory(tree, "fiber") != 4 || !validSpanningTree(g, tree) {
+		t.Errorf("Expected the fiber star of weight 20, got weight %d", total)
+	}
+
+	tree, total, err = g.CategoryConstrainedMST(AtLeastOfCategory("fiber", 2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 12 || countCategory(tree, "fiber") != 2 {
+		t.Errorf("Expected two fiber links and weight 12, got %d fiber links and weight %d", countCategory(tree, "fiber"), total)
+	}
+
+	_, _, err = g.CategoryConstrainedMST(AtLeastOfCategory("fiber", 3), AtMostOfCategory("fiber", 2))
+	if !errors.Is(err, ErrInfeasibleConstraints) {
+		t.Errorf("Expected ErrInfeasibleConstraints for contradictory bounds, got %v", err)
+	}
+}
@@ -0,0 +1,56 @@
+package mst
+
+import "sort"
+
+// ==================== MST CLUSTERING ====================
+
+// CutHeaviest removes the k-1 heaviest edges from an MST and returns the
+// resulting vertex groups, which is single-linkage clustering into k clusters
+// Each group is sorted by vertex ID and groups are ordered by their smallest ID
+// Only vertices that appear in the MST edges are included; if k exceeds the
+// number of vertices every vertex becomes its own group
+func CutHeaviest(mst []*Edge, k int) [][]int {
+	edges := make([]*Edge, len(mst))
+	copy(edges, mst)
+	sort.SliceStable(edges, func(i, j int) bool {
+		return edges[i].Weight < edges[j].Weight
+	})
+
+	cuts := k - 1
+	if cuts < 0 {
+		cuts = 0
+	}
+	if cuts > len(edges) {
+		cuts = len(edges)
+	}
+	kept := edges[:len(edges)-cuts]
+
+	uf := NewUnionFind()
+	for _, e := range edges {
+		uf.MakeSet(e.From.ID)
+		uf.MakeSet(e.To.ID)
+	}
+	for _, e := range kept {
+		uf.Union(e.From.ID, e.To.ID)
+	}
+	return groupsOf(uf)
+}
+
+// groupsOf lists the sets of a UnionFind, sorted within and between groups
+func groupsOf(uf *UnionFind) [][]int {
+	byRoot := make(map[int][]int)
+	for id := range uf.parent {
+		root := uf.Find(id)
+		byRoot[root] = append(byRoot[root], id)
+	}
+
+	groups := make([][]int, 0, len(byRoot))
+	for _, group := range byRoot {
+		sort.Ints(group)
+		groups = append(groups, group)
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i][0] < groups[j][0]
+	})
+	return groups
+}
@@ -0,0 +1,33 @@
+package mst
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+// TestCutHeaviest tests splitting an MST into k clusters
+func TestCutHeaviest(t *testing.T) {
+	fmt.Println("\n=== CUT HEAVIEST TEST ===")
+
+	g, _ := buildConstraintGraph()
+	mst, _ := g.Kruskal()
+
+	// MST edges: 1-2 (1), 0-2 (2), 3-4 (2), 4-5 (3), 1-3 (5)
+	cases := []struct {
+		k        int
+		expected [][]int
+	}{
+		{1, [][]int{{0, 1, 2, 3, 4, 5}}},
+		{2, [][]int{{0, 1, 2}, {3, 4, 5}}},
+		{3, [][]int{{0, 1, 2}, {3, 4}, {5}}},
+		{10, [][]int{{0}, {1}, {2}, {3}, {4}, {5}}},
+	}
+	for _, c := range cases {
+		groups := CutHeaviest(mst, c.k)
+		fmt.Printf("  k=%d: %v\n", c.k, groups)
+		if !reflect.DeepEqual(groups, c.expected) {
+			t.Errorf("k=%d: expected %v, got %v", c.k, c.expected, groups)
+		}
+	}
+}
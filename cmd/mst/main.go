@@ -0,0 +1,45 @@
+// Command mst explores graphs and spanning trees from the terminal
+//
+//	mst repl [file]
+//
+// starts an interactive shell, optionally loading a snapshot, node-link
+// JSON, or edge list file first; type "help" at the prompt for commands
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+const usage = `usage: mst <command> [arguments]
+
+commands:
+  repl [file]   start an interactive shell, optionally loading a graph
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	switch os.Args[1] {
+	case "repl":
+		s := newSession(os.Stdout)
+		if len(os.Args) > 2 {
+			if err := s.exec("load " + os.Args[2]); err != nil {
+				fmt.Fprintln(os.Stderr, "mst:", err)
+				os.Exit(1)
+			}
+		}
+		if err := s.run(os.Stdin, true); err != nil {
+			fmt.Fprintln(os.Stderr, "mst:", err)
+			os.Exit(1)
+		}
+	case "help", "-h", "--help":
+		fmt.Print(usage)
+	default:
+		fmt.Fprintf(os.Stderr, "mst: unknown command %q\n\n%s", os.Args[1], usage)
+		os.Exit(2)
+	}
+}
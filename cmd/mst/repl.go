@@ -0,0 +1,311 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/l00pss/mst"
+)
+
+// errQuit ends the session
+var errQuit = errors.New("quit")
+
+// session is the state of one interactive shell
+type session struct {
+	g    mst.Graph
+	last *mst.MSTResult // most recent run, highlighted by save to DOT
+	out  io.Writer
+}
+
+// command is a shell command with its usage line
+type command struct {
+	args string
+	help string
+	run  func(s *session, args []string) error
+}
+
+var commands map[string]command
+
+func init() {
+	// Assigned here because help refers back to the table
+	commands = map[string]command{
+		"help":       {"", "list commands", (*session).help},
+		"new":        {"[directed]", "start an empty graph", (*session).newGraph},
+		"load":       {"<file>", "read a snapshot or node-link .json file, or an edge list", (*session).load},
+		"save":       {"<file> [nodelink]", "write a .json snapshot (or node-link) or a .dot drawing of the last tree", (*session).save},
+		"add":        {"<from> <to> [weight]", "add an edge, creating missing vertices", (*session).add},
+		"remove":     {"<from> <to> | <vertex>", "remove an edge, or a vertex with its edges", (*session).remove},
+		"weight":     {"<from> <to> <weight>", "change the weight of an edge", (*session).weight},
+		"name":       {"<vertex> <name>", "name a vertex", (*session).name},
+		"show":       {"", "list vertices and edges", (*session).show},
+		"info":       {"", "summarize the graph", (*session).info},
+		"algorithms": {"", "list the algorithms run accepts", (*session).algorithms},
+		"run":        {"[algorithm]", "compute a spanning tree, with kruskal by default", (*session).runAlgorithm},
+		"compare":    {"", "run kruskal, prim, and boruvka and compare them", (*session).compare},
+		"quit":       {"", "leave the shell", func(*session, []string) error { return errQuit }},
+	}
+}
+
+func newSession(out io.Writer) *session {
+	return &session{g: mst.NewGraph(false), out: out}
+}
+
+// run reads commands from r until it ends or quit is entered
+// Errors from commands are printed and the session continues
+func (s *session) run(r io.Reader, prompt bool) error {
+	sc := bufio.NewScanner(r)
+	for {
+		if prompt {
+			fmt.Fprint(s.out, "mst> ")
+		}
+		if !sc.Scan() {
+			return sc.Err()
+		}
+		switch err := s.exec(sc.Text()); {
+		case errors.Is(err, errQuit):
+			return nil
+		case err != nil:
+			fmt.Fprintln(s.out, "error:", err)
+		}
+	}
+}
+
+// exec runs one command line; blank lines and # comments do nothing
+func (s *session) exec(line string) error {
+	fields := strings.Fields(line)
+	if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+		return nil
+	}
+	name := fields[0]
+	if name == "exit" {
+		name = "quit"
+	}
+	cmd, ok := commands[name]
+	if !ok {
+		return fmt.Errorf("unknown command %q, try help", fields[0])
+	}
+	return cmd.run(s, fields[1:])
+}
+
+func (s *session) help([]string) error {
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		cmd := commands[name]
+		fmt.Fprintf(s.out, "  %-30s %s\n", strings.TrimSpace(name+" "+cmd.args), cmd.help)
+	}
+	return nil
+}
+
+func (s *session) newGraph(args []string) error {
+	directed := len(args) > 0 && args[0] == "directed"
+	s.g, s.last = mst.NewGraph(directed), nil
+	return nil
+}
+
+func (s *session) load(args []string) error {
+	if len(args) != 1 {
+		return errors.New("usage: load <file>")
+	}
+	f, err := os.Open(args[0])
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var g mst.Graph
+	if filepath.Ext(args[0]) == ".json" {
+		// Untagged node-link documents are read as version 1 snapshots
+		if g, err = mst.ReadSnapshot(f); err != nil {
+			return err
+		}
+	} else {
+		g = mst.NewGraph(false)
+		for rec, err := range mst.ReadEdgeList(f) {
+			if err != nil {
+				return err
+			}
+			g.AddEdge(mst.Edge{From: &mst.Vertex{ID: rec.From}, To: &mst.Vertex{ID: rec.To}, Weight: rec.Weight})
+		}
+	}
+	s.g, s.last = g, nil
+	fmt.Fprintf(s.out, "loaded %d vertices and %d edges\n", g.VertexCount(), g.EdgeCount())
+	return nil
+}
+
+func (s *session) save(args []string) error {
+	if len(args) < 1 || len(args) > 2 {
+		return errors.New("usage: save <file> [nodelink]")
+	}
+	f, err := os.Create(args[0])
+	if err != nil {
+		return err
+	}
+	switch {
+	case filepath.Ext(args[0]) == ".dot":
+		var tree []*mst.Edge
+		if s.last != nil {
+			tree = s.last.Edges
+		}
+		err = s.g.WriteDOTHighlighted(f, tree)
+	case len(args) == 2 && args[1] == "nodelink":
+		err = s.g.WriteNodeLink(f)
+	case len(args) == 2:
+		err = fmt.Errorf("unknown format %q", args[1])
+	default:
+		err = s.g.WriteSnapshot(f)
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		fmt.Fprintln(s.out, "saved", args[0])
+	}
+	return err
+}
+
+// ints parses vertex IDs and weights
+func ints(args []string) ([]int, error) {
+	values := make([]int, len(args))
+	for i, arg := range args {
+		v, err := strconv.Atoi(arg)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not an integer", arg)
+		}
+		values[i] = v
+	}
+	return values, nil
+}
+
+func (s *session) add(args []string) error {
+	if len(args) < 2 || len(args) > 3 {
+		return errors.New("usage: add <from> <to> [weight]")
+	}
+	v, err := ints(append(args, "1")[:3])
+	if err != nil {
+		return err
+	}
+	s.g.AddEdge(mst.Edge{From: &mst.Vertex{ID: v[0]}, To: &mst.Vertex{ID: v[1]}, Weight: v[2]})
+	s.last = nil
+	return nil
+}
+
+func (s *session) remove(args []string) error {
+	v, err := ints(args)
+	switch {
+	case err != nil:
+		return err
+	case len(v) == 1:
+		if !s.g.RemoveVertex(v[0]) {
+			return fmt.Errorf("vertex %d: %w", v[0], mst.ErrVertexNotFound)
+		}
+	case len(v) == 2:
+		e, ok := s.g.GetEdge(v[0], v[1])
+		if !ok {
+			return fmt.Errorf("edge %d-%d: %w", v[0], v[1], mst.ErrEdgeNotFound)
+		}
+		s.g.RemoveEdge(e)
+	default:
+		return errors.New("usage: remove <from> <to> | <vertex>")
+	}
+	s.last = nil
+	return nil
+}
+
+func (s *session) weight(args []string) error {
+	v, err := ints(args)
+	if err != nil {
+		return err
+	}
+	if len(v) != 3 {
+		return errors.New("usage: weight <from> <to> <weight>")
+	}
+	e, ok := s.g.GetEdge(v[0], v[1])
+	if !ok {
+		return fmt.Errorf("edge %d-%d: %w", v[0], v[1], mst.ErrEdgeNotFound)
+	}
+	s.g.SetEdgeWeight(e, v[2])
+	s.last = nil
+	return nil
+}
+
+func (s *session) name(args []string) error {
+	if len(args) != 2 {
+		return errors.New("usage: name <vertex> <name>")
+	}
+	id, err := strconv.Atoi(args[0])
+	if err != nil {
+		return fmt.Errorf("%q is not an integer", args[0])
+	}
+	return s.g.SetVertexName(id, args[1])
+}
+
+func (s *session) show([]string) error {
+	for _, id := range s.g.SortedVertexIDs() {
+		fmt.Fprintln(s.out, strings.TrimSpace(fmt.Sprintf("vertex %d %s", id, s.g.Vertices[id].Name)))
+	}
+	arrow := "-"
+	if s.g.Directed {
+		arrow = "->"
+	}
+	for _, e := range s.g.Edges {
+		fmt.Fprintf(s.out, "edge %d%s%d weight %d\n", e.From.ID, arrow, e.To.ID, e.Weight)
+	}
+	return nil
+}
+
+func (s *session) info([]string) error {
+	kind := "undirected"
+	if s.g.Directed {
+		kind = "directed"
+	}
+	fmt.Fprintf(s.out, "%s graph: %d vertices, %d edges", kind, s.g.VertexCount(), s.g.EdgeCount())
+	if !s.g.Directed && s.g.VertexCount() > 0 {
+		fmt.Fprintf(s.out, ", connected: %v", s.g.IsConnected())
+	}
+	fmt.Fprintln(s.out)
+	return nil
+}
+
+func (s *session) algorithms([]string) error {
+	fmt.Fprintln(s.out, strings.Join(mst.Algorithms(), " "))
+	return nil
+}
+
+func (s *session) runAlgorithm(args []string) error {
+	name := mst.AlgorithmKruskal
+	if len(args) > 0 {
+		name = args[0]
+	}
+	if s.g.Directed {
+		return errors.New("spanning trees need an undirected graph, start one with new")
+	}
+	result, err := s.g.Run(name)
+	if err != nil {
+		return err
+	}
+	s.last = &result
+	for _, e := range result.Edges {
+		fmt.Fprintf(s.out, "  %d-%d weight %d\n", e.From.ID, e.To.ID, e.Weight)
+	}
+	fmt.Fprintln(s.out, result)
+	return nil
+}
+
+func (s *session) compare([]string) error {
+	if s.g.Directed {
+		return errors.New("spanning trees need an undirected graph, start one with new")
+	}
+	fmt.Fprint(s.out, s.g.CompareAlgorithms())
+	return nil
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestREPL tests a scripted session: building, editing, running, saving, and loading
+func TestREPL(t *testing.T) {
+	fmt.Println("\n=== REPL TEST ===")
+
+	dir := t.TempDir()
+	snapshot := filepath.Join(dir, "g.json")
+	drawing := filepath.Join(dir, "g.dot")
+	script := strings.Join([]string{
+		"# a square with one diagonal",
+		"add 0 1 4",
+		"add 1 2 2",
+		"add 2 3 3",
+		"add 3 0 5",
+		"add 0 2",
+		"weight 3 0 9",
+		"name 0 hub",
+		"info",
+		"run",
+		"save " + drawing,
+		"remove 0 2",
+		"run prim",
+		"save " + snapshot,
+		"new",
+		"load " + snapshot,
+		"show",
+		"run simulated_annealing",
+		"remove 7",
+		"frobnicate",
+		"quit",
+		"info",
+	}, "\n")
+
+	var out bytes.Buffer
+	s := newSession(&out)
+	if err := s.run(strings.NewReader(script), false); err != nil {
+		t.Fatal(err)
+	}
+	fmt.Print(out.String())
+
+	for _, want := range []string{
+		"undirected graph: 4 vertices, 5 edges, connected: true",
+		"kruskal: 3 edges, total 6",
+		"prim: 3 edges, total 9",
+		"loaded 4 vertices and 4 edges",
+		"vertex 0 hub",
+		"edge 3-0 weight 9",
+		`error: unknown MST algorithm: "simulated_annealing"`,
+		"error: vertex 7: vertex not found",
+		`error: unknown command "frobnicate"`,
+	} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("Expected the output to contain %q", want)
+		}
+	}
+	if strings.Count(out.String(), "undirected graph") != 1 {
+		t.Error("Expected quit to end the session")
+	}
+
+	dot, err := os.ReadFile(drawing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Count(string(dot), "color=\"red\"") != 3 {
+		t.Errorf("Expected the DOT drawing to highlight the 3 tree edges:\n%s", dot)
+	}
+}
+
+// TestREPLHelp tests that help lists every command
+func TestREPLHelp(t *testing.T) {
+	fmt.Println("\n=== REPL HELP TEST ===")
+
+	var out bytes.Buffer
+	if err := newSession(&out).exec("help"); err != nil {
+		t.Fatal(err)
+	}
+	for name := range commands {
+		if !strings.Contains(out.String(), "  "+name) {
+			t.Errorf("Expected help to list %q", name)
+		}
+	}
+}
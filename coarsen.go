@@ -0,0 +1,286 @@
+package mst
+
+import (
+	"cmp"
+	"math/bits"
+	"slices"
+)
+
+// ==================== MULTILEVEL MST ====================
+
+// MultilevelReport summarizes a MultilevelMST run
+// The returned tree weighs at most ErrorBound more than an exact MST
+type MultilevelReport struct {
+	Levels     int   // coarsening levels performed
+	Vertices   []int // vertex count before coarsening and after each level
+	Contracted int   // tree edges fixed by matching rather than by the coarse MST
+	Refined    int   // weight removed by the refinement pass
+	Weight     int   // total weight of the returned tree
+	ErrorBound int   // upper bound on Weight minus the exact MST weight
+}
+
+// levelEdge is an edge between two groups of one coarsening level
+// It holds no pointers, so the large per-level slices cost the garbage collector nothing
+type levelEdge struct {
+	w    int   // weight, kept inline for cache-friendly scans
+	a, b int32 // group indices at the current level
+	i    int32 // position in g.Edges
+}
+
+// MultilevelMST trades exactness for speed on very large graphs
+// Each of up to levels rounds coarsens the graph by heavy-edge matching:
+// groups are visited in order and each unmatched one is merged with the
+// unmatched neighbor it has the strongest link to, which for MST means the
+// lightest edge; the matched edges enter the tree. The MST of the coarsest
+// graph is then mapped back to original edges
+// A matched edge is only suboptimal when its group has a lighter edge to an
+// already matched neighbor, and by the cut property it then costs at most
+// the difference of the two weights; those differences add up to the error
+// bound. The refinement pass spans the tree plus those missed lighter edges
+// again, so it costs O(V log V) however many edges the graph has
+// Every level is one linear pass over the remaining edges and the coarsest
+// graph's edges are radix sorted by value rather than through their *Edge.
+// Matching rarely removes many edges from sparse graphs, so one or two
+// levels are usually the fastest setting there
+// Self-loops are ignored and disconnected graphs yield a spanning forest
+func (g *Graph) MultilevelMST(levels int) ([]*Edge, MultilevelReport) {
+	if g.Directed {
+		panic("Kruskal algorithm only works for undirected graphs")
+	}
+
+	ids := g.SortedVertexIDs()
+	indexOf := func(id int) int32 { return int32(id) }
+	if len(ids) > 0 && (ids[0] != 0 || ids[len(ids)-1] != len(ids)-1) {
+		// IDs are not already 0..n-1, so number them through a map
+		index := make(map[int]int32, len(ids))
+		for i, id := range ids {
+			index[id] = int32(i)
+		}
+		indexOf = func(id int) int32 { return index[id] }
+	}
+	edges := make([]levelEdge, 0, len(g.Edges))
+	for i, e := range g.Edges {
+		if a, b := indexOf(e.From.ID), indexOf(e.To.ID); a != b {
+			edges = append(edges, levelEdge{w: e.Weight, a: a, b: b, i: int32(i)})
+		}
+	}
+	spare := make([]levelEdge, len(edges))
+	var scratch matchScratch
+
+	n := len(ids)
+	report := MultilevelReport{Vertices: []int{n}}
+	tree := make([]*Edge, 0, max(n-1, 0))
+	var missed []*Edge
+	bound := 0
+	for report.Levels < levels {
+		m := heavyEdgeMatching(g.Edges, edges, n, &scratch)
+		if len(m.matched) == 0 {
+			break
+		}
+		tree = append(tree, m.matched...)
+		missed = append(missed, m.missed...)
+		bound += m.bound
+		edges, spare = coarseEdges(edges, spare[:cap(spare)], m.next, m.groups), edges
+		n = m.groups
+		report.Levels++
+		report.Vertices = append(report.Vertices, n)
+	}
+	report.Contracted = len(tree)
+
+	// Coarsest MST, whose edges are already the original edges between groups
+	sortLevelEdges(edges, spare)
+	uf := NewDenseUnionFind(n)
+	for _, le := range edges {
+		if uf.Union(int(le.a), int(le.b)) {
+			tree = append(tree, g.Edges[le.i])
+		}
+	}
+
+	// Refinement: the MST of the tree plus the missed edges can only improve it
+	before := GetMSTWeight(tree)
+	if bound > 0 {
+		candidates := append(slices.Clone(tree), missed...)
+		sortEdgesByWeight(candidates)
+		uf.Reset(len(ids))
+		tree = tree[:0]
+		for _, e := range candidates {
+			if uf.Union(int(indexOf(e.From.ID)), int(indexOf(e.To.ID))) {
+				tree = append(tree, e)
+			}
+		}
+	}
+
+	report.Weight = GetMSTWeight(tree)
+	report.Refined = before - report.Weight
+	report.ErrorBound = max(bound-report.Refined, 0)
+	return tree, report
+}
+
+// levelMatching is the result of one round of heavy-edge matching
+type levelMatching struct {
+	matched []*Edge // edges merging two groups
+	missed  []*Edge // lighter edges that matched groups could not take
+	bound   int     // total weight the matched edges may cost over an MST
+	next    []int32 // group of the next level for every current group
+	groups  int     // number of groups of the next level
+}
+
+// matchScratch keeps the adjacency arrays of heavyEdgeMatching between levels
+type matchScratch struct {
+	offsets, fill []int32
+	arcs          []levelEdge // a is unused, b is the neighboring group
+}
+
+// heavyEdgeMatching matches every group, in ascending index order, with the
+// unmatched neighboring group it shares the lightest edge with
+func heavyEdgeMatching(all []*Edge, edges []levelEdge, n int, s *matchScratch) levelMatching {
+	offsets := growInt32s(&s.offsets, n+1)
+	for _, le := range edges {
+		offsets[le.a+1]++
+		offsets[le.b+1]++
+	}
+	for i := 1; i <= n; i++ {
+		offsets[i] += offsets[i-1]
+	}
+	if cap(s.arcs) < 2*len(edges) {
+		s.arcs = make([]levelEdge, 2*len(edges))
+	}
+	arcs := s.arcs[:2*len(edges)]
+	fill := growInt32s(&s.fill, n)
+	copy(fill, offsets[:n])
+	for _, le := range edges {
+		arcs[fill[le.a]] = levelEdge{w: le.w, b: le.b, i: le.i}
+		fill[le.a]++
+		arcs[fill[le.b]] = levelEdge{w: le.w, b: le.a, i: le.i}
+		fill[le.b]++
+	}
+
+	m := levelMatching{next: make([]int32, n)}
+	for i := range m.next {
+		m.next[i] = -1
+	}
+	for a := range int32(n) {
+		if m.next[a] >= 0 {
+			continue
+		}
+		var best, lightest *levelEdge
+		for k := offsets[a]; k < offsets[a+1]; k++ {
+			arc := &arcs[k]
+			if lightest == nil || arc.w < lightest.w {
+				lightest = arc
+			}
+			if m.next[arc.b] < 0 && (best == nil || arc.w < best.w) {
+				best = arc
+			}
+		}
+		m.next[a] = int32(m.groups)
+		if best != nil {
+			m.next[best.b] = int32(m.groups)
+			m.matched = append(m.matched, all[best.i])
+			if lightest.w < best.w {
+				m.missed = append(m.missed, all[lightest.i])
+				m.bound += best.w - lightest.w
+			}
+		}
+		m.groups++
+	}
+	return m
+}
+
+// coarseEdges maps edges onto the groups of the next level and keeps the
+// lightest edge between every pair of groups, writing the result to dst
+// Edges inside a group are dropped; the first of equally light edges wins
+func coarseEdges(edges, dst []levelEdge, next []int32, groups int) []levelEdge {
+	// Bucket the edges by their lower group, then dedupe each bucket in place
+	offsets := make([]int32, groups+1)
+	for i := range edges {
+		le := &edges[i]
+		le.a, le.b = next[le.a], next[le.b]
+		if le.a > le.b {
+			le.a, le.b = le.b, le.a
+		}
+		if le.a != le.b {
+			offsets[le.a+1]++
+		}
+	}
+	for i := 1; i <= groups; i++ {
+		offsets[i] += offsets[i-1]
+	}
+	fill := slices.Clone(offsets[:groups])
+	for _, le := range edges {
+		if le.a != le.b {
+			dst[fill[le.a]] = le
+			fill[le.a]++
+		}
+	}
+
+	slot := fill // position of the kept a-b edge, valid while seen[b] == a
+	seen := make([]int32, groups)
+	for i := range seen {
+		seen[i] = -1
+	}
+	kept := dst[:0]
+	for a := range int32(groups) {
+		for _, le := range dst[offsets[a]:offsets[a+1]] {
+			if seen[le.b] == a {
+				if le.w < kept[slot[le.b]].w {
+					kept[slot[le.b]] = le
+				}
+				continue
+			}
+			seen[le.b], slot[le.b] = a, int32(len(kept))
+			kept = append(kept, le)
+		}
+	}
+	return kept
+}
+
+// sortLevelEdges orders edges by ascending weight with an LSD radix sort on
+// 8-bit digits of the offset from the minimum weight, using buffer, which
+// must be at least as long, as scratch space
+// Level edges are sorted in place rather than through their *Edge, which
+// keeps every pass sequential
+func sortLevelEdges(edges, buffer []levelEdge) {
+	if len(edges) < radixThreshold {
+		slices.SortStableFunc(edges, func(x, y levelEdge) int {
+			return cmp.Compare(x.w, y.w)
+		})
+		return
+	}
+	lo, hi := edges[0].w, edges[0].w
+	for _, le := range edges {
+		lo = min(lo, le.w)
+		hi = max(hi, le.w)
+	}
+	span := uint64(hi) - uint64(lo)
+
+	src, dst := edges, buffer[:len(edges)]
+	for shift := 0; shift < bits.Len64(span); shift += 8 {
+		var count [257]int
+		for _, le := range src {
+			count[(uint64(le.w)-uint64(lo))>>shift&0xff+1]++
+		}
+		for d := 1; d < len(count); d++ {
+			count[d] += count[d-1]
+		}
+		for _, le := range src {
+			d := (uint64(le.w) - uint64(lo)) >> shift & 0xff
+			dst[count[d]] = le
+			count[d]++
+		}
+		src, dst = dst, src
+	}
+	if &src[0] != &edges[0] {
+		copy(edges, src)
+	}
+}
+
+// growInt32s returns (*buf)[:n] zeroed, reallocating only when it is too small
+func growInt32s(buf *[]int32, n int) []int32 {
+	if cap(*buf) < n {
+		*buf = make([]int32, n)
+	}
+	s := (*buf)[:n]
+	clear(s)
+	return s
+}
@@ -0,0 +1,76 @@
+package mst
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+)
+
+// TestMultilevelMST tests that the multilevel tree spans and honors its error bound
+func TestMultilevelMST(t *testing.T) {
+	fmt.Println("\n=== MULTILEVEL MST TEST ===")
+
+	rng := rand.New(rand.NewPCG(7, 11))
+	g := NewGraph(false)
+	const n = 200
+	v := make([]Vertex, n)
+	for i := range v {
+		v[i] = Vertex{ID: i}
+	}
+	for i := 1; i < n; i++ {
+		g.AddEdge(Edge{From: &v[rng.IntN(i)], To: &v[i], Weight: rng.IntN(100)})
+	}
+	for range 4 * n {
+		a, b := rng.IntN(n), rng.IntN(n)
+		g.AddEdge(Edge{From: &v[a], To: &v[b], Weight: rng.IntN(100)})
+	}
+
+	_, exact := g.Kruskal()
+	for _, levels := range []int{0, 1, 3, 10} {
+		tree, report := g.MultilevelMST(levels)
+		fmt.Printf("  levels=%d vertices=%v weight=%d (exact %d) bound=%d refined=%d\n",
+			report.Levels, report.Vertices, report.Weight, exact, report.ErrorBound, report.Refined)
+
+		if len(tree) != n-1 {
+			t.Fatalf("levels=%d: expected %d edges, got %d", levels, n-1, len(tree))
+		}
+		uf := NewUnionFind()
+		for _, e := range tree {
+			uf.MakeSet(e.From.ID)
+			uf.MakeSet(e.To.ID)
+			if !uf.Union(e.From.ID, e.To.ID) {
+				t.Fatalf("levels=%d: tree contains a cycle", levels)
+			}
+		}
+		if report.Weight != GetMSTWeight(tree) || report.Weight < exact {
+			t.Errorf("levels=%d: inconsistent weight %d (exact %d)", levels, report.Weight, exact)
+		}
+		if report.Weight-exact > report.ErrorBound {
+			t.Errorf("levels=%d: error %d exceeds bound %d", levels, report.Weight-exact, report.ErrorBound)
+		}
+		if levels == 0 && report.ErrorBound != 0 {
+			t.Errorf("Without coarsening the tree should be exact, bound %d", report.ErrorBound)
+		}
+	}
+}
+
+// BenchmarkMultilevelMST benchmarks one coarsening level on 1M edges, to
+// compare with BenchmarkKruskalParallelSort
+func BenchmarkMultilevelMST(b *testing.B) {
+	rng := rand.New(rand.NewPCG(7, 8))
+	g := NewGraph(false)
+	vertices := make([]Vertex, 100000)
+	for i := range vertices {
+		vertices[i] = Vertex{ID: i}
+	}
+	edges := make([]Edge, 1000000)
+	for i := range edges {
+		edges[i] = Edge{From: &vertices[rng.IntN(len(vertices))], To: &vertices[rng.IntN(len(vertices))], Weight: rng.IntN(1 << 30)}
+	}
+	g.AddEdges(edges)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		g.MultilevelMST(1)
+	}
+}
@@ -0,0 +1,33 @@
+package mst
+
+import (
+	"io"
+	"maps"
+)
+
+// ==================== CODECS ====================
+
+// Codec reads and writes whole graphs in one serialization format
+// NodeLink and SnapshotCodec are codecs; each documents what it does not keep,
+// such as Vertex Data
+type Codec interface {
+	Encode(w io.Writer, g *Graph) error
+	Decode(r io.Reader) (Graph, error)
+}
+
+var (
+	_ Codec = NodeLink{}
+	_ Codec = SnapshotCodec{}
+)
+
+// codecs are the built-in formats by name
+var codecs = map[string]Codec{
+	"nodelink": NodeLink{},
+	"snapshot": SnapshotCodec{},
+}
+
+// Codecs returns the built-in graph formats by name, for tools that pick
+// a format from user input and for tests covering every format
+func Codecs() map[string]Codec {
+	return maps.Clone(codecs)
+}
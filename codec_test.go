@@ -0,0 +1,37 @@
+package mst
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+)
+
+// TestCodecs tests that every built-in codec reads back what it writes
+func TestCodecs(t *testing.T) {
+	fmt.Println("\n=== CODECS TEST ===")
+
+	codecs := Codecs()
+	if len(codecs) != 2 || codecs["nodelink"] == nil || codecs["snapshot"] == nil {
+		t.Fatalf("Expected the nodelink and snapshot codecs, got %v", codecs)
+	}
+	delete(codecs, "nodelink")
+	if _, ok := Codecs()["nodelink"]; !ok {
+		t.Error("Expected Codecs to return a copy")
+	}
+
+	g := buildCompleteGraph(5)
+	for name, codec := range Codecs() {
+		var buf bytes.Buffer
+		if err := codec.Encode(&buf, &g); err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		decoded, err := codec.Decode(&buf)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		fmt.Printf("%s: %d vertices, %d edges\n", name, decoded.VertexCount(), decoded.EdgeCount())
+		if decoded.Hash() != g.Hash() {
+			t.Errorf("%s: expected the decoded graph to hash like the original", name)
+		}
+	}
+}
@@ -0,0 +1,173 @@
+package mst
+
+import (
+	"math/rand/v2"
+	"slices"
+)
+
+// ==================== COMMUNITY DETECTION ====================
+
+// maxPropagationRounds bounds LabelPropagation on graphs where labels
+// keep oscillating
+const maxPropagationRounds = 100
+
+// Modularity scores a division of the vertices into communities as the
+// fraction of edges inside communities minus the fraction expected if
+// edges were placed at random with the same degrees
+// Values near 0 mean no structure and values above 0.3 usually mark clear
+// communities. Every edge counts once whatever its weight, as weights here
+// are costs rather than tie strengths; vertices missing from communities
+// count as singletons
+func (g *Graph) Modularity(communities [][]int) float64 {
+	if g.Directed {
+		panic("Modularity only works for undirected graphs")
+	}
+	m := float64(len(g.Edges))
+	if m == 0 {
+		return 0
+	}
+	community := make(map[int]int, len(g.Vertices))
+	for c, members := range communities {
+		for _, id := range members {
+			community[id] = c
+		}
+	}
+	label := func(id int) int {
+		if c, ok := community[id]; ok {
+			return c
+		}
+		return len(communities) + id
+	}
+
+	inside := make(map[int]float64)
+	degree := make(map[int]float64)
+	for _, e := range g.Edges {
+		a, b := label(e.From.ID), label(e.To.ID)
+		degree[a]++
+		degree[b]++
+		if a == b {
+			inside[a]++
+		}
+	}
+	q := 0.0
+	for c, d := range degree {
+		q += inside[c]/m - (d/(2*m))*(d/(2*m))
+	}
+	return q
+}
+
+// LabelPropagation finds communities by letting every vertex repeatedly
+// adopt the label most common among its neighbors, until no label changes
+// Vertices are visited in a random order every round and ties are broken
+// at random, keeping the current label when it is among the most common,
+// so the result depends on rng; a nil rng uses a randomly seeded one. It
+// runs in near-linear time per round and usually settles in a few rounds.
+// Groups are sorted like CutHeaviest
+func (g *Graph) LabelPropagation(rng *rand.Rand) [][]int {
+	if g.Directed {
+		panic("LabelPropagation only works for undirected graphs")
+	}
+	if rng == nil {
+		rng = rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64()))
+	}
+
+	order := g.SortedVertexIDs()
+	labels := make(map[int]int, len(order))
+	for _, id := range order {
+		labels[id] = id
+	}
+	counts := make(map[int]int)
+	best := make([]int, 0)
+	for round := 0; round < maxPropagationRounds; round++ {
+		rng.Shuffle(len(order), func(i, j int) { order[i], order[j] = order[j], order[i] })
+		changed := false
+		for _, id := range order {
+			clear(counts)
+			top := 0
+			for _, e := range g.Vertices[id].Edges {
+				if e.To.ID == id {
+					continue
+				}
+				l := labels[e.To.ID]
+				counts[l]++
+				top = max(top, counts[l])
+			}
+			if top == 0 || counts[labels[id]] == top {
+				continue
+			}
+			best = best[:0]
+			for l, c := range counts {
+				if c == top {
+					best = append(best, l)
+				}
+			}
+			// Map order is random; sort so that rng alone decides
+			slices.Sort(best)
+			labels[id] = best[rng.IntN(len(best))]
+			changed = true
+		}
+		if !changed {
+			break
+		}
+	}
+
+	uf := NewUnionFind()
+	representative := make(map[int]int)
+	for _, id := range g.SortedVertexIDs() {
+		uf.MakeSet(id)
+		if r, ok := representative[labels[id]]; ok {
+			uf.Union(r, id)
+		} else {
+			representative[labels[id]] = id
+		}
+	}
+	return groupsOf(uf)
+}
+
+// GirvanNewman finds communities by repeatedly removing the edge with the
+// highest EdgeBetweenness, and returns the split into connected components
+// with the highest Modularity seen along the way, with that score
+// Betweenness is recomputed after every removal, so it takes O(E²·V log V)
+// and suits graphs of up to a few hundred vertices. How edges are weighted
+// follows EdgeBetweenness. Groups are sorted like CutHeaviest
+func (g *Graph) GirvanNewman() ([][]int, float64) {
+	if g.Directed {
+		panic("GirvanNewman only works for undirected graphs")
+	}
+	work, _ := g.filterEdges(func(*Edge) bool { return true }, true)
+
+	best := work.componentGroups()
+	bestScore := g.Modularity(best)
+	for count := len(best); len(work.Edges) > 0; {
+		betweenness := work.EdgeBetweenness()
+		busiest := work.Edges[0]
+		for _, e := range work.Edges[1:] {
+			if betweenness[e] > betweenness[busiest] {
+				busiest = e
+			}
+		}
+		work.RemoveEdge(busiest)
+
+		groups := work.componentGroups()
+		if len(groups) == count {
+			continue
+		}
+		count = len(groups)
+		if score := g.Modularity(groups); score > bestScore {
+			best, bestScore = groups, score
+		}
+	}
+	return best, bestScore
+}
+
+// componentGroups lists the connected components, sorted like CutHeaviest
+func (g *Graph) componentGroups() [][]int {
+	uf := NewUnionFind()
+	for id := range g.Vertices {
+		uf.MakeSet(id)
+	}
+	for _, e := range g.Edges {
+		uf.Union(e.From.ID, e.To.ID)
+	}
+	return groupsOf(uf)
+}
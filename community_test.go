@@ -0,0 +1,101 @@
+package mst
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"reflect"
+	"testing"
+)
+
+// buildCliquePair returns two 5-cliques, 0-4 and 5-9, joined by the edge 4-5
+func buildCliquePair() Graph {
+	g := NewGraph(false)
+	v := make([]*Vertex, 10)
+	for i := range v {
+		v[i] = &Vertex{ID: i}
+	}
+	for _, base := range []int{0, 5} {
+		for i := base; i < base+5; i++ {
+			for j := i + 1; j < base+5; j++ {
+				g.AddEdge(Edge{From: v[i], To: v[j], Weight: 1})
+			}
+		}
+	}
+	g.AddEdge(Edge{From: v[4], To: v[5], Weight: 1})
+	return g
+}
+
+// TestModularity tests scores of known divisions
+func TestModularity(t *testing.T) {
+	fmt.Println("\n=== MODULARITY TEST ===")
+
+	g := buildCliquePair()
+	split := [][]int{{0, 1, 2, 3, 4}, {5, 6, 7, 8, 9}}
+	// 20 of 21 edges inside, each half holding 41 of the 42 edge ends
+	want := 20.0/21 - 2*(21.0/42)*(21.0/42)
+	if q := g.Modularity(split); math.Abs(q-want) > 1e-12 {
+		t.Errorf("Expected modularity %v, got %v", want, q)
+	}
+	if q := g.Modularity([][]int{{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}}); math.Abs(q) > 1e-12 {
+		t.Errorf("Expected 0 for a single community, got %v", q)
+	}
+	if q := g.Modularity(nil); q >= 0 {
+		t.Errorf("Expected singletons to score below 0, got %v", q)
+	}
+	fmt.Printf("Two cliques: modularity %.3f\n", g.Modularity(split))
+}
+
+// TestLabelPropagation tests that labels settle on the two cliques
+func TestLabelPropagation(t *testing.T) {
+	fmt.Println("\n=== LABEL PROPAGATION TEST ===")
+
+	// Cliques always end up whole, and usually apart; on graphs this small
+	// a label can occasionally flood across the bridge
+	g := buildCliquePair()
+	want := [][]int{{0, 1, 2, 3, 4}, {5, 6, 7, 8, 9}}
+	found := 0
+	for seed := range uint64(20) {
+		groups := g.LabelPropagation(rand.New(rand.NewPCG(seed, 1)))
+		if reflect.DeepEqual(groups, want) {
+			found++
+		} else if len(groups) != 1 {
+			t.Errorf("Seed %d: expected whole cliques, got %v", seed, groups)
+		}
+	}
+	fmt.Printf("Split found with %d of 20 seeds\n", found)
+	if found < 10 {
+		t.Errorf("Expected most seeds to find the split, got %d of 20", found)
+	}
+
+	// Isolated vertices keep their own label
+	g.AddVertex(Vertex{ID: 20})
+	groups := g.LabelPropagation(nil)
+	if last := groups[len(groups)-1]; !reflect.DeepEqual(last, []int{20}) {
+		t.Errorf("Expected the isolated vertex alone, got %v", groups)
+	}
+}
+
+// TestGirvanNewman tests that removing the bridge gives the best split
+func TestGirvanNewman(t *testing.T) {
+	fmt.Println("\n=== GIRVAN-NEWMAN TEST ===")
+
+	g := buildCliquePair()
+	groups, q := g.GirvanNewman()
+	fmt.Printf("Communities %v, modularity %.3f\n", groups, q)
+	want := [][]int{{0, 1, 2, 3, 4}, {5, 6, 7, 8, 9}}
+	if !reflect.DeepEqual(groups, want) {
+		t.Errorf("Expected %v, got %v", want, groups)
+	}
+	if math.Abs(q-g.Modularity(want)) > 1e-12 {
+		t.Errorf("Expected the reported score to match Modularity, got %v", q)
+	}
+	if g.EdgeCount() != 21 {
+		t.Errorf("Expected the graph to keep its edges, got %d", g.EdgeCount())
+	}
+
+	empty := NewGraph(false)
+	if groups, q := empty.GirvanNewman(); len(groups) != 0 || q != 0 {
+		t.Errorf("Expected nothing for an empty graph, got %v, %v", groups, q)
+	}
+}
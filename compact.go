@@ -0,0 +1,221 @@
+package mst
+
+import "math"
+
+// ==================== COMPACT EDGE REPRESENTATION ====================
+
+// CompactGraph stores an undirected graph as parallel arrays of int32 vertex
+// indices and weights, about 16 bytes per edge instead of an Edge struct with
+// two pointers, an interface, and a map, plus the reverse adjacency copy
+// It implements IndexedGraph, so KruskalIndexed and PrimIndexed run on it
+// directly. Rich *Edge values are only created on demand by Edge and Graph,
+// and edge Data is kept in a side table for just the edges that have it
+type CompactGraph struct {
+	ids    []int         // vertex ID of every index
+	index  map[int]int32 // vertex index of every ID
+	from   []int32
+	to     []int32
+	weight []int
+	data   map[int32]any // Data of the edges that have any
+
+	// Adjacency, built on first use by Adjacent
+	offsets []int32
+	arcs    []int32 // edge indices grouped by endpoint
+}
+
+// NewCompactGraph creates an empty CompactGraph with room for the given number of edges
+func NewCompactGraph(edgeCapacity int) *CompactGraph {
+	return &CompactGraph{
+		index:  make(map[int]int32),
+		from:   make([]int32, 0, edgeCapacity),
+		to:     make([]int32, 0, edgeCapacity),
+		weight: make([]int, 0, edgeCapacity),
+	}
+}
+
+// Compact returns the compact form of an undirected graph
+// Edge i is a copy of g.Edges[i], Data included; the result holds no
+// pointers into g, so later changes to either one leave the other intact
+func (g *Graph) Compact() *CompactGraph {
+	if g.Directed {
+		panic("CompactGraph only supports undirected graphs")
+	}
+	c := NewCompactGraph(len(g.Edges))
+	for _, id := range g.SortedVertexIDs() {
+		c.vertex(id)
+	}
+	for _, e := range g.Edges {
+		i := c.AddEdge(e.From.ID, e.To.ID, e.Weight)
+		if e.Data != nil {
+			c.SetEdgeData(i, e.Data)
+		}
+	}
+	return c
+}
+
+// vertex returns the index of a vertex ID, assigning the next one if it is new
+func (c *CompactGraph) vertex(id int) int32 {
+	if i, exists := c.index[id]; exists {
+		return i
+	}
+	if len(c.ids) == math.MaxInt32 {
+		panic("CompactGraph vertex count exceeds int32 indices")
+	}
+	i := int32(len(c.ids))
+	c.ids = append(c.ids, id)
+	c.index[id] = i
+	return i
+}
+
+// AddEdge adds an edge between two vertex IDs and returns its index
+// Unknown vertices are created; the edge has no Data until SetEdgeData
+func (c *CompactGraph) AddEdge(fromID, toID, weight int) int {
+	if len(c.weight) == math.MaxInt32 {
+		panic("CompactGraph edge count exceeds int32 indices")
+	}
+	c.from = append(c.from, c.vertex(fromID))
+	c.to = append(c.to, c.vertex(toID))
+	c.weight = append(c.weight, weight)
+	c.offsets, c.arcs = nil, nil
+	return len(c.weight) - 1
+}
+
+// SetEdgeData attaches Data to edge i
+func (c *CompactGraph) SetEdgeData(i int, data any) {
+	if c.data == nil {
+		c.data = make(map[int32]any)
+	}
+	c.data[int32(i)] = data
+}
+
+// EdgeData returns the Data of edge i, or nil if it has none
+func (c *CompactGraph) EdgeData(i int) any {
+	return c.data[int32(i)]
+}
+
+// VertexCount returns the number of vertices
+func (c *CompactGraph) VertexCount() int {
+	return len(c.ids)
+}
+
+// EdgeCount returns the number of edges
+func (c *CompactGraph) EdgeCount() int {
+	return len(c.weight)
+}
+
+// VertexID returns the ID of the vertex at index i
+func (c *CompactGraph) VertexID(i int) int {
+	return c.ids[i]
+}
+
+// IndexOf returns the index of the vertex with the given ID
+func (c *CompactGraph) IndexOf(id int) (int, bool) {
+	i, exists := c.index[id]
+	return int(i), exists
+}
+
+// EdgeAt returns the endpoint indices and weight of edge i
+func (c *CompactGraph) EdgeAt(i int) (u, v, weight int) {
+	return int(c.from[i]), int(c.to[i]), c.weight[i]
+}
+
+// Adjacent calls yield for every edge at vertex index u until yield returns false
+// The adjacency arrays are built on the first call after the graph changes
+func (c *CompactGraph) Adjacent(u int, yield func(v, weight, edge int) bool) {
+	if c.offsets == nil {
+		c.buildAdjacency()
+	}
+	for _, e := range c.arcs[c.offsets[u]:c.offsets[u+1]] {
+		v := c.to[e]
+		if int(v) == u {
+			v = c.from[e]
+		}
+		if !yield(int(v), c.weight[e], int(e)) {
+			return
+		}
+	}
+}
+
+// buildAdjacency groups edge indices by endpoint with a counting pass
+func (c *CompactGraph) buildAdjacency() {
+	n := len(c.ids)
+	offsets := make([]int32, n+1)
+	for i := range c.weight {
+		offsets[c.from[i]+1]++
+		if c.to[i] != c.from[i] {
+			offsets[c.to[i]+1]++
+		}
+	}
+	for u := 1; u <= n; u++ {
+		offsets[u] += offsets[u-1]
+	}
+	fill := make([]int32, n)
+	copy(fill, offsets[:n])
+	arcs := make([]int32, offsets[n])
+	for i := range c.weight {
+		arcs[fill[c.from[i]]] = int32(i)
+		fill[c.from[i]]++
+		if c.to[i] != c.from[i] {
+			arcs[fill[c.to[i]]] = int32(i)
+			fill[c.to[i]]++
+		}
+	}
+	c.offsets, c.arcs = offsets, arcs
+}
+
+// Edge returns edge i in rich form
+// A new Edge with detached endpoint vertices is allocated each call
+func (c *CompactGraph) Edge(i int) *Edge {
+	return &Edge{
+		From:   &Vertex{ID: c.ids[c.from[i]]},
+		To:     &Vertex{ID: c.ids[c.to[i]]},
+		Weight: c.weight[i],
+		Data:   c.data[int32(i)],
+	}
+}
+
+// Edges converts a list of edge indices, such as an MST, to rich form
+func (c *CompactGraph) Edges(indices []int) []*Edge {
+	edges := make([]*Edge, len(indices))
+	for k, i := range indices {
+		edges[k] = c.Edge(i)
+	}
+	return edges
+}
+
+// Graph expands the compact graph into a full undirected Graph
+func (c *CompactGraph) Graph() Graph {
+	g := NewGraph(false)
+	vertices := make([]Vertex, len(c.ids))
+	for i, id := range c.ids {
+		vertices[i] = Vertex{ID: id}
+		g.AddVertex(vertices[i])
+	}
+	edges := make([]Edge, len(c.weight))
+	for i := range edges {
+		edges[i] = Edge{
+			From:   &vertices[c.from[i]],
+			To:     &vertices[c.to[i]],
+			Weight: c.weight[i],
+			Data:   c.EdgeData(i),
+		}
+	}
+	g.AddEdges(edges)
+	return g
+}
+
+// Kruskal finds the MST of the compact graph and returns it in rich form
+func (c *CompactGraph) Kruskal() ([]*Edge, int) {
+	tree, total := KruskalIndexed(c)
+	return c.Edges(tree), total
+}
+
+// Prim grows an MST from the vertex with ID startID and returns it in rich form
+func (c *CompactGraph) Prim(startID int) ([]*Edge, int) {
+	start, exists := c.IndexOf(startID)
+	if !exists {
+		return nil, 0
+	}
+	tree, total := PrimIndexed(c, start)
+	return c.Edges(tree), total
+}
@@ -0,0 +1,102 @@
+package mst
+
+import (
+	"fmt"
+	"testing"
+)
+
+// TestCompactGraph tests building, spanning, and expanding a compact graph
+func TestCompactGraph(t *testing.T) {
+	fmt.Println("\n=== COMPACT GRAPH TEST ===")
+
+	c := NewCompactGraph(4)
+	c.AddEdge(10, 20, 4)
+	c.AddEdge(20, 30, 2)
+	e := c.AddEdge(10, 30, 3)
+	c.SetEdgeData(e, "backup")
+	c.AddEdge(30, 30, 0)
+
+	if c.VertexCount() != 3 || c.EdgeCount() != 4 {
+		t.Fatalf("Expected 3 vertices and 4 edges, got %d and %d", c.VertexCount(), c.EdgeCount())
+	}
+	mst, total := c.Kruskal()
+	fmt.Printf("  kruskal: %v (total %d)\n", mst, total)
+	if total != 5 || len(mst) != 2 {
+		t.Errorf("Expected MST weight 5 with 2 edges, got %d with %d", total, len(mst))
+	}
+	if _, total := c.Prim(20); total != 5 {
+		t.Errorf("Prim: expected 5, got %d", total)
+	}
+	if rich := c.Edge(e); rich.From.ID != 10 || rich.To.ID != 30 || rich.Data != "backup" {
+		t.Errorf("Unexpected rich edge %v with data %v", rich, rich.Data)
+	}
+
+	u, _ := c.IndexOf(30)
+	degree := 0
+	c.Adjacent(u, func(v, weight, edge int) bool {
+		degree++
+		return true
+	})
+	if degree != 3 {
+		t.Errorf("Expected vertex 30 to touch 3 edges, got %d", degree)
+	}
+
+	g := c.Graph()
+	if g.VertexCount() != 3 || g.EdgeCount() != 4 {
+		t.Errorf("Expanded graph has %d vertices and %d edges", g.VertexCount(), g.EdgeCount())
+	}
+	if _, total := g.Kruskal(); total != 5 {
+		t.Errorf("Expanded graph MST weight %d, expected 5", total)
+	}
+}
+
+// TestGraphCompact tests that the compact form of a Graph keeps its edges in order
+func TestGraphCompact(t *testing.T) {
+	fmt.Println("\n=== GRAPH COMPACT TEST ===")
+
+	g := buildCompleteGraph(20)
+	c := g.Compact()
+	_, want := g.Kruskal()
+	mst, total := c.Kruskal()
+	if total != want {
+		t.Errorf("Expected MST weight %d, got %d", want, total)
+	}
+	for _, e := range mst {
+		if _, exists := g.Vertices[e.From.ID]; !exists || !g.HasEdge(e.From.ID, e.To.ID) {
+			t.Fatalf("Edge %v is not an edge of the original graph", e)
+		}
+	}
+	for i, e := range g.Edges {
+		if u, v, w := c.EdgeAt(i); c.VertexID(u) != e.From.ID || c.VertexID(v) != e.To.ID || w != e.Weight {
+			t.Fatalf("Expected edge %d to match %v", i, e)
+		}
+	}
+}
+
+// TestGraphCompactEdgeData tests that a compacted graph copies edge Data
+// and stays independent of the graph it came from
+func TestGraphCompactEdgeData(t *testing.T) {
+	g := newPathGraph(3, 1)
+	g.Edges[0].Data = "primary"
+	c := g.Compact()
+	c.SetEdgeData(1, "backup")
+
+	if data := c.EdgeData(0); data != "primary" {
+		t.Errorf("Expected the copied EdgeData %q, got %v", "primary", data)
+	}
+	if e := c.Edge(1); e.Data != "backup" {
+		t.Errorf("Expected Edge to carry the data, got %v", e.Data)
+	}
+	if g.Edges[1].Data != nil {
+		t.Errorf("Expected the source graph untouched, got %v", g.Edges[1].Data)
+	}
+	expanded := c.Graph()
+	if expanded.Edges[1].Data != "backup" || expanded.Vertices[2].Edges[0].Data != "backup" {
+		t.Error("Expected the expanded graph and its reverse copies to carry the data")
+	}
+
+	g.RemoveEdge(g.Edges[0])
+	if c.EdgeCount() != 2 || c.EdgeData(0) != "primary" {
+		t.Errorf("Expected the compact form to keep both edges after RemoveEdge, got %d", c.EdgeCount())
+	}
+}
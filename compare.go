@@ -0,0 +1,106 @@
+package mst
+
+import (
+	"fmt"
+	"maps"
+	"runtime"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// ==================== ALGORITHM COMPARISON ====================
+
+// AlgorithmRun is one algorithm's result and cost in a Comparison
+type AlgorithmRun struct {
+	MSTResult
+	Duration   time.Duration
+	AllocBytes uint64 // bytes allocated during the run
+	Allocs     uint64 // heap objects allocated during the run
+	Err        error  // unknown algorithm or weight overflow
+}
+
+// Comparison reports how several algorithms fared on the same graph
+// Equal weights with different edges are expected when weights tie, since
+// a graph then has several minimum spanning trees; different weights mean
+// one of the algorithms is wrong or was given options it ignores
+type Comparison struct {
+	Runs []AlgorithmRun
+
+	// WeightsAgree reports whether every successful run found the same total
+	WeightsAgree bool
+	// EdgeSetsAgree reports whether every successful run chose the same edges
+	EdgeSetsAgree bool
+}
+
+// CompareAlgorithms runs registered algorithms on the graph one after
+// another and reports their weights, running times, allocations, and
+// whether their trees agree
+// With no names it compares Kruskal, Prim, and Borůvka. Allocations are
+// read from runtime.MemStats, so they include any concurrent allocation
+// elsewhere in the process; run comparisons from a quiet program
+func (g *Graph) CompareAlgorithms(names ...string) Comparison {
+	if len(names) == 0 {
+		names = []string{AlgorithmKruskal, AlgorithmPrim, AlgorithmBoruvka}
+	}
+
+	c := Comparison{WeightsAgree: true, EdgeSetsAgree: true}
+	var reference map[edgeKey]int
+	var referenceWeight int64
+	for _, name := range names {
+		var before, after runtime.MemStats
+		runtime.ReadMemStats(&before)
+		began := time.Now()
+		result, err := g.Run(name)
+		elapsed := time.Since(began)
+		runtime.ReadMemStats(&after)
+
+		c.Runs = append(c.Runs, AlgorithmRun{
+			MSTResult:  result,
+			Duration:   elapsed,
+			AllocBytes: after.TotalAlloc - before.TotalAlloc,
+			Allocs:     after.Mallocs - before.Mallocs,
+			Err:        err,
+		})
+		if err != nil {
+			continue
+		}
+
+		edges := make(map[edgeKey]int, len(result.Edges))
+		for _, e := range result.Edges {
+			edges[keyOf(e, g.Directed)]++
+		}
+		if reference == nil {
+			reference, referenceWeight = edges, result.TotalWeight
+			continue
+		}
+		c.WeightsAgree = c.WeightsAgree && result.TotalWeight == referenceWeight
+		c.EdgeSetsAgree = c.EdgeSetsAgree && maps.Equal(edges, reference)
+	}
+	return c
+}
+
+// String formats the comparison as a table with one row per algorithm
+func (c Comparison) String() string {
+	var b strings.Builder
+	tw := tabwriter.NewWriter(&b, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "ALGORITHM\tEDGES\tWEIGHT\tTIME\tALLOCS\t")
+	for _, run := range c.Runs {
+		if run.Err != nil {
+			fmt.Fprintf(tw, "%s\terror: %v\t\t\t\t\n", run.Algorithm, run.Err)
+			continue
+		}
+		fmt.Fprintf(tw, "%s\t%d\t%s\t%s\t%d\t\n",
+			run.Algorithm, len(run.Edges), run.Unit.Format(run.TotalWeight), run.Duration.Round(time.Microsecond), run.Allocs)
+	}
+	tw.Flush()
+	switch {
+	case !c.WeightsAgree:
+		b.WriteString("✗ weights differ\n")
+	case !c.EdgeSetsAgree:
+		b.WriteString("✓ weights agree; edge sets differ between tied trees\n")
+	default:
+		b.WriteString("✓ weights and edge sets agree\n")
+	}
+	return b.String()
+}
@@ -0,0 +1,61 @@
+package mst
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// TestCompareAlgorithms tests that the built-in algorithms agree
+func TestCompareAlgorithms(t *testing.T) {
+	fmt.Println("\n=== COMPARE ALGORITHMS TEST ===")
+
+	g := buildCompleteGraph(40)
+	c := g.CompareAlgorithms()
+	fmt.Print(c)
+
+	if len(c.Runs) != 3 || c.Runs[0].Algorithm != AlgorithmKruskal || c.Runs[2].Algorithm != AlgorithmBoruvka {
+		t.Fatalf("Expected runs of Kruskal, Prim, and Borůvka, got %d runs", len(c.Runs))
+	}
+	_, want := g.Kruskal()
+	for _, run := range c.Runs {
+		if run.Err != nil || run.TotalWeight != int64(want) || len(run.Edges) != 39 {
+			t.Errorf("%s: expected weight %d, got %d (%v)", run.Algorithm, want, run.TotalWeight, run.Err)
+		}
+	}
+	if !c.WeightsAgree {
+		t.Error("Expected the weights to agree")
+	}
+
+	c = g.CompareAlgorithms(AlgorithmKruskal, "simulated_annealing")
+	if !errors.Is(c.Runs[1].Err, ErrUnknownAlgorithm) || !c.WeightsAgree {
+		t.Errorf("Expected an unknown algorithm to be reported and skipped, got %v", c.Runs[1].Err)
+	}
+}
+
+// TestCompareAlgorithmsTies tests that tied trees are told apart from wrong ones
+func TestCompareAlgorithmsTies(t *testing.T) {
+	fmt.Println("\n=== COMPARE ALGORITHMS TIES TEST ===")
+
+	reversed := "kruskal_reversed_ties"
+	if _, exists := Lookup(reversed); !exists {
+		Register(reversed, func(g *Graph, opts ...Option) ([]*Edge, int) {
+			return g.Kruskal(append(opts, WithTieBreakers(func(a, b *Edge) int { return ByEndpoints(b, a) }))...)
+		})
+	}
+
+	// Every spanning tree of a unit-weight square drops one of its four sides
+	g := NewGraph(false)
+	for i := range 4 {
+		g.AddEdge(Edge{From: &Vertex{ID: i}, To: &Vertex{ID: (i + 1) % 4}, Weight: 1})
+	}
+	c := g.CompareAlgorithms(AlgorithmKruskal, reversed)
+	fmt.Print(c)
+	if !c.WeightsAgree || c.EdgeSetsAgree {
+		t.Error("Expected equal weights with different edge sets")
+	}
+	if !strings.Contains(c.String(), "edge sets differ") {
+		t.Error("Expected the report to mention the differing edge sets")
+	}
+}
@@ -0,0 +1,89 @@
+package mst
+
+import "sort"
+
+// ==================== PER-COMPONENT FOREST STATISTICS ====================
+
+// ComponentStats describes one tree of a spanning forest
+type ComponentStats struct {
+	Root      int   // smallest vertex ID in the component
+	Vertices  int   // number of vertices
+	EdgeCount int   // number of tree edges
+	Weight    int   // total weight of the tree edges
+	Heaviest  *Edge // heaviest tree edge, nil for an isolated vertex
+	Diameter  int   // weight of the heaviest path between two tree vertices
+	Hops      int   // number of edges on the longest path between two tree vertices
+}
+
+// ForestStats breaks a spanning forest of g down into its trees
+// Vertices of g not touched by any forest edge are reported as isolated
+// single-vertex components. Components are ordered by Root
+func (g *Graph) ForestStats(forest []*Edge) []ComponentStats {
+	rf := rootForest(forest, -1)
+
+	// Longest downward path from each vertex, by weight and by hops; a path
+	// may stop anywhere, so negative edges never lengthen it
+	down := make(map[int]int, len(rf.order))
+	downHops := make(map[int]int, len(rf.order))
+	byRoot := make(map[int]*ComponentStats)
+	rootOf := make(map[int]int, len(rf.order))
+	for _, v := range rf.order {
+		if p := rf.parent[v]; p == v {
+			rootOf[v] = v
+		} else {
+			rootOf[v] = rootOf[p]
+		}
+	}
+
+	for i := len(rf.order) - 1; i >= 0; i-- {
+		v := rf.order[i]
+		c := byRoot[rootOf[v]]
+		if c == nil {
+			c = &ComponentStats{Root: v}
+			byRoot[rootOf[v]] = c
+		}
+		c.Vertices++
+		c.Root = min(c.Root, v)
+		c.Diameter = max(c.Diameter, down[v])
+		c.Hops = max(c.Hops, downHops[v])
+
+		edge := rf.parentEdge[v]
+		if edge == nil {
+			continue
+		}
+		c.EdgeCount++
+		c.Weight += edge.Weight
+		if c.Heaviest == nil || edge.Weight > c.Heaviest.Weight {
+			c.Heaviest = edge
+		}
+
+		// Join the best path through v with the best one already at the parent
+		p := rf.parent[v]
+		through, throughHops := max(0, down[v]+edge.Weight), downHops[v]+1
+		c.Diameter = max(c.Diameter, down[p]+through)
+		c.Hops = max(c.Hops, downHops[p]+throughHops)
+		down[p] = max(down[p], through)
+		downHops[p] = max(downHops[p], throughHops)
+	}
+
+	stats := make([]ComponentStats, 0, len(byRoot))
+	for _, c := range byRoot {
+		stats = append(stats, *c)
+	}
+	for id := range g.Vertices {
+		if _, inForest := rf.parent[id]; !inForest {
+			stats = append(stats, ComponentStats{Root: id, Vertices: 1})
+		}
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		return stats[i].Root < stats[j].Root
+	})
+	return stats
+}
+
+// MSTComponents computes a minimum spanning forest with Kruskal and
+// returns the statistics of each of its trees, see ForestStats
+func (g *Graph) MSTComponents(opts ...Option) []ComponentStats {
+	forest, _ := g.Kruskal(opts...)
+	return g.ForestStats(forest)
+}
@@ -0,0 +1,50 @@
+package mst
+
+import (
+	"fmt"
+	"testing"
+)
+
+// TestMSTComponents tests per-tree statistics of a forest, including isolated vertices
+func TestMSTComponents(t *testing.T) {
+	fmt.Println("\n=== COMPONENT STATS TEST ===")
+
+	g := NewGraph(false)
+	v := make([]Vertex, 8)
+	for i := range v {
+		v[i] = Vertex{ID: i}
+	}
+	// Star 0-1, 0-2, 0-3 with a tail 3-4, a separate pair 5-6, and isolated 7
+	for _, e := range [][3]int{{0, 1, 2}, {0, 2, 3}, {0, 3, 1}, {3, 4, 5}, {1, 2, 9}, {5, 6, 4}} {
+		g.AddEdge(Edge{From: &v[e[0]], To: &v[e[1]], Weight: e[2]})
+	}
+	g.AddVertex(v[7])
+
+	stats := g.MSTComponents()
+	for _, c := range stats {
+		fmt.Printf("Root %d: %d vertices, %d edges, weight %d, diameter %d (%d hops)\n",
+			c.Root, c.Vertices, c.EdgeCount, c.Weight, c.Diameter, c.Hops)
+	}
+	if len(stats) != 3 {
+		t.Fatalf("Expected 3 components, got %d", len(stats))
+	}
+
+	star := stats[0]
+	if star.Root != 0 || star.Vertices != 5 || star.EdgeCount != 4 || star.Weight != 11 {
+		t.Errorf("Unexpected star component %+v", star)
+	}
+	if star.Heaviest == nil || star.Heaviest.Weight != 5 {
+		t.Errorf("Expected heaviest edge of weight 5, got %v", star.Heaviest)
+	}
+	// Longest path is 2-0-3-4 by weight (9) and 1-0-3-4 by hops (3)
+	if star.Diameter != 9 || star.Hops != 3 {
+		t.Errorf("Expected diameter 9 over 3 hops, got %d over %d", star.Diameter, star.Hops)
+	}
+
+	if pair := stats[1]; pair.Root != 5 || pair.Diameter != 4 || pair.Hops != 1 {
+		t.Errorf("Unexpected pair component %+v", pair)
+	}
+	if lone := stats[2]; lone.Root != 7 || lone.Vertices != 1 || lone.Heaviest != nil || lone.Diameter != 0 {
+		t.Errorf("Unexpected isolated component %+v", lone)
+	}
+}
@@ -0,0 +1,56 @@
+package mst
+
+// ==================== DIRECTED CONNECTIVITY ====================
+
+// IsWeaklyConnected reports whether the graph is connected when edge
+// directions are ignored. For undirected graphs it equals IsConnected
+func (g *Graph) IsWeaklyConnected() bool {
+	if g.VertexCount() == 0 {
+		return true
+	}
+	uf := NewUnionFind()
+	for id := range g.Vertices {
+		uf.MakeSet(id)
+	}
+	for _, e := range g.Edges {
+		uf.Union(e.From.ID, e.To.ID)
+	}
+	return uf.Count() == 1
+}
+
+// IsStronglyConnected reports whether every vertex can reach every other
+// vertex along edge directions. For undirected graphs it equals IsConnected
+func (g *Graph) IsStronglyConnected() bool {
+	if g.VertexCount() == 0 {
+		return true
+	}
+	start := g.SortedVertexIDs()[0]
+
+	forward := make(map[int][]int, len(g.Vertices))
+	backward := make(map[int][]int, len(g.Vertices))
+	for id, v := range g.Vertices {
+		for _, e := range v.Edges {
+			forward[id] = append(forward[id], e.To.ID)
+			backward[e.To.ID] = append(backward[e.To.ID], id)
+		}
+	}
+	return reachCount(forward, start) == g.VertexCount() &&
+		reachCount(backward, start) == g.VertexCount()
+}
+
+// reachCount counts the vertices reachable from start, including start
+func reachCount(adj map[int][]int, start int) int {
+	visited := map[int]bool{start: true}
+	stack := []int{start}
+	for len(stack) > 0 {
+		v := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, w := range adj[v] {
+			if !visited[w] {
+				visited[w] = true
+				stack = append(stack, w)
+			}
+		}
+	}
+	return len(visited)
+}
@@ -0,0 +1,45 @@
+package mst
+
+import (
+	"fmt"
+	"testing"
+)
+
+// TestDirectedConnectivity tests weak and strong connectivity
+func TestDirectedConnectivity(t *testing.T) {
+	fmt.Println("\n=== DIRECTED CONNECTIVITY TEST ===")
+
+	g := NewGraph(true)
+	v := make([]Vertex, 3)
+	for i := range v {
+		v[i] = Vertex{ID: i}
+	}
+	// 0 -> 1 -> 2, with 2 unable to get back
+	g.AddEdge(Edge{From: &v[0], To: &v[1], Weight: 1})
+	g.AddEdge(Edge{From: &v[1], To: &v[2], Weight: 1})
+
+	if !g.IsWeaklyConnected() || !g.IsConnected() {
+		t.Error("Expected the chain to be weakly connected")
+	}
+	if g.IsStronglyConnected() {
+		t.Error("Expected the chain not to be strongly connected")
+	}
+
+	g.AddEdge(Edge{From: &v[2], To: &v[0], Weight: 1})
+	if !g.IsStronglyConnected() {
+		t.Error("Expected the cycle to be strongly connected")
+	}
+
+	split := NewGraph(true)
+	split.AddEdge(Edge{From: &v[1], To: &v[0], Weight: 1})
+	split.AddVertex(v[2])
+	if split.IsWeaklyConnected() || split.IsConnected() {
+		t.Error("Expected an isolated vertex to break weak connectivity")
+	}
+
+	u := NewGraph(false)
+	u.AddEdge(Edge{From: &v[0], To: &v[1], Weight: 1})
+	if !u.IsStronglyConnected() || !u.IsWeaklyConnected() {
+		t.Error("Expected undirected connectivity to agree across methods")
+	}
+}
@@ -0,0 +1,151 @@
+package mst
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// ==================== EDGE CONSTRAINTS ====================
+
+// ErrInfeasibleConstraints is returned when required and forbidden edges
+// leave no spanning tree that satisfies them
+var ErrInfeasibleConstraints = errors.New("edge constraints make a spanning tree infeasible")
+
+// WithRequiredEdges pins edges into the tree
+// Edges are matched by their endpoints; when several edges join the same
+// pair of vertices the cheapest one is required
+func WithRequiredEdges(edges ...*Edge) Option {
+	return func(o *options) {
+		o.required = append(o.required, edges...)
+	}
+}
+
+// WithForbiddenEdges bans edges from the tree
+// Edges are matched by their endpoints, so every edge between a forbidden
+// pair of vertices is excluded
+func WithForbiddenEdges(edges ...*Edge) Option {
+	return func(o *options) {
+		o.forbidden = append(o.forbidden, edges...)
+	}
+}
+
+// pairKey identifies an unordered pair of vertices
+type pairKey struct {
+	a, b int
+}
+
+func pairOf(e *Edge) pairKey {
+	if e.From.ID > e.To.ID {
+		return pairKey{a: e.To.ID, b: e.From.ID}
+	}
+	return pairKey{a: e.From.ID, b: e.To.ID}
+}
+
+// edgeConstraints is the resolved form of the required and forbidden options
+type edgeConstraints struct {
+	required  map[edgeKey]bool
+	forbidden map[pairKey]bool
+}
+
+// allowed reports whether an edge may be used
+func (c *edgeConstraints) allowed(e *Edge) bool {
+	return c == nil || !c.forbidden[pairOf(e)]
+}
+
+// isRequired reports whether an edge must be in the tree
+func (c *edgeConstraints) isRequired(e *Edge) bool {
+	return c != nil && c.required[keyOf(e, false)]
+}
+
+// key returns the priority of an edge, placing required edges before all others
+func (c *edgeConstraints) key(e *Edge) int {
+	if c.isRequired(e) {
+		return math.MinInt
+	}
+	return e.Weight
+}
+
+// less orders edges by priority, breaking ties between required edges by weight
+func (c *edgeConstraints) less(a, b *Edge) bool {
+	ra, rb := c.isRequired(a), c.isRequired(b)
+	if ra != rb {
+		return ra
+	}
+	return a.Weight < b.Weight
+}
+
+// prepareConstraints resolves the required and forbidden edges against g
+// It always sets o.constraints so algorithms can run best-effort, and
+// returns an error wrapping ErrInfeasibleConstraints if no spanning tree
+// can satisfy them
+func (o *options) prepareConstraints(g *Graph) error {
+	if len(o.required) == 0 && len(o.forbidden) == 0 {
+		o.constraints = nil
+		return nil
+	}
+
+	c := &edgeConstraints{
+		required:  make(map[edgeKey]bool, len(o.required)),
+		forbidden: make(map[pairKey]bool, len(o.forbidden)),
+	}
+	o.constraints = c
+	for _, e := range o.forbidden {
+		c.forbidden[pairOf(e)] = true
+	}
+
+	var problem error
+	fail := func(err error) {
+		if problem == nil {
+			problem = err
+		}
+	}
+
+	// Required edges must exist, must not be forbidden, and must not form a cycle
+	uf := NewUnionFind()
+	for _, req := range o.required {
+		pair := pairOf(req)
+		if c.forbidden[pair] {
+			fail(fmt.Errorf("%w: edge %d-%d is both required and forbidden", ErrInfeasibleConstraints, pair.a, pair.b))
+			continue
+		}
+
+		var cheapest *Edge
+		for _, e := range g.Vertices[pair.a].Edges {
+			if pairOf(e) == pair && (cheapest == nil || e.Weight < cheapest.Weight) {
+				cheapest = e
+			}
+		}
+		if cheapest == nil {
+			fail(fmt.Errorf("%w: required edge %d-%d is not in the graph", ErrInfeasibleConstraints, pair.a, pair.b))
+			continue
+		}
+
+		key := keyOf(cheapest, false)
+		if c.required[key] {
+			continue
+		}
+		c.required[key] = true
+		uf.MakeSet(pair.a)
+		uf.MakeSet(pair.b)
+		if !uf.Union(pair.a, pair.b) {
+			fail(fmt.Errorf("%w: required edges form a cycle through %d-%d", ErrInfeasibleConstraints, pair.a, pair.b))
+		}
+	}
+
+	// The allowed edges must still connect the graph
+	reach := NewUnionFind()
+	for id := range g.Vertices {
+		reach.MakeSet(id)
+	}
+	for _, e := range g.Edges {
+		if c.allowed(e) {
+			reach.Union(e.From.ID, e.To.ID)
+		}
+	}
+	if reach.Count() > 1 {
+		fail(fmt.Errorf("%w: forbidden edges split the graph into %d components", ErrInfeasibleConstraints, reach.Count()))
+	}
+
+	return problem
+}
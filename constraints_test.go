@@ -0,0 +1,101 @@
+package mst
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// buildConstraintGraph creates the 6-vertex sample graph used in the Kruskal tests
+func buildConstraintGraph() (Graph, []*Vertex) {
+	g := NewGraph(false)
+	vertices := make([]*Vertex, 6)
+	for i := 0; i < 6; i++ {
+		vertices[i] = &Vertex{ID: i, Name: fmt.Sprintf("V%d", i), Edges: make([]*Edge, 0)}
+	}
+	edges := []struct{ from, to, weight int }{
+		{0, 1, 4}, {0, 2, 2}, {1, 2, 1}, {1, 3, 5}, {2, 3, 8},
+		{2, 4, 10}, {3, 4, 2}, {3, 5, 6}, {4, 5, 3},
+	}
+	for _, e := range edges {
+		g.AddEdge(Edge{From: vertices[e.from], To: vertices[e.to], Weight: e.weight})
+	}
+	return g, vertices
+}
+
+// containsPair reports whether an edge list joins the two vertices
+func containsPair(edges []*Edge, a, b int) bool {
+	for _, e := range edges {
+		if (e.From.ID == a && e.To.ID == b) || (e.From.ID == b && e.To.ID == a) {
+			return true
+		}
+	}
+	return false
+}
+
+// TestRequiredAndForbiddenEdges tests constrained MSTs across algorithms
+func TestRequiredAndForbiddenEdges(t *testing.T) {
+	fmt.Println("\n=== EDGE CONSTRAINTS TEST ===")
+
+	g, v := buildConstraintGraph()
+	opts := []Option{
+		WithRequiredEdges(&Edge{From: v[2], To: v[4]}),
+		WithForbiddenEdges(&Edge{From: v[1], To: v[3]}),
+	}
+
+	// Unconstrained weight is 13; forcing 2-4 (10) and banning 1-3 (5)
+	// gives 1 + 2 + 10 + 2 + 3 = 18
+	expected := 18
+	results := map[string][]*Edge{}
+	var weight int
+	results["kruskal"], weight = g.Kruskal(opts...)
+	if weight != expected {
+		t.Errorf("Kruskal: expected weight %d, got %d", expected, weight)
+	}
+	results["prim"], weight = g.Prim(0, opts...)
+	if weight != expected {
+		t.Errorf("Prim: expected weight %d, got %d", expected, weight)
+	}
+	results["prim_eager"], weight = g.PrimEager(5, opts...)
+	if weight != expected {
+		t.Errorf("PrimEager: expected weight %d, got %d", expected, weight)
+	}
+	results["prim_dense"], weight = g.PrimDense(3, opts...)
+	if weight != expected {
+		t.Errorf("PrimDense: expected weight %d, got %d", expected, weight)
+	}
+
+	for name, mst := range results {
+		if !containsPair(mst, 2, 4) {
+			t.Errorf("%s: expected required edge 2-4 in the tree", name)
+		}
+		if containsPair(mst, 1, 3) {
+			t.Errorf("%s: expected forbidden edge 1-3 to be excluded", name)
+		}
+	}
+}
+
+// TestInfeasibleConstraints tests the errors reported by KruskalStrict
+func TestInfeasibleConstraints(t *testing.T) {
+	g, v := buildConstraintGraph()
+
+	cases := map[string][]Option{
+		"cycle": {WithRequiredEdges(
+			&Edge{From: v[0], To: v[1]}, &Edge{From: v[1], To: v[2]}, &Edge{From: v[2], To: v[0]},
+		)},
+		"missing":    {WithRequiredEdges(&Edge{From: v[0], To: v[5]})},
+		"conflict":   {WithRequiredEdges(&Edge{From: v[0], To: v[1]}), WithForbiddenEdges(&Edge{From: v[1], To: v[0]})},
+		"disconnect": {WithForbiddenEdges(&Edge{From: v[3], To: v[5]}, &Edge{From: v[4], To: v[5]})},
+	}
+	for name, opts := range cases {
+		_, _, err := g.KruskalStrict(opts...)
+		if !errors.Is(err, ErrInfeasibleConstraints) {
+			t.Errorf("%s: expected ErrInfeasibleConstraints, got %v", name, err)
+		}
+		fmt.Printf("✓ %s: %v\n", name, err)
+	}
+
+	if _, _, err := g.KruskalStrict(WithRequiredEdges(&Edge{From: v[2], To: v[4]})); err != nil {
+		t.Errorf("Expected feasible constraints, got %v", err)
+	}
+}
@@ -0,0 +1,65 @@
+package mst
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ==================== EDGE CONTRACTION ====================
+
+// ErrEdgeNotFound is returned when two vertices are not joined by an edge
+var ErrEdgeNotFound = errors.New("edge not found")
+
+// DataMerger combines the Data of two vertices merged by ContractEdge
+type DataMerger func(kept, absorbed any) any
+
+// ContractEdge merges vertex toID into vertex fromID and returns the merged vertex
+// Every edge between the two vertices is dropped, and every other edge of toID
+// is re-homed onto fromID with its weight and data, so parallel edges may
+// appear; in directed graphs an edge in either direction may be contracted
+// The merged vertex keeps the ID and name of fromID, and its Data becomes
+// merge(from.Data, to.Data), or stays unchanged when merge is nil
+// Observers see the removal of toID and its edges, then the re-homed edges,
+// then the Data change of fromID, so a Transaction can undo all of it
+func (g *Graph) ContractEdge(fromID, toID int, merge DataMerger) (*Vertex, error) {
+	from, fromExists := g.Vertices[fromID]
+	to, toExists := g.Vertices[toID]
+	if !fromExists || !toExists {
+		return nil, ErrVertexNotFound
+	}
+	if fromID == toID || (!g.HasEdge(fromID, toID) && !g.HasEdge(toID, fromID)) {
+		return nil, fmt.Errorf("contract %d-%d: %w", fromID, toID, ErrEdgeNotFound)
+	}
+
+	rehomed := make([]Edge, 0)
+	for _, edge := range g.Edges {
+		u, v := edge.From.ID, edge.To.ID
+		if (u == toID || v == toID) && u != fromID && v != fromID {
+			if u == toID {
+				u = fromID
+			}
+			if v == toID {
+				v = fromID
+			}
+			rehomed = append(rehomed, Edge{
+				From:    &Vertex{ID: u},
+				To:      &Vertex{ID: v},
+				Weight:  edge.Weight,
+				Data:    edge.Data,
+				Weights: edge.Weights,
+				Attrs:   edge.Attrs,
+			})
+		}
+	}
+
+	g.RemoveVertex(toID)
+	for _, edge := range rehomed {
+		g.AddEdge(edge)
+	}
+
+	if merge != nil {
+		g.SetVertexData(fromID, merge(from.Data, to.Data))
+	}
+	merged := g.Vertices[fromID]
+	return &merged, nil
+}
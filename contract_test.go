@@ -0,0 +1,85 @@
+package mst
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// TestContractEdge tests merging two vertices and re-homing their edges
+func TestContractEdge(t *testing.T) {
+	fmt.Println("\n=== CONTRACT EDGE TEST ===")
+
+	// Square 0-1-2-3-0 with diagonal 1-3; contracting 1-3 turns it into
+	// two parallel pairs 0-1 and 1-2
+	g := NewGraph(false)
+	v := make([]Vertex, 4)
+	for i := range v {
+		v[i] = Vertex{ID: i, Data: i * 10}
+	}
+	for _, p := range [][3]int{{0, 1, 1}, {1, 2, 2}, {2, 3, 3}, {3, 0, 4}, {1, 3, 5}} {
+		g.AddEdge(Edge{From: &v[p[0]], To: &v[p[1]], Weight: p[2]})
+	}
+
+	merged, err := g.ContractEdge(1, 3, func(kept, absorbed any) any {
+		return kept.(int) + absorbed.(int)
+	})
+	if err != nil {
+		t.Fatalf("ContractEdge failed: %v", err)
+	}
+	g.Print()
+
+	if merged.ID != 1 || merged.Data != 40 {
+		t.Errorf("Expected vertex 1 with data 40, got %d with %v", merged.ID, merged.Data)
+	}
+	if _, exists := g.Vertices[3]; exists {
+		t.Error("Vertex 3 should have been absorbed")
+	}
+	if g.EdgeCount() != 4 || len(g.Vertices[1].Edges) != 4 {
+		t.Errorf("Expected 4 edges all touching vertex 1, got %d and %d",
+			g.EdgeCount(), len(g.Vertices[1].Edges))
+	}
+	if e, _ := g.GetEdge(1, 0); e.Weight != 1 {
+		t.Errorf("Expected lightest 0-1 edge of weight 1, got %d", e.Weight)
+	}
+	if _, total := g.Kruskal(); total != 3 {
+		t.Errorf("Expected contracted MST weight 3, got %d", total)
+	}
+
+	// Observers see the merged Data, and a rollback restores both vertices
+	before := g.Snapshot()
+	changes := 0
+	cancel := g.Observe(MutationFunc(func(m Mutation) {
+		if m.Kind == MutationVertexDataChanged && m.Vertex.ID == 1 {
+			changes++
+		}
+	}))
+	tx := g.Begin()
+	if _, err := g.ContractEdge(1, 2, func(kept, absorbed any) any {
+		return kept.(int) + absorbed.(int)
+	}); err != nil {
+		t.Fatalf("ContractEdge failed: %v", err)
+	}
+	cancel()
+	if changes != 1 {
+		t.Errorf("Expected one data change for vertex 1, got %d", changes)
+	}
+	if snap, _ := g.Snapshot().Vertex(1); snap.Data != 60 {
+		t.Errorf("Expected the snapshot to see data 60, got %v", snap.Data)
+	}
+	tx.Rollback()
+	if g.Vertices[1].Data != 40 || g.Vertices[2].Data != 20 || g.EdgeCount() != 4 {
+		t.Errorf("Expected rollback to restore data 40 and 20 and 4 edges, got %v, %v and %d",
+			g.Vertices[1].Data, g.Vertices[2].Data, g.EdgeCount())
+	}
+	if old, _ := before.Vertex(1); old.Data != 40 {
+		t.Errorf("Expected the earlier snapshot to keep data 40, got %v", old.Data)
+	}
+
+	if _, err := g.ContractEdge(0, 2, nil); !errors.Is(err, ErrEdgeNotFound) {
+		t.Errorf("Expected ErrEdgeNotFound for non-adjacent vertices, got %v", err)
+	}
+	if _, err := g.ContractEdge(0, 99, nil); !errors.Is(err, ErrVertexNotFound) {
+		t.Errorf("Expected ErrVertexNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,174 @@
+package mst
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+)
+
+// ==================== MULTI-CRITERIA WEIGHTS ====================
+
+// ErrMissingCriterion is returned when an edge has no value for the requested criterion
+var ErrMissingCriterion = errors.New("edge has no value for criterion")
+
+// CriterionWeight names the primary Edge.Weight in KruskalBy
+const CriterionWeight = "weight"
+
+// SetEdgeWeightBy sets a named criterion on an edge and its reverse copy
+// Changing a criterion other than CriterionWeight notifies observers with
+// MutationEdgeCriterionChanged
+func (g *Graph) SetEdgeWeightBy(edge *Edge, criterion string, weight int) {
+	if criterion == "" || criterion == CriterionWeight {
+		g.SetEdgeWeight(edge, weight)
+		return
+	}
+	old, present := edge.Weights[criterion]
+	if present && old == weight {
+		return
+	}
+	if edge.Weights == nil {
+		edge.Weights = make(map[string]int)
+		if edge.twin != nil {
+			edge.twin.Weights = edge.Weights
+		}
+	}
+	edge.Weights[criterion] = weight
+	g.notify(Mutation{Kind: MutationEdgeCriterionChanged, Edge: edge, Criterion: criterion, OldWeight: old, OldPresent: present})
+}
+
+// unsetEdgeWeightBy removes a named criterion from an edge, undoing the
+// SetEdgeWeightBy call that added it
+func (g *Graph) unsetEdgeWeightBy(edge *Edge, criterion string) {
+	old, present := edge.Weights[criterion]
+	if !present {
+		return
+	}
+	delete(edge.Weights, criterion)
+	g.notify(Mutation{Kind: MutationEdgeCriterionChanged, Edge: edge, Criterion: criterion, OldWeight: old, OldPresent: true})
+}
+
+// KruskalBy finds the spanning tree that minimizes a named criterion from
+// Edge.Weights, such as "latency", and returns its total in that criterion
+// CriterionWeight (or "") selects Edge.Weight. Every edge must carry the
+// criterion, otherwise an error wrapping ErrMissingCriterion is returned
+func (g *Graph) KruskalBy(criterion string, opts ...Option) ([]*Edge, int, error) {
+	if g.Directed {
+		panic("Kruskal algorithm only works for undirected graphs")
+	}
+
+	value, err := g.criterion(criterion)
+	if err != nil {
+		return nil, 0, err
+	}
+	o := newOptions(opts)
+	o.weight = value
+	o.prepareConstraints(g)
+	mst, total := g.kruskal(o)
+	return mst, total, nil
+}
+
+// criterion returns the function reading a named criterion from an edge,
+// nil for Edge.Weight, after checking that every edge of g carries it
+func (g *Graph) criterion(name string) (func(*Edge) int, error) {
+	if name == "" || name == CriterionWeight {
+		return nil, nil
+	}
+	for _, edge := range g.Edges {
+		if _, ok := edge.Weights[name]; !ok {
+			return nil, fmt.Errorf("%w %q: %d-%d", ErrMissingCriterion, name, edge.From.ID, edge.To.ID)
+		}
+	}
+	return func(edge *Edge) int {
+		return edge.Weights[name]
+	}, nil
+}
+
+// weightOf returns the value of the selected criterion for an edge
+func (o *options) weightOf(edge *Edge) int {
+	if o.weight == nil {
+		return edge.Weight
+	}
+	return o.weight(edge)
+}
+
+// less orders edges for Kruskal: required edges first, then by the selected
+// criterion, then by each tie breaker in turn
+func (o *options) less(a, b *Edge) bool {
+	ra, rb := o.constraints.isRequired(a), o.constraints.isRequired(b)
+	if ra != rb {
+		return ra
+	}
+	if wa, wb := o.weightOf(a), o.weightOf(b); wa != wb {
+		return wa < wb
+	}
+	for _, compare := range o.tieBreakers {
+		if r := compare(a, b); r != 0 {
+			return r < 0
+		}
+	}
+	return false
+}
+
+// ordered reports whether a criterion or tie breakers decide the edge order,
+// rather than the plain weight
+func (o *options) ordered() bool {
+	return o.weight != nil || len(o.tieBreakers) > 0
+}
+
+// edgeRanks numbers the allowed edges of g in the order Kruskal scans them,
+// so the integer-keyed heaps of PrimEager follow the criterion and tie
+// breakers too; both adjacency copies of an edge share its rank
+func (o *options) edgeRanks(g *Graph) map[*Edge]int {
+	edges := g.sortedEdges(o)
+	ranks := make(map[*Edge]int, 2*len(edges))
+	for i, edge := range edges {
+		ranks[edge] = i
+		if edge.twin != nil {
+			ranks[edge.twin] = i
+		}
+	}
+	return ranks
+}
+
+// EdgeComparator orders two edges, returning a negative number if a comes
+// first, a positive number if b comes first, and zero if they tie
+type EdgeComparator func(a, b *Edge) int
+
+// WithTieBreakers makes Kruskal and the Prim variants decide between
+// equal-weight edges with the given comparators, consulted in order. The result minimizes the primary
+// weight and, among all such trees, the first comparator's criterion, and so on
+func WithTieBreakers(cmps ...EdgeComparator) Option {
+	return func(o *options) {
+		o.tieBreakers = append(o.tieBreakers, cmps...)
+	}
+}
+
+// WithSecondaryCriterion breaks ties on the primary weight by a named
+// criterion from Edge.Weights, e.g. lower latency among equal-cost links
+func WithSecondaryCriterion(criterion string) Option {
+	return WithTieBreakers(ByCriterion(criterion))
+}
+
+// ByCriterion compares edges by a named criterion; CriterionWeight (or "")
+// selects Edge.Weight and missing values count as zero
+func ByCriterion(criterion string) EdgeComparator {
+	value := func(e *Edge) int {
+		if criterion == "" || criterion == CriterionWeight {
+			return e.Weight
+		}
+		return e.Weights[criterion]
+	}
+	return func(a, b *Edge) int {
+		return cmp.Compare(value(a), value(b))
+	}
+}
+
+// ByEndpoints compares edges by their smaller and then larger vertex ID,
+// giving a deterministic final tie breaker
+func ByEndpoints(a, b *Edge) int {
+	pa, pb := pairOf(a), pairOf(b)
+	if r := cmp.Compare(pa.a, pb.a); r != 0 {
+		return r
+	}
+	return cmp.Compare(pa.b, pb.b)
+}
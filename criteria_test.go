@@ -0,0 +1,88 @@
+package mst
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// TestKruskalBy tests minimizing different named criteria on one graph
+func TestKruskalBy(t *testing.T) {
+	fmt.Println("\n=== MULTI-CRITERIA TEST ===")
+
+	g := NewGraph(false)
+	v := make([]Vertex, 3)
+	for i := range v {
+		v[i] = Vertex{ID: i}
+	}
+	links := []struct {
+		from, to, cost, latency int
+	}{
+		{0, 1, 1, 30},
+		{1, 2, 2, 20},
+		{0, 2, 3, 10},
+	}
+	for _, l := range links {
+		g.AddEdge(Edge{From: &v[l.from], To: &v[l.to], Weight: l.cost,
+			Weights: map[string]int{"latency": l.latency}})
+	}
+
+	if _, total, err := g.KruskalBy(CriterionWeight); err != nil || total != 3 {
+		t.Errorf("Expected cost 3, got %d (%v)", total, err)
+	}
+	mst, total, err := g.KruskalBy("latency")
+	if err != nil || total != 30 {
+		t.Errorf("Expected latency 30, got %d (%v)", total, err)
+	}
+	for _, e := range mst {
+		if pairOf(e) == (pairKey{0, 1}) {
+			t.Error("Expected the slow 0-1 link to be left out")
+		}
+	}
+
+	// Setting a criterion through the reverse copy is seen by Kruskal
+	back, _ := g.GetEdge(1, 0)
+	g.SetEdgeWeightBy(back, "latency", 5)
+	if _, total, _ := g.KruskalBy("latency"); total != 15 {
+		t.Errorf("Expected latency 15 after update, got %d", total)
+	}
+
+	g.SetEdgeWeightBy(g.Edges[0], "loss", 1)
+	if _, _, err := g.KruskalBy("loss"); !errors.Is(err, ErrMissingCriterion) {
+		t.Errorf("Expected ErrMissingCriterion, got %v", err)
+	}
+}
+
+// TestTieBreakers tests lexicographic tie-breaking on a secondary criterion
+func TestTieBreakers(t *testing.T) {
+	g := NewGraph(false)
+	v := make([]Vertex, 4)
+	for i := range v {
+		v[i] = Vertex{ID: i}
+	}
+	// A 4-cycle of equal cost; the 3-0 link has the worst latency
+	latencies := []int{10, 20, 30, 40}
+	for i, l := range latencies {
+		g.AddEdge(Edge{From: &v[i], To: &v[(i+1)%4], Weight: 5,
+			Weights: map[string]int{"latency": l}})
+	}
+
+	mst, total, _ := g.KruskalBy(CriterionWeight, WithSecondaryCriterion("latency"))
+	if total != 15 {
+		t.Errorf("Expected cost 15, got %d", total)
+	}
+	for _, e := range mst {
+		if e.Weights["latency"] == 40 {
+			t.Error("Expected the 40ms link to lose the tie")
+		}
+	}
+
+	// Reversing the chain keeps the best-latency link last in line
+	worst := func(a, b *Edge) int { return -ByCriterion("latency")(a, b) }
+	mst, _ = g.Kruskal(WithTieBreakers(worst, ByEndpoints))
+	for _, e := range mst {
+		if e.Weights["latency"] == 10 {
+			t.Error("Expected the reversed comparator to leave out the 10ms link")
+		}
+	}
+}
@@ -0,0 +1,437 @@
+package mst
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"maps"
+	"math"
+	"slices"
+	"sort"
+	"unsafe"
+)
+
+// ==================== COMPACT CSR STORAGE ====================
+
+// ErrInvalidCSR is returned when data is not a valid CSR graph file
+var ErrInvalidCSR = errors.New("invalid CSR graph data")
+
+// csrMagic starts every CSR graph file
+var csrMagic = [8]byte{'M', 'S', 'T', 'C', 'S', 'R', 0, 0}
+
+const (
+	csrVersion    = 1
+	csrHeaderSize = 32
+	csrDirected   = 1 << 0
+)
+
+// nativeLittleEndian reports whether the host can view the file's little-endian arrays in place
+var nativeLittleEndian = binary.NativeEndian.Uint16([]byte{1, 0}) == 1
+
+// WriteCSR writes the graph in the read-only compressed sparse row format
+// read by OpenCSR and ReadCSR
+//
+// The file is a 32-byte header (magic, version, flags, vertex and edge
+// counts) followed by little-endian arrays, each padded to 8 bytes: sorted
+// vertex IDs, row offsets, neighbor indices, adjacency edge indices, and the
+// edge list as from, to, and weight columns. Edges are stored in ascending
+// weight order so Kruskal can stream them without sorting
+// Vertex names, Data, and attributes are not stored; vertex and edge counts
+// must fit in uint32
+func (g *Graph) WriteCSR(w io.Writer) error {
+	edges := make([]EdgeRecord, len(g.Edges))
+	for i, e := range g.Edges {
+		edges[i] = EdgeRecord{From: e.From.ID, To: e.To.ID, Weight: e.Weight}
+	}
+	return writeCSR(w, g.Directed, g.SortedVertexIDs(), edges)
+}
+
+// WriteCSRStream writes an edge stream in the format of WriteCSR without
+// building a Graph, so files can be made from graphs too large for one
+// The vertices are the endpoints of the edges. Only plain records are held,
+// about 40 bytes per edge against several hundred for a Graph; the stream
+// is read to the end and its first error returned
+func WriteCSRStream(w io.Writer, directed bool, edges iter.Seq2[EdgeRecord, error]) error {
+	records := make([]EdgeRecord, 0)
+	seen := make(map[int]struct{})
+	for e, err := range edges {
+		if err != nil {
+			return err
+		}
+		records = append(records, e)
+		seen[e.From] = struct{}{}
+		seen[e.To] = struct{}{}
+	}
+	return writeCSR(w, directed, slices.Sorted(maps.Keys(seen)), records)
+}
+
+// writeCSR writes the vertices ids, in ascending order, and the edges
+// between them, which it sorts by weight in place
+func writeCSR(w io.Writer, directed bool, ids []int, edges []EdgeRecord) error {
+	if uint64(len(ids)) > math.MaxUint32 || uint64(len(edges)) > math.MaxUint32 {
+		return fmt.Errorf("write CSR: graph too large for 32-bit indices")
+	}
+	index := make(map[int]uint32, len(ids))
+	for i, id := range ids {
+		index[id] = uint32(i)
+	}
+	sortRecords(edges)
+
+	n, m := len(ids), len(edges)
+	from := make([]uint32, m)
+	to := make([]uint32, m)
+	weight := make([]int64, m)
+	degree := make([]uint64, n+1)
+	for i, e := range edges {
+		from[i], to[i], weight[i] = index[e.From], index[e.To], int64(e.Weight)
+		degree[from[i]+1]++
+		if !directed {
+			degree[to[i]+1]++
+		}
+	}
+	offsets := degree
+	for i := 1; i <= n; i++ {
+		offsets[i] += offsets[i-1]
+	}
+
+	fill := make([]uint64, n)
+	copy(fill, offsets[:n])
+	neighbors := make([]uint32, offsets[n])
+	adjEdges := make([]uint32, offsets[n])
+	place := func(u, v uint32, e int) {
+		neighbors[fill[u]], adjEdges[fill[u]] = v, uint32(e)
+		fill[u]++
+	}
+	for i := range edges {
+		place(from[i], to[i], i)
+		if !directed {
+			place(to[i], from[i], i)
+		}
+	}
+
+	vertexIDs := make([]int64, n)
+	for i, id := range ids {
+		vertexIDs[i] = int64(id)
+	}
+
+	var flags uint32
+	if directed {
+		flags |= csrDirected
+	}
+	bw := bufio.NewWriter(w)
+	header := make([]byte, csrHeaderSize)
+	copy(header, csrMagic[:])
+	binary.LittleEndian.PutUint32(header[8:], csrVersion)
+	binary.LittleEndian.PutUint32(header[12:], flags)
+	binary.LittleEndian.PutUint64(header[16:], uint64(n))
+	binary.LittleEndian.PutUint64(header[24:], uint64(m))
+	bw.Write(header)
+
+	for _, section := range []any{vertexIDs, offsets, neighbors, adjEdges, from, to, weight} {
+		if err := binary.Write(bw, binary.LittleEndian, section); err != nil {
+			return err
+		}
+		if s, ok := section.([]uint32); ok && len(s)%2 == 1 {
+			bw.Write(make([]byte, 4))
+		}
+	}
+	return bw.Flush()
+}
+
+// CSRGraph is a read-only graph in compressed sparse row form, viewed in
+// place over a byte slice or a memory-mapped file
+// On little-endian hosts nothing is deserialized, so the operating system
+// pages the file in as it is read and graphs larger than memory can be
+// queried and spanned. Vertices are addressed by index 0..VertexCount()-1 in
+// ascending ID order; VertexID and IndexOf convert to and from vertex IDs
+type CSRGraph struct {
+	directed  bool
+	ids       []int64
+	offsets   []uint64
+	neighbors []uint32
+	adjEdges  []uint32
+	from, to  []uint32
+	weight    []int64
+	close     func() error
+}
+
+// CSROption configures ReadCSR and OpenCSR
+type CSROption func(*csrOptions)
+
+// csrOptions holds the settings collected from a list of CSROption values
+type csrOptions struct {
+	validate bool
+}
+
+// WithCSRValidation makes ReadCSR and OpenCSR check every stored index and
+// the order of every sorted array before returning, so a corrupted file is
+// rejected with ErrInvalidCSR instead of making later queries panic or
+// return wrong results. It reads the whole file, paging in all of a mapping
+func WithCSRValidation() CSROption {
+	return func(o *csrOptions) {
+		o.validate = true
+	}
+}
+
+// ReadCSR views data written by WriteCSR as a CSRGraph
+// The graph refers to data directly, so data must not change while it is used;
+// data that is not 8-byte aligned is copied first
+// Only the header and the section sizes are checked, which touches a few
+// pages at most; pass WithCSRValidation for data that may be corrupted
+func ReadCSR(data []byte, opts ...CSROption) (*CSRGraph, error) {
+	var o csrOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	if uintptr(unsafe.Pointer(unsafe.SliceData(data)))%8 != 0 {
+		aligned := make([]uint64, (len(data)+7)/8)
+		buf := unsafe.Slice((*byte)(unsafe.Pointer(unsafe.SliceData(aligned))), len(data))
+		copy(buf, data)
+		data = buf
+	}
+	if len(data) < csrHeaderSize || [8]byte(data[:8]) != csrMagic {
+		return nil, fmt.Errorf("%w: bad magic", ErrInvalidCSR)
+	}
+	if v := binary.LittleEndian.Uint32(data[8:]); v != csrVersion {
+		return nil, fmt.Errorf("%w: unsupported version %d", ErrInvalidCSR, v)
+	}
+	flags := binary.LittleEndian.Uint32(data[12:])
+	n := binary.LittleEndian.Uint64(data[16:])
+	m := binary.LittleEndian.Uint64(data[24:])
+	if n > math.MaxUint32 || m > math.MaxUint32 {
+		return nil, fmt.Errorf("%w: counts out of range", ErrInvalidCSR)
+	}
+
+	c := &CSRGraph{directed: flags&csrDirected != 0}
+	arcs := 2 * m
+	if c.directed {
+		arcs = m
+	}
+	pos := uint64(csrHeaderSize)
+	section := func(count, size uint64) ([]byte, error) {
+		length := (count*size + 7) &^ 7
+		if pos+length > uint64(len(data)) {
+			return nil, fmt.Errorf("%w: truncated", ErrInvalidCSR)
+		}
+		b := data[pos : pos+count*size]
+		pos += length
+		return b, nil
+	}
+
+	var err error
+	var b []byte
+	if b, err = section(n, 8); err != nil {
+		return nil, err
+	}
+	c.ids = viewInt64s(b)
+	if b, err = section(n+1, 8); err != nil {
+		return nil, err
+	}
+	c.offsets = viewUint64s(b)
+	if c.offsets[0] != 0 {
+		return nil, fmt.Errorf("%w: first row offset %d", ErrInvalidCSR, c.offsets[0])
+	}
+	if c.offsets[n] != arcs {
+		return nil, fmt.Errorf("%w: adjacency size mismatch", ErrInvalidCSR)
+	}
+	for _, dst := range []*[]uint32{&c.neighbors, &c.adjEdges} {
+		if b, err = section(arcs, 4); err != nil {
+			return nil, err
+		}
+		*dst = viewUint32s(b)
+	}
+	for _, dst := range []*[]uint32{&c.from, &c.to} {
+		if b, err = section(m, 4); err != nil {
+			return nil, err
+		}
+		*dst = viewUint32s(b)
+	}
+	if b, err = section(m, 8); err != nil {
+		return nil, err
+	}
+	c.weight = viewInt64s(b)
+	if o.validate {
+		if err := c.validate(); err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+// validate checks that every stored index is in range and every sorted
+// array is in order, so a corrupted file cannot make later queries panic
+func (c *CSRGraph) validate() error {
+	n, m := uint64(len(c.ids)), uint64(len(c.from))
+	for i := 1; i < len(c.ids); i++ {
+		if c.ids[i] <= c.ids[i-1] {
+			return fmt.Errorf("%w: vertex IDs not ascending at %d", ErrInvalidCSR, i)
+		}
+	}
+	for i := 1; i < len(c.offsets); i++ {
+		if c.offsets[i] < c.offsets[i-1] {
+			return fmt.Errorf("%w: row offsets decreasing at %d", ErrInvalidCSR, i)
+		}
+	}
+	for i := range c.from {
+		if uint64(c.from[i]) >= n || uint64(c.to[i]) >= n {
+			return fmt.Errorf("%w: edge %d endpoints out of range", ErrInvalidCSR, i)
+		}
+		if i > 0 && c.weight[i] < c.weight[i-1] {
+			return fmt.Errorf("%w: edge weights not ascending at %d", ErrInvalidCSR, i)
+		}
+	}
+	for u := range n {
+		for i := c.offsets[u]; i < c.offsets[u+1]; i++ {
+			v, e := c.neighbors[i], uint64(c.adjEdges[i])
+			if uint64(v) >= n {
+				return fmt.Errorf("%w: neighbor %d out of range", ErrInvalidCSR, v)
+			}
+			if e >= m {
+				return fmt.Errorf("%w: adjacency edge %d out of range", ErrInvalidCSR, e)
+			}
+			forward := uint64(c.from[e]) == u && c.to[e] == v
+			backward := !c.directed && uint64(c.to[e]) == u && c.from[e] == v
+			if !forward && !backward {
+				return fmt.Errorf("%w: adjacency of vertex %d does not match edge %d", ErrInvalidCSR, u, e)
+			}
+		}
+	}
+	return nil
+}
+
+// Close releases the memory mapping of a graph opened with OpenCSR
+// The graph must not be used afterwards
+func (c *CSRGraph) Close() error {
+	if c.close == nil {
+		return nil
+	}
+	err := c.close()
+	c.close = nil
+	return err
+}
+
+// Directed reports whether the stored graph is directed
+func (c *CSRGraph) Directed() bool {
+	return c.directed
+}
+
+// VertexCount returns the number of vertices
+func (c *CSRGraph) VertexCount() int {
+	return len(c.ids)
+}
+
+// EdgeCount returns the number of edges
+func (c *CSRGraph) EdgeCount() int {
+	return len(c.weight)
+}
+
+// SortedByWeight reports that edge indices run in ascending weight order
+func (c *CSRGraph) SortedByWeight() bool {
+	return true
+}
+
+// VertexID returns the ID of the vertex at index i
+func (c *CSRGraph) VertexID(i int) int {
+	return int(c.ids[i])
+}
+
+// IndexOf returns the index of the vertex with the given ID
+func (c *CSRGraph) IndexOf(id int) (int, bool) {
+	i := sort.Search(len(c.ids), func(i int) bool { return c.ids[i] >= int64(id) })
+	return i, i < len(c.ids) && c.ids[i] == int64(id)
+}
+
+// EdgeAt returns the endpoint indices and weight of edge i
+func (c *CSRGraph) EdgeAt(i int) (u, v, weight int) {
+	return int(c.from[i]), int(c.to[i]), int(c.weight[i])
+}
+
+// Adjacent calls yield for every edge at vertex index u until yield returns false
+func (c *CSRGraph) Adjacent(u int, yield func(v, weight, edge int) bool) {
+	for k := c.offsets[u]; k < c.offsets[u+1]; k++ {
+		e := c.adjEdges[k]
+		if !yield(int(c.neighbors[k]), int(c.weight[e]), int(e)) {
+			return
+		}
+	}
+}
+
+// Neighbors yields the neighbor IDs and edge weights of the vertex with the given ID
+func (c *CSRGraph) Neighbors(id int) iter.Seq2[int, int] {
+	return func(yield func(int, int) bool) {
+		u, ok := c.IndexOf(id)
+		if !ok {
+			return
+		}
+		c.Adjacent(u, func(v, weight, _ int) bool {
+			return yield(int(c.ids[v]), weight)
+		})
+	}
+}
+
+// Kruskal streams the weight-sorted edges into a union-find and returns the
+// edge indices of the MST and its total weight, holding only O(V) in memory
+func (c *CSRGraph) Kruskal() ([]int, int) {
+	if c.directed {
+		panic("Kruskal algorithm only works for undirected graphs")
+	}
+	return KruskalIndexed(c)
+}
+
+// Prim grows an MST from the vertex with ID startID and returns its edge indices and total weight
+func (c *CSRGraph) Prim(startID int) ([]int, int) {
+	if c.directed {
+		panic("Prim algorithm only works for undirected graphs")
+	}
+	start, ok := c.IndexOf(startID)
+	if !ok {
+		return nil, 0
+	}
+	return PrimIndexed(c, start)
+}
+
+func viewInt64s(b []byte) []int64 {
+	if len(b) == 0 {
+		return nil
+	}
+	if nativeLittleEndian {
+		return unsafe.Slice((*int64)(unsafe.Pointer(unsafe.SliceData(b))), len(b)/8)
+	}
+	out := make([]int64, len(b)/8)
+	for i := range out {
+		out[i] = int64(binary.LittleEndian.Uint64(b[8*i:]))
+	}
+	return out
+}
+
+func viewUint64s(b []byte) []uint64 {
+	if len(b) == 0 {
+		return nil
+	}
+	if nativeLittleEndian {
+		return unsafe.Slice((*uint64)(unsafe.Pointer(unsafe.SliceData(b))), len(b)/8)
+	}
+	out := make([]uint64, len(b)/8)
+	for i := range out {
+		out[i] = binary.LittleEndian.Uint64(b[8*i:])
+	}
+	return out
+}
+
+func viewUint32s(b []byte) []uint32 {
+	if len(b) == 0 {
+		return nil
+	}
+	if nativeLittleEndian {
+		return unsafe.Slice((*uint32)(unsafe.Pointer(unsafe.SliceData(b))), len(b)/4)
+	}
+	out := make([]uint32, len(b)/4)
+	for i := range out {
+		out[i] = binary.LittleEndian.Uint32(b[4*i:])
+	}
+	return out
+}
@@ -0,0 +1,39 @@
+//go:build linux || darwin || freebsd || netbsd || openbsd || dragonfly
+
+package mst
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+)
+
+// OpenCSR memory-maps a file written by WriteCSR read-only
+// Call Close to unmap it
+func OpenCSR(path string, opts ...CSROption) (*CSRGraph, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if info.Size() < csrHeaderSize || int64(int(info.Size())) != info.Size() {
+		return nil, fmt.Errorf("%w: bad size %d", ErrInvalidCSR, info.Size())
+	}
+	data, err := syscall.Mmap(int(f.Fd()), 0, int(info.Size()), syscall.PROT_READ, syscall.MAP_SHARED)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := ReadCSR(data, opts...)
+	if err != nil {
+		syscall.Munmap(data)
+		return nil, err
+	}
+	c.close = func() error { return syscall.Munmap(data) }
+	return c, nil
+}
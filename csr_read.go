@@ -0,0 +1,15 @@
+//go:build !(linux || darwin || freebsd || netbsd || openbsd || dragonfly)
+
+package mst
+
+import "os"
+
+// OpenCSR reads a file written by WriteCSR
+// This platform has no memory mapping support, so the file is loaded into memory
+func OpenCSR(path string, opts ...CSROption) (*CSRGraph, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ReadCSR(data, opts...)
+}
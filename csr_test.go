@@ -0,0 +1,202 @@
+package mst
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestCSRRoundTrip tests writing, mapping, and spanning a CSR graph file
+func TestCSRRoundTrip(t *testing.T) {
+	fmt.Println("\n=== CSR STORAGE TEST ===")
+
+	g := buildCompleteGraph(12)
+	// Sparse IDs must map to dense indices
+	far := Vertex{ID: 1000}
+	g.AddEdge(Edge{From: &far, To: &Vertex{ID: 3}, Weight: 7})
+
+	path := filepath.Join(t.TempDir(), "graph.csr")
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := g.WriteCSR(f); err != nil {
+		t.Fatalf("WriteCSR failed: %v", err)
+	}
+	f.Close()
+
+	c, err := OpenCSR(path)
+	if err != nil {
+		t.Fatalf("OpenCSR failed: %v", err)
+	}
+	defer c.Close()
+
+	if c.VertexCount() != g.VertexCount() || c.EdgeCount() != g.EdgeCount() || c.Directed() {
+		t.Fatalf("Expected %d vertices and %d edges, got %d and %d",
+			g.VertexCount(), g.EdgeCount(), c.VertexCount(), c.EdgeCount())
+	}
+	if i, ok := c.IndexOf(1000); !ok || c.VertexID(i) != 1000 {
+		t.Error("IndexOf should find vertex 1000")
+	}
+	if _, ok := c.IndexOf(999); ok {
+		t.Error("IndexOf should not find vertex 999")
+	}
+
+	degree := 0
+	for id, w := range c.Neighbors(1000) {
+		if id != 3 || w != 7 {
+			t.Errorf("Unexpected neighbor %d (w:%d)", id, w)
+		}
+		degree++
+	}
+	if degree != 1 {
+		t.Errorf("Expected 1 neighbor of 1000, got %d", degree)
+	}
+
+	_, want := g.Kruskal()
+	tree, total := c.Kruskal()
+	fmt.Printf("  kruskal: %d edges, total %d (graph: %d)\n", len(tree), total, want)
+	if total != want || len(tree) != c.VertexCount()-1 {
+		t.Errorf("Kruskal: expected total %d, got %d with %d edges", want, total, len(tree))
+	}
+	if _, total := c.Prim(1000); total != want {
+		t.Errorf("Prim: expected total %d, got %d", want, total)
+	}
+	sum := 0
+	for _, e := range tree {
+		_, _, w := c.EdgeAt(e)
+		sum += w
+	}
+	if sum != total {
+		t.Errorf("Edge weights sum to %d, expected %d", sum, total)
+	}
+}
+
+// TestCSRInvalid tests rejection of corrupt data and the unsorted Kruskal path
+func TestCSRInvalid(t *testing.T) {
+	fmt.Println("\n=== CSR INVALID DATA TEST ===")
+
+	g := newPathGraph(3, 1, 2)
+	var buf bytes.Buffer
+	if err := g.WriteCSR(&buf); err != nil {
+		t.Fatal(err)
+	}
+	data := buf.Bytes()
+
+	if _, err := ReadCSR(data[:len(data)-8]); !errors.Is(err, ErrInvalidCSR) {
+		t.Errorf("Expected ErrInvalidCSR for truncated data, got %v", err)
+	}
+	bad := bytes.Clone(data)
+	bad[0] = 'X'
+	if _, err := ReadCSR(bad); !errors.Is(err, ErrInvalidCSR) {
+		t.Errorf("Expected ErrInvalidCSR for bad magic, got %v", err)
+	}
+
+	// Misaligned input is copied rather than viewed
+	shifted := append([]byte{0}, data...)
+	c, err := ReadCSR(shifted[1:])
+	if err != nil {
+		t.Fatalf("ReadCSR failed on misaligned data: %v", err)
+	}
+	if _, total := KruskalIndexed(unsortedView{c}); total != 6 {
+		t.Errorf("Expected MST weight 6, got %d", total)
+	}
+}
+
+// TestCSRCorrupted tests that out-of-range indices and misordered arrays
+// are rejected by ReadCSR with validation instead of panicking later
+func TestCSRCorrupted(t *testing.T) {
+	g := newPathGraph(3, 1, 2)
+	var buf bytes.Buffer
+	if err := g.WriteCSR(&buf); err != nil {
+		t.Fatal(err)
+	}
+	data := buf.Bytes()
+
+	// Section starts for 4 vertices and 3 undirected edges, each padded to 8 bytes
+	const ids, offsets, neighbors, adjEdges, to, weight = 32, 64, 104, 128, 168, 184
+	cases := []struct {
+		name  string
+		patch func(b []byte)
+	}{
+		{"vertex IDs", func(b []byte) { binary.LittleEndian.PutUint64(b[ids+8:], 0) }},
+		{"offsets", func(b []byte) { binary.LittleEndian.PutUint64(b[offsets+16:], 0) }},
+		{"first offset", func(b []byte) { binary.LittleEndian.PutUint64(b[offsets:], 1) }},
+		{"neighbor", func(b []byte) { binary.LittleEndian.PutUint32(b[neighbors:], 1000) }},
+		{"adjacency edge", func(b []byte) { binary.LittleEndian.PutUint32(b[adjEdges:], 1000) }},
+		{"adjacency mismatch", func(b []byte) { binary.LittleEndian.PutUint32(b[neighbors:], 2) }},
+		{"edge endpoint", func(b []byte) { binary.LittleEndian.PutUint32(b[to+4:], 1000) }},
+		{"weights", func(b []byte) { binary.LittleEndian.PutUint64(b[weight:], 9) }},
+	}
+	for _, tc := range cases {
+		bad := bytes.Clone(data)
+		tc.patch(bad)
+		if _, err := ReadCSR(bad, WithCSRValidation()); !errors.Is(err, ErrInvalidCSR) {
+			t.Errorf("%s: expected ErrInvalidCSR, got %v", tc.name, err)
+		}
+	}
+	if _, err := ReadCSR(data, WithCSRValidation()); err != nil {
+		t.Errorf("Expected the unpatched data to load, got %v", err)
+	}
+
+	// Without validation only the header and section sizes are checked
+	bad := bytes.Clone(data)
+	binary.LittleEndian.PutUint32(bad[neighbors:], 1000)
+	if _, err := ReadCSR(bad); err != nil {
+		t.Errorf("Expected unvalidated data to load, got %v", err)
+	}
+	binary.LittleEndian.PutUint64(bad[offsets:], 1)
+	if _, err := ReadCSR(bad); !errors.Is(err, ErrInvalidCSR) {
+		t.Errorf("Expected a bad first offset to be rejected, got %v", err)
+	}
+}
+
+// TestWriteCSRStream tests that an edge stream is written exactly like the
+// Graph with the same edges, and that stream errors are returned
+func TestWriteCSRStream(t *testing.T) {
+	g := buildCompleteGraph(6)
+	g.AddEdge(Edge{From: &Vertex{ID: 1000}, To: &Vertex{ID: 3}, Weight: 7})
+	var want bytes.Buffer
+	if err := g.WriteCSR(&want); err != nil {
+		t.Fatal(err)
+	}
+
+	stream := func(yield func(EdgeRecord, error) bool) {
+		for _, e := range g.Edges {
+			if !yield(EdgeRecord{From: e.From.ID, To: e.To.ID, Weight: e.Weight}, nil) {
+				return
+			}
+		}
+	}
+	var got bytes.Buffer
+	if err := WriteCSRStream(&got, false, stream); err != nil {
+		t.Fatalf("WriteCSRStream failed: %v", err)
+	}
+	if !bytes.Equal(got.Bytes(), want.Bytes()) {
+		t.Error("Expected the streamed file to match WriteCSR")
+	}
+	c, err := ReadCSR(got.Bytes(), WithCSRValidation())
+	if err != nil {
+		t.Fatalf("ReadCSR failed: %v", err)
+	}
+	_, weight := g.Kruskal()
+	if _, total := c.Kruskal(); total != weight {
+		t.Errorf("Expected MST weight %d, got %d", weight, total)
+	}
+
+	failing := func(yield func(EdgeRecord, error) bool) {
+		yield(EdgeRecord{From: 0, To: 1, Weight: 1}, nil)
+		yield(EdgeRecord{}, io.ErrUnexpectedEOF)
+	}
+	if err := WriteCSRStream(io.Discard, false, failing); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("Expected the stream error, got %v", err)
+	}
+}
+
+// unsortedView hides SortedByWeight so KruskalIndexed takes its sorting path
+type unsortedView struct{ IndexedGraph }
@@ -0,0 +1,100 @@
+package mst
+
+import (
+	"context"
+	"math"
+)
+
+// ==================== DEADLINE-BOUNDED APPROXIMATION ====================
+
+// stopInterval is how many edges are scanned between deadline checks
+const stopInterval = 1024
+
+// ApproximateResult is the spanning structure returned by ApproximateMST
+type ApproximateResult struct {
+	Edges       []*Edge
+	Weight      int
+	Approximate bool // the context ended before the tree was proven minimal
+	Rounds      int  // Borůvka rounds completed before the deadline
+	Exact       int  // leading entries of Edges guaranteed to be in a minimum spanning tree
+	LowerBound  int  // no spanning tree of the graph weighs less than this
+}
+
+// Gap returns how much heavier the result may be than a minimum spanning tree
+func (r ApproximateResult) Gap() int {
+	return r.Weight - r.LowerBound
+}
+
+// ApproximateMST runs Borůvka's algorithm until ctx is done and then returns
+// the best spanning structure found so far instead of nothing
+// Edges from completed rounds are exact; the remaining components are joined
+// by a single unsorted pass over the edges, which is linear and stays within
+// the deadline budget of an interactive caller. When that happens the result
+// is flagged Approximate and LowerBound certifies how far off it can be
+// A context that never ends gives the same tree as Boruvka
+func (g *Graph) ApproximateMST(ctx context.Context, opts ...Option) ApproximateResult {
+	if g.Directed {
+		panic("ApproximateMST only works for undirected graphs")
+	}
+
+	o := newOptions(opts)
+	o.prepareConstraints(g)
+	c := o.constraints
+
+	m := o.startMetrics("approximate")
+	defer m.finish()
+	m.startPhase("rounds")
+	began := o.logStart("approximate", g)
+
+	uf := NewUnionFind()
+	for id := range g.Vertices {
+		uf.MakeSet(id)
+	}
+	stop := func() bool { return ctx.Err() != nil }
+	mst, totalWeight, rounds, interrupted := g.boruvkaRounds(o, m, uf, stop)
+
+	result := ApproximateResult{
+		Rounds:      rounds,
+		Exact:       len(mst),
+		Approximate: interrupted,
+	}
+	if !interrupted {
+		result.Edges, result.Weight, result.LowerBound = mst, totalWeight, totalWeight
+		o.logFinish("approximate", g, mst, totalWeight, len(mst) < g.VertexCount()-1, began)
+		return result
+	}
+
+	// Every missing tree edge crosses the current components, so none is
+	// lighter than the lightest crossing edge
+	m.startPhase("complete")
+	lightest := math.MaxInt
+	for _, edge := range g.Edges {
+		m.count(CounterEdgesScanned)
+		if c.allowed(edge) && uf.Find(edge.From.ID) != uf.Find(edge.To.ID) {
+			lightest = min(lightest, edge.Weight)
+		}
+	}
+
+	// Join the components with whatever crossing edges come first, taking
+	// required edges before the rest
+	exactWeight := totalWeight
+	for _, required := range [2]bool{true, false} {
+		for _, edge := range g.Edges {
+			if c.isRequired(edge) != required || !c.allowed(edge) {
+				continue
+			}
+			m.count(CounterEdgesScanned)
+			m.count(CounterUnions)
+			if uf.Union(edge.From.ID, edge.To.ID) {
+				o.traceEdge("approximate", EventEdgeAccepted, edge)
+				mst = append(mst, edge)
+				totalWeight += edge.Weight
+			}
+		}
+	}
+
+	result.Edges, result.Weight = mst, totalWeight
+	result.LowerBound = exactWeight + (len(mst)-result.Exact)*lightest
+	o.logFinish("approximate", g, mst, totalWeight, len(mst) < g.VertexCount()-1, began)
+	return result
+}
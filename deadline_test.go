@@ -0,0 +1,66 @@
+package mst
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+// TestApproximateMST tests the deadline-bounded fallback and its certified bound
+func TestApproximateMST(t *testing.T) {
+	fmt.Println("\n=== APPROXIMATE MST TEST ===")
+
+	g := buildCompleteGraph(60)
+	_, want := g.Kruskal()
+
+	// Without a deadline the result is exact
+	exact := g.ApproximateMST(context.Background())
+	if exact.Approximate || exact.Weight != want || exact.Gap() != 0 || exact.Exact != 59 {
+		t.Errorf("Expected exact weight %d, got %+v", want, exact)
+	}
+
+	// An expired context still yields a spanning tree
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	quick := g.ApproximateMST(ctx)
+	if !quick.Approximate || quick.Rounds != 0 || quick.Exact != 0 || len(quick.Edges) != 59 {
+		t.Errorf("Expected an approximate 59-edge tree after no rounds, got %+v", quick)
+	}
+	if quick.LowerBound > want || quick.Weight < want {
+		t.Errorf("Expected %d <= %d <= %d", quick.LowerBound, want, quick.Weight)
+	}
+
+	// Cancelling after the first round keeps that round's edges as exact
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	partial := g.ApproximateMST(ctx, WithTracer(TraceFunc(func(e TraceEvent) {
+		if e.Kind == EventEdgeAccepted {
+			cancel()
+		}
+	})))
+	fmt.Printf("Approximate weight %d (exact %d, bound %d, %d rounds)\n",
+		partial.Weight, want, partial.LowerBound, partial.Rounds)
+	if !partial.Approximate || partial.Rounds != 1 || partial.Exact == 0 || len(partial.Edges) != 59 {
+		t.Errorf("Expected one exact round completed to a tree, got %+v", partial)
+	}
+	if partial.LowerBound > want || partial.Weight < want || partial.Gap() > quick.Gap() {
+		t.Errorf("Expected a tighter bound after one round, got %+v", partial)
+	}
+	if !validSpanningTree(g, partial.Edges) {
+		t.Error("Expected the approximate edges to form a spanning tree")
+	}
+}
+
+// validSpanningTree reports whether edges connect every vertex of g without cycles
+func validSpanningTree(g Graph, edges []*Edge) bool {
+	uf := NewUnionFind()
+	for id := range g.Vertices {
+		uf.MakeSet(id)
+	}
+	for _, edge := range edges {
+		if !uf.Union(edge.From.ID, edge.To.ID) {
+			return false
+		}
+	}
+	return len(edges) == g.VertexCount()-1
+}
@@ -0,0 +1,123 @@
+package mst
+
+import "sort"
+
+// ==================== SINGLE-LINKAGE DENDROGRAM ====================
+
+// Merge is one step of a Dendrogram, joining two clusters into a new one
+// Clusters are numbered like SciPy linkage matrices: 0 to len(Leaves)-1
+// are the single vertices, and len(Leaves)+i is the cluster made by Merges[i]
+type Merge struct {
+	Left, Right int   // clusters joined, Left being the one with the smaller vertex ID
+	Height      int   // weight of the MST edge that joined them
+	Size        int   // number of vertices in the new cluster
+	Edge        *Edge // the MST edge
+}
+
+// Dendrogram is the single-linkage hierarchy of a graph's vertices
+type Dendrogram struct {
+	Leaves []int   // vertex IDs of the leaf clusters, ascending
+	Merges []Merge // in order of non-decreasing height
+}
+
+// Dendrogram builds the single-linkage clustering tree of the graph by
+// applying its MST edges from lightest to heaviest
+// Cutting it at a height gives the same clusters as removing heavier MST
+// edges, so every CutHeaviest result is a level of the dendrogram. A
+// disconnected graph leaves one top cluster per component. Options such as
+// WithForbiddenEdges shape the MST the hierarchy is built from
+func (g *Graph) Dendrogram(opts ...Option) *Dendrogram {
+	if g.Directed {
+		panic("Dendrogram only works for undirected graphs")
+	}
+
+	o := newOptions(opts)
+	o.prepareConstraints(g)
+	tree, _ := g.kruskal(o)
+	// Required edges come first from Kruskal, so restore height order
+	sort.SliceStable(tree, func(i, j int) bool {
+		return o.weightOf(tree[i]) < o.weightOf(tree[j])
+	})
+
+	d := &Dendrogram{
+		Leaves: g.SortedVertexIDs(),
+		Merges: make([]Merge, 0, len(tree)),
+	}
+	uf := NewUnionFind()
+	cluster := make(map[int]int, len(d.Leaves)) // set root to cluster number
+	size := make(map[int]int, len(d.Leaves))
+	smallest := make(map[int]int, len(d.Leaves))
+	for i, id := range d.Leaves {
+		uf.MakeSet(id)
+		cluster[id] = i
+		size[id] = 1
+		smallest[id] = id
+	}
+
+	for _, e := range tree {
+		a, b := uf.Find(e.From.ID), uf.Find(e.To.ID)
+		left, right := cluster[a], cluster[b]
+		if smallest[a] > smallest[b] {
+			left, right = right, left
+		}
+		merged, low := size[a]+size[b], min(smallest[a], smallest[b])
+		uf.Union(a, b)
+		root := uf.Find(a)
+		cluster[root] = len(d.Leaves) + len(d.Merges)
+		size[root], smallest[root] = merged, low
+		d.Merges = append(d.Merges, Merge{Left: left, Right: right, Height: o.weightOf(e), Size: merged, Edge: e})
+	}
+	return d
+}
+
+// Members returns the vertex IDs in a cluster, ascending
+func (d *Dendrogram) Members(cluster int) []int {
+	members := make([]int, 0)
+	stack := []int{cluster}
+	for len(stack) > 0 {
+		c := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if c < len(d.Leaves) {
+			members = append(members, d.Leaves[c])
+			continue
+		}
+		m := d.Merges[c-len(d.Leaves)]
+		stack = append(stack, m.Left, m.Right)
+	}
+	sort.Ints(members)
+	return members
+}
+
+// Cut returns the clusters formed by every merge at or below height
+// Groups are sorted like CutHeaviest, and each vertex is in exactly one
+func (d *Dendrogram) Cut(height int) [][]int {
+	n := sort.Search(len(d.Merges), func(i int) bool {
+		return d.Merges[i].Height > height
+	})
+	return d.cutAfter(n)
+}
+
+// CutK returns at most k clusters by undoing the heaviest merges, or one
+// cluster per component when the graph has more than k components
+func (d *Dendrogram) CutK(k int) [][]int {
+	n := len(d.Leaves) - k
+	if n < 0 {
+		n = 0
+	}
+	if n > len(d.Merges) {
+		n = len(d.Merges)
+	}
+	return d.cutAfter(n)
+}
+
+// cutAfter applies the first n merges
+func (d *Dendrogram) cutAfter(n int) [][]int {
+	uf := NewUnionFind()
+	for _, id := range d.Leaves {
+		uf.MakeSet(id)
+	}
+	for _, m := range d.Merges[:n] {
+		uf.Union(m.Edge.From.ID, m.Edge.To.ID)
+	}
+	return groupsOf(uf)
+}
@@ -0,0 +1,87 @@
+package mst
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+// TestDendrogram tests merges and cuts against CutHeaviest
+func TestDendrogram(t *testing.T) {
+	fmt.Println("\n=== DENDROGRAM TEST ===")
+
+	g, _ := buildConstraintGraph()
+	d := g.Dendrogram()
+
+	// MST edges: 1-2 (1), 0-2 (2), 3-4 (2), 4-5 (3), 1-3 (5)
+	expected := []Merge{
+		{Left: 1, Right: 2, Height: 1, Size: 2},
+		{Left: 0, Right: 6, Height: 2, Size: 3},
+		{Left: 3, Right: 4, Height: 2, Size: 2},
+		{Left: 8, Right: 5, Height: 3, Size: 3},
+		{Left: 7, Right: 9, Height: 5, Size: 6},
+	}
+	if len(d.Merges) != len(expected) {
+		t.Fatalf("Expected %d merges, got %d", len(expected), len(d.Merges))
+	}
+	for i, m := range d.Merges {
+		fmt.Printf("  %d: %d + %d at %d (size %d)\n", len(d.Leaves)+i, m.Left, m.Right, m.Height, m.Size)
+		m.Edge = nil
+		if m != expected[i] {
+			t.Errorf("Merge %d: expected %+v, got %+v", i, expected[i], m)
+		}
+	}
+
+	if members := d.Members(9); !reflect.DeepEqual(members, []int{3, 4, 5}) {
+		t.Errorf("Expected cluster 9 to hold [3 4 5], got %v", members)
+	}
+
+	mst, _ := g.Kruskal()
+	for k := 1; k <= 7; k++ {
+		if got, want := d.CutK(k), CutHeaviest(mst, k); !reflect.DeepEqual(got, want) {
+			t.Errorf("CutK(%d): expected %v, got %v", k, want, got)
+		}
+	}
+
+	cases := []struct {
+		height   int
+		expected [][]int
+	}{
+		{0, [][]int{{0}, {1}, {2}, {3}, {4}, {5}}},
+		{2, [][]int{{0, 1, 2}, {3, 4}, {5}}},
+		{4, [][]int{{0, 1, 2}, {3, 4, 5}}},
+		{5, [][]int{{0, 1, 2, 3, 4, 5}}},
+	}
+	for _, c := range cases {
+		if groups := d.Cut(c.height); !reflect.DeepEqual(groups, c.expected) {
+			t.Errorf("Cut(%d): expected %v, got %v", c.height, c.expected, groups)
+		}
+	}
+}
+
+// TestDendrogramForest tests isolated vertices and separate components
+func TestDendrogramForest(t *testing.T) {
+	fmt.Println("\n=== DENDROGRAM FOREST TEST ===")
+
+	g := NewGraph(false)
+	g.AddEdge(Edge{From: &Vertex{ID: 4}, To: &Vertex{ID: 2}, Weight: 7})
+	g.AddEdge(Edge{From: &Vertex{ID: 8}, To: &Vertex{ID: 9}, Weight: 1})
+	g.AddVertex(Vertex{ID: 5})
+
+	d := g.Dendrogram()
+	if !reflect.DeepEqual(d.Leaves, []int{2, 4, 5, 8, 9}) || len(d.Merges) != 2 {
+		t.Fatalf("Expected 5 leaves and 2 merges, got %v and %d", d.Leaves, len(d.Merges))
+	}
+	if m := d.Merges[1]; m.Left != 0 || m.Right != 1 || m.Height != 7 {
+		t.Errorf("Expected 2 and 4 joined at 7 last, got %+v", m)
+	}
+
+	want := [][]int{{2, 4}, {5}, {8, 9}}
+	if groups := d.CutK(1); !reflect.DeepEqual(groups, want) {
+		t.Errorf("Expected one cluster per component %v, got %v", want, groups)
+	}
+	empty := NewGraph(false)
+	if groups := empty.Dendrogram().Cut(10); len(groups) != 0 {
+		t.Errorf("Expected no clusters for an empty graph, got %v", groups)
+	}
+}
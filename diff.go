@@ -0,0 +1,186 @@
+package mst
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+)
+
+// ==================== GRAPH DIFF AND PATCH ====================
+
+// WeightChange records an edge whose weight differs between two graphs
+type WeightChange struct {
+	From, To             int
+	OldWeight, NewWeight int
+}
+
+// GraphDelta is the set of changes that turns one graph into another
+// Edges are identified by their endpoints, and by weight among parallel
+// edges; undirected edges are listed with From <= To. Every list is sorted,
+// so equal deltas compare and serialize identically
+type GraphDelta struct {
+	AddedVertices   []Vertex // copies without adjacency lists
+	RemovedVertices []int
+	AddedEdges      []EdgeRecord
+	RemovedEdges    []EdgeRecord // includes the edges of removed vertices
+	WeightChanges   []WeightChange
+}
+
+// IsEmpty reports whether the delta changes nothing
+func (d *GraphDelta) IsEmpty() bool {
+	return len(d.AddedVertices) == 0 && len(d.RemovedVertices) == 0 &&
+		len(d.AddedEdges) == 0 && len(d.RemovedEdges) == 0 && len(d.WeightChanges) == 0
+}
+
+// Diff computes the changes that turn graph a into graph b
+// Between parallel edges with the same endpoints, equal weights are matched
+// first; the remaining ones are paired up as weight changes in ascending
+// weight order, and any surplus is added or removed. Changes to names,
+// vertex weights, Data, or Attrs of vertices and edges present in both
+// graphs are not tracked
+func Diff(a, b *Graph) *GraphDelta {
+	d := &GraphDelta{}
+	for _, id := range b.SortedVertexIDs() {
+		if _, exists := a.Vertices[id]; !exists {
+			v := b.Vertices[id]
+			v.Edges = nil
+			d.AddedVertices = append(d.AddedVertices, v)
+		}
+	}
+	for _, id := range a.SortedVertexIDs() {
+		if _, exists := b.Vertices[id]; !exists {
+			d.RemovedVertices = append(d.RemovedVertices, id)
+		}
+	}
+
+	before, after := a.weightsByArc(), b.weightsByArc()
+	arcs := make([]arcKey, 0, len(before)+len(after))
+	for arc := range before {
+		arcs = append(arcs, arc)
+	}
+	for arc := range after {
+		if _, seen := before[arc]; !seen {
+			arcs = append(arcs, arc)
+		}
+	}
+	slices.SortFunc(arcs, func(x, y arcKey) int {
+		return cmp.Or(cmp.Compare(x.from, y.from), cmp.Compare(x.to, y.to))
+	})
+
+	for _, arc := range arcs {
+		removed, added := unmatchedWeights(before[arc], after[arc])
+		pairs := min(len(removed), len(added))
+		for i := range pairs {
+			d.WeightChanges = append(d.WeightChanges, WeightChange{
+				From: arc.from, To: arc.to, OldWeight: removed[i], NewWeight: added[i],
+			})
+		}
+		for _, w := range removed[pairs:] {
+			d.RemovedEdges = append(d.RemovedEdges, EdgeRecord{From: arc.from, To: arc.to, Weight: w})
+		}
+		for _, w := range added[pairs:] {
+			d.AddedEdges = append(d.AddedEdges, EdgeRecord{From: arc.from, To: arc.to, Weight: w})
+		}
+	}
+	return d
+}
+
+// weightsByArc groups the edge weights of g by endpoints, sorted ascending
+func (g *Graph) weightsByArc() map[arcKey][]int {
+	weights := make(map[arcKey][]int)
+	for _, edge := range g.Edges {
+		arc := g.arcOf(edge.From.ID, edge.To.ID)
+		weights[arc] = append(weights[arc], edge.Weight)
+	}
+	for _, w := range weights {
+		slices.Sort(w)
+	}
+	return weights
+}
+
+// arcOf returns the key an edge between two vertices is diffed under,
+// which ignores direction in undirected graphs
+func (g *Graph) arcOf(from, to int) arcKey {
+	if !g.Directed && from > to {
+		from, to = to, from
+	}
+	return arcKey{from: from, to: to}
+}
+
+// unmatchedWeights removes the common weights of two sorted lists and
+// returns what remains of each
+func unmatchedWeights(before, after []int) (removed, added []int) {
+	i, j := 0, 0
+	for i < len(before) && j < len(after) {
+		switch {
+		case before[i] == after[j]:
+			i++
+			j++
+		case before[i] < after[j]:
+			removed = append(removed, before[i])
+			i++
+		default:
+			added = append(added, after[j])
+			j++
+		}
+	}
+	return append(removed, before[i:]...), append(added, after[j:]...)
+}
+
+// Apply replays a delta computed by Diff onto the graph
+// Edges are removed and reweighted first, then vertices are removed and
+// added, and finally new edges are added. If an edge or vertex the delta
+// refers to is missing, every change made so far is rolled back and an
+// error wrapping ErrEdgeNotFound or ErrVertexNotFound is returned
+func (g *Graph) Apply(delta *GraphDelta) error {
+	tx := g.Begin()
+
+	byArc := make(map[arcKey][]*Edge)
+	for _, edge := range g.Edges {
+		arc := g.arcOf(edge.From.ID, edge.To.ID)
+		byArc[arc] = append(byArc[arc], edge)
+	}
+	// take finds and claims an edge with the given endpoints and weight
+	take := func(from, to, weight int) (*Edge, error) {
+		arc := g.arcOf(from, to)
+		edges := byArc[arc]
+		for i, edge := range edges {
+			if edge.Weight == weight {
+				byArc[arc] = slices.Delete(edges, i, i+1)
+				return edge, nil
+			}
+		}
+		return nil, fmt.Errorf("apply %d-%d with weight %d: %w", from, to, weight, ErrEdgeNotFound)
+	}
+
+	fail := func(err error) error {
+		tx.Rollback()
+		return err
+	}
+	for _, rec := range delta.RemovedEdges {
+		edge, err := take(rec.From, rec.To, rec.Weight)
+		if err != nil {
+			return fail(err)
+		}
+		g.RemoveEdge(edge)
+	}
+	for _, change := range delta.WeightChanges {
+		edge, err := take(change.From, change.To, change.OldWeight)
+		if err != nil {
+			return fail(err)
+		}
+		g.SetEdgeWeight(edge, change.NewWeight)
+	}
+	for _, id := range delta.RemovedVertices {
+		if !g.RemoveVertex(id) {
+			return fail(fmt.Errorf("apply removal of %d: %w", id, ErrVertexNotFound))
+		}
+	}
+	for _, v := range delta.AddedVertices {
+		g.AddVertex(v)
+	}
+	for _, rec := range delta.AddedEdges {
+		g.AddEdge(Edge{From: &Vertex{ID: rec.From}, To: &Vertex{ID: rec.To}, Weight: rec.Weight})
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,60 @@
+package mst
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// TestDiffApply tests that applying a diff reproduces the target graph
+func TestDiffApply(t *testing.T) {
+	fmt.Println("\n=== DIFF/APPLY TEST ===")
+
+	build := func(edges [][3]int, extra ...int) Graph {
+		g := NewGraph(false)
+		for _, e := range edges {
+			g.AddEdge(Edge{From: &Vertex{ID: e[0], Name: fmt.Sprint("V", e[0])}, To: &Vertex{ID: e[1], Name: fmt.Sprint("V", e[1])}, Weight: e[2]})
+		}
+		for _, id := range extra {
+			g.AddVertex(Vertex{ID: id, Name: fmt.Sprint("V", id)})
+		}
+		return g
+	}
+	a := build([][3]int{{0, 1, 4}, {1, 2, 2}, {2, 3, 7}, {3, 0, 1}, {1, 3, 5}, {1, 3, 6}}, 9)
+	b := build([][3]int{{1, 0, 4}, {1, 2, 3}, {3, 0, 1}, {1, 3, 6}, {2, 4, 8}})
+
+	d := Diff(&a, &b)
+	fmt.Printf("Delta: %+v\n", *d)
+	if len(d.AddedVertices) != 1 || len(d.RemovedVertices) != 1 || d.RemovedVertices[0] != 9 {
+		t.Errorf("Expected vertex 4 added and 9 removed, got %+v", d)
+	}
+	if len(d.WeightChanges) != 1 || d.WeightChanges[0] != (WeightChange{From: 1, To: 2, OldWeight: 2, NewWeight: 3}) {
+		t.Errorf("Expected one weight change on 1-2, got %+v", d.WeightChanges)
+	}
+	if len(d.RemovedEdges) != 2 || len(d.AddedEdges) != 1 {
+		t.Errorf("Expected 2 removed and 1 added edge, got %+v and %+v", d.RemovedEdges, d.AddedEdges)
+	}
+
+	if err := a.Apply(d); err != nil {
+		t.Fatal(err)
+	}
+	if !Diff(&a, &b).IsEmpty() || a.Hash() != b.Hash() {
+		t.Errorf("Expected the patched graph to equal the target, still differs by %+v", *Diff(&a, &b))
+	}
+
+	// A delta that no longer fits is rolled back entirely
+	before := a.Hash()
+	stale := &GraphDelta{
+		RemovedEdges:  []EdgeRecord{{From: 0, To: 1, Weight: 4}},
+		WeightChanges: []WeightChange{{From: 2, To: 3, OldWeight: 7, NewWeight: 9}},
+	}
+	if err := a.Apply(stale); !errors.Is(err, ErrEdgeNotFound) {
+		t.Errorf("Expected ErrEdgeNotFound, got %v", err)
+	}
+	if err := a.Apply(&GraphDelta{RemovedVertices: []int{42}}); !errors.Is(err, ErrVertexNotFound) {
+		t.Errorf("Expected ErrVertexNotFound, got %v", err)
+	}
+	if a.Hash() != before {
+		t.Error("Expected a failed Apply to leave the graph unchanged")
+	}
+}
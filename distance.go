@@ -0,0 +1,230 @@
+package mst
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"math"
+	"strconv"
+)
+
+// ==================== DISTANCE MATRIX ====================
+
+// ErrNegativeCycle is returned when a cycle of negative total weight makes
+// shortest paths undefined; in an undirected graph any negative edge is one
+var ErrNegativeCycle = errors.New("graph has a negative cycle")
+
+// Unreachable is the distance between vertices joined by no path
+const Unreachable = math.MaxInt
+
+// DistanceMatrix holds the distance between every ordered pair of vertices
+type DistanceMatrix struct {
+	IDs    []int    // vertex IDs of the rows and columns, ascending
+	Labels []string // vertex names, or IDs for unnamed vertices
+	Dist   [][]int  // Dist[i][j] from IDs[i] to IDs[j], or Unreachable
+
+	index map[int]int
+}
+
+// DistanceOption configures DistanceMatrix
+type DistanceOption func(*distanceOptions)
+
+// distanceOptions holds the settings collected from a list of DistanceOption values
+type distanceOptions struct {
+	tree    bool     // measure paths in the MST instead of shortest paths
+	treeMST []Option // options of the Kruskal run that finds the MST
+}
+
+// WithTreeDistances makes DistanceMatrix measure distances along the MST
+// that Kruskal finds with opts, instead of shortest paths
+func WithTreeDistances(opts ...Option) DistanceOption {
+	return func(o *distanceOptions) {
+		o.tree = true
+		o.treeMST = append(o.treeMST, opts...)
+	}
+}
+
+// DistanceMatrix returns the shortest-path distance by Edge.Weight between
+// every pair of vertices
+// Dijkstra runs from every vertex in O(V·E log V); with negative weights
+// Floyd-Warshall is used instead and a negative cycle returns
+// ErrNegativeCycle. WithTreeDistances measures the unique path in the
+// minimum spanning forest instead, which is defined for negative weights
+// too and leaves separate trees Unreachable
+func (g *Graph) DistanceMatrix(opts ...DistanceOption) (*DistanceMatrix, error) {
+	var do distanceOptions
+	for _, opt := range opts {
+		opt(&do)
+	}
+	m := g.newDistanceMatrix()
+
+	if do.tree {
+		if g.Directed {
+			panic("tree distances only work for undirected graphs")
+		}
+		o := newOptions(do.treeMST)
+		o.prepareConstraints(g)
+		tree, _ := g.kruskal(o)
+		m.fillTree(tree)
+		return m, nil
+	}
+
+	for _, e := range g.Edges {
+		if e.Weight < 0 {
+			return m, m.floydWarshall(g)
+		}
+	}
+	for i, id := range m.IDs {
+		g.dijkstraRow(id, m.index, m.Dist[i])
+	}
+	return m, nil
+}
+
+// newDistanceMatrix returns a matrix over g's vertices with every
+// distance Unreachable except from a vertex to itself
+func (g *Graph) newDistanceMatrix() *DistanceMatrix {
+	ids := g.SortedVertexIDs()
+	m := &DistanceMatrix{
+		IDs:    ids,
+		Labels: make([]string, len(ids)),
+		Dist:   make([][]int, len(ids)),
+		index:  make(map[int]int, len(ids)),
+	}
+	for i, id := range ids {
+		m.index[id] = i
+		m.Labels[i] = g.Vertices[id].Name
+		if m.Labels[i] == "" {
+			m.Labels[i] = strconv.Itoa(id)
+		}
+		m.Dist[i] = make([]int, len(ids))
+		for j := range m.Dist[i] {
+			m.Dist[i][j] = Unreachable
+		}
+		m.Dist[i][i] = 0
+	}
+	return m
+}
+
+// dijkstraRow writes the distances from source into row, which is indexed
+// like the matrix columns
+func (g *Graph) dijkstraRow(source int, index map[int]int, row []int) {
+	heap := NewDAryHeap(4, len(g.Vertices))
+	heap.Push(source, 0, nil)
+	settled := make(map[int]bool, len(g.Vertices))
+	for heap.Len() > 0 {
+		id, dist, _ := heap.PopMin()
+		settled[id] = true
+		row[index[id]] = dist
+		for _, e := range g.Vertices[id].Edges {
+			if !settled[e.To.ID] {
+				heap.Push(e.To.ID, dist+e.Weight, e)
+			}
+		}
+	}
+}
+
+// floydWarshall fills the matrix from the edges in O(V³), keeping the
+// lightest of parallel edges
+func (m *DistanceMatrix) floydWarshall(g *Graph) error {
+	relax := func(from, to, w int) {
+		i, j := m.index[from], m.index[to]
+		if w < m.Dist[i][j] {
+			m.Dist[i][j] = w
+		}
+	}
+	for _, e := range g.Edges {
+		relax(e.From.ID, e.To.ID, e.Weight)
+		if !g.Directed {
+			relax(e.To.ID, e.From.ID, e.Weight)
+		}
+	}
+
+	n := len(m.IDs)
+	for k := range n {
+		for i := range n {
+			if m.Dist[i][k] == Unreachable {
+				continue
+			}
+			for j := range n {
+				if m.Dist[k][j] != Unreachable && m.Dist[i][k]+m.Dist[k][j] < m.Dist[i][j] {
+					m.Dist[i][j] = m.Dist[i][k] + m.Dist[k][j]
+				}
+			}
+		}
+	}
+	for i := range n {
+		if m.Dist[i][i] < 0 {
+			return ErrNegativeCycle
+		}
+	}
+	return nil
+}
+
+// fillTree walks the forest from every vertex, setting the distance to
+// each vertex of the same tree
+func (m *DistanceMatrix) fillTree(tree []*Edge) {
+	adj := make(map[int][]*Edge, len(m.IDs))
+	for _, e := range tree {
+		adj[e.From.ID] = append(adj[e.From.ID], e)
+		adj[e.To.ID] = append(adj[e.To.ID], e)
+	}
+
+	type visit struct{ id, parent, dist int }
+	for i, source := range m.IDs {
+		row := m.Dist[i]
+		stack := []visit{{id: source, parent: source}}
+		for len(stack) > 0 {
+			v := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			row[m.index[v.id]] = v.dist
+			for _, e := range adj[v.id] {
+				next := e.To.ID
+				if next == v.id {
+					next = e.From.ID
+				}
+				if next != v.parent {
+					stack = append(stack, visit{id: next, parent: v.id, dist: v.dist + e.Weight})
+				}
+			}
+		}
+	}
+}
+
+// Distance returns the distance from u to v, or false when either is not
+// in the matrix or v cannot be reached from u
+func (m *DistanceMatrix) Distance(u, v int) (int, bool) {
+	i, ok := m.index[u]
+	if !ok {
+		return 0, false
+	}
+	j, ok := m.index[v]
+	if !ok || m.Dist[i][j] == Unreachable {
+		return 0, false
+	}
+	return m.Dist[i][j], true
+}
+
+// WriteCSV writes the matrix with a header row and a first column of
+// vertex labels; unreachable pairs are left empty
+func (m *DistanceMatrix) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	record := make([]string, len(m.IDs)+1)
+	copy(record[1:], m.Labels)
+	if err := cw.Write(record); err != nil {
+		return err
+	}
+	for i, row := range m.Dist {
+		record[0] = m.Labels[i]
+		for j, d := range row {
+			record[j+1] = ""
+			if d != Unreachable {
+				record[j+1] = strconv.Itoa(d)
+			}
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,148 @@
+package mst
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// TestDistanceMatrix tests Dijkstra rows against Floyd-Warshall
+func TestDistanceMatrix(t *testing.T) {
+	fmt.Println("\n=== DISTANCE MATRIX TEST ===")
+
+	g := buildCompleteGraph(20)
+	m, err := g.DistanceMatrix()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := g.newDistanceMatrix()
+	if err := want.floydWarshall(&g); err != nil {
+		t.Fatal(err)
+	}
+	for i := range m.IDs {
+		for j := range m.IDs {
+			if m.Dist[i][j] != want.Dist[i][j] {
+				t.Errorf("Distance %d-%d: expected %d, got %d", i, j, want.Dist[i][j], m.Dist[i][j])
+			}
+			if m.Dist[i][j] != m.Dist[j][i] {
+				t.Errorf("Distance %d-%d is not symmetric", i, j)
+			}
+		}
+	}
+	d, _ := m.Distance(0, 19)
+	fmt.Printf("Distance V0-V19: %d\n", d)
+
+	// One-way and missing paths in a directed graph
+	v := []*Vertex{{ID: 0}, {ID: 1}, {ID: 2}}
+	dg := NewGraph(true)
+	dg.AddEdge(Edge{From: v[0], To: v[1], Weight: 4})
+	dg.AddEdge(Edge{From: v[1], To: v[2], Weight: 1})
+	dg.AddEdge(Edge{From: v[0], To: v[2], Weight: 7})
+	m, err = dg.DistanceMatrix()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d, ok := m.Distance(0, 2); !ok || d != 5 {
+		t.Errorf("Expected distance 0->2 of 5, got %d, %v", d, ok)
+	}
+	if _, ok := m.Distance(2, 0); ok {
+		t.Error("Expected 2->0 to be unreachable")
+	}
+	if _, ok := m.Distance(0, 9); ok {
+		t.Error("Expected no distance to a missing vertex")
+	}
+}
+
+// TestDistanceMatrixNegative tests Floyd-Warshall with negative weights
+func TestDistanceMatrixNegative(t *testing.T) {
+	fmt.Println("\n=== NEGATIVE DISTANCE MATRIX TEST ===")
+
+	v := []*Vertex{{ID: 0}, {ID: 1}, {ID: 2}}
+	dg := NewGraph(true)
+	dg.AddEdge(Edge{From: v[0], To: v[1], Weight: 4})
+	dg.AddEdge(Edge{From: v[1], To: v[2], Weight: -3})
+	dg.AddEdge(Edge{From: v[0], To: v[2], Weight: 2})
+	m, err := dg.DistanceMatrix()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d, _ := m.Distance(0, 2); d != 1 {
+		t.Errorf("Expected distance 0->2 of 1, got %d", d)
+	}
+
+	dg.AddEdge(Edge{From: v[2], To: v[0], Weight: -2})
+	if _, err := dg.DistanceMatrix(); !errors.Is(err, ErrNegativeCycle) {
+		t.Errorf("Expected ErrNegativeCycle, got %v", err)
+	}
+
+	// Any negative undirected edge can be walked back and forth
+	g := NewGraph(false)
+	g.AddEdge(Edge{From: &Vertex{ID: 0}, To: &Vertex{ID: 1}, Weight: -1})
+	if _, err := g.DistanceMatrix(); !errors.Is(err, ErrNegativeCycle) {
+		t.Errorf("Expected ErrNegativeCycle, got %v", err)
+	}
+}
+
+// TestDistanceMatrixTree tests distances along the MST
+func TestDistanceMatrixTree(t *testing.T) {
+	fmt.Println("\n=== TREE DISTANCE MATRIX TEST ===")
+
+	// Square with a diagonal; the MST is 0-1, 1-2, 2-3 and a separate 4-5
+	v := make([]*Vertex, 6)
+	for i := range v {
+		v[i] = &Vertex{ID: i}
+	}
+	g := NewGraph(false)
+	g.AddEdge(Edge{From: v[0], To: v[1], Weight: 1})
+	g.AddEdge(Edge{From: v[1], To: v[2], Weight: 2})
+	g.AddEdge(Edge{From: v[2], To: v[3], Weight: -1})
+	g.AddEdge(Edge{From: v[3], To: v[0], Weight: 5})
+	g.AddEdge(Edge{From: v[0], To: v[2], Weight: 4})
+	g.AddEdge(Edge{From: v[4], To: v[5], Weight: 6})
+
+	m, err := g.DistanceMatrix(WithTreeDistances())
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct{ u, v, want int }{{0, 3, 2}, {3, 0, 2}, {0, 2, 3}, {1, 3, 1}, {4, 5, 6}}
+	for _, c := range cases {
+		if d, ok := m.Distance(c.u, c.v); !ok || d != c.want {
+			t.Errorf("Tree distance %d-%d: expected %d, got %d, %v", c.u, c.v, c.want, d, ok)
+		}
+	}
+	if _, ok := m.Distance(0, 4); ok {
+		t.Error("Expected separate trees to be unreachable")
+	}
+
+	// Forbidding an edge changes the tree the distances follow
+	e, _ := g.GetEdge(0, 1)
+	m, _ = g.DistanceMatrix(WithTreeDistances(WithForbiddenEdges(e)))
+	if d, _ := m.Distance(0, 1); d != 6 {
+		t.Errorf("Expected detour 0-3-2-1 of 6, got %d", d)
+	}
+}
+
+// TestDistanceMatrixCSV tests the CSV export
+func TestDistanceMatrixCSV(t *testing.T) {
+	fmt.Println("\n=== DISTANCE MATRIX CSV TEST ===")
+
+	g := NewGraph(false)
+	a, b := &Vertex{ID: 0, Name: "A"}, &Vertex{ID: 1, Name: "B"}
+	g.AddEdge(Edge{From: a, To: b, Weight: 3})
+	g.AddVertex(Vertex{ID: 2})
+
+	m, err := g.DistanceMatrix()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var sb strings.Builder
+	if err := m.WriteCSV(&sb); err != nil {
+		t.Fatal(err)
+	}
+	fmt.Print(sb.String())
+	want := ",A,B,2\nA,0,3,\nB,3,0,\n2,,,0\n"
+	if sb.String() != want {
+		t.Errorf("Expected CSV\n%s\ngot\n%s", want, sb.String())
+	}
+}
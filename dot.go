@@ -0,0 +1,85 @@
+package mst
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// ==================== DOT RENDERER ====================
+
+// edgeKey identifies an edge by its endpoints and weight
+// For undirected graphs the endpoints are normalized so that an adjacency
+// edge and its reverse map to the same key
+type edgeKey struct {
+	from, to, weight int
+}
+
+func keyOf(e *Edge, directed bool) edgeKey {
+	from, to := e.From.ID, e.To.ID
+	if !directed && from > to {
+		from, to = to, from
+	}
+	return edgeKey{from: from, to: to, weight: e.Weight}
+}
+
+// EdgeStyle returns extra DOT attributes for an edge, or "" for the default style
+type EdgeStyle func(e *Edge) string
+
+// WriteDOT writes the graph in Graphviz DOT format
+// Vertex and edge Attrs are written as extra quoted DOT attributes
+func (g *Graph) WriteDOT(w io.Writer) error {
+	return g.writeDOT(w, nil)
+}
+
+// WriteDOTStyled writes the graph in DOT format, asking style for the attributes of every edge
+func (g *Graph) WriteDOTStyled(w io.Writer, style EdgeStyle) error {
+	return g.writeDOT(w, style)
+}
+
+// WriteDOTHighlighted writes the graph in DOT format with the given edges (e.g. an MST) highlighted
+func (g *Graph) WriteDOTHighlighted(w io.Writer, highlight []*Edge) error {
+	set := make(map[edgeKey]bool, len(highlight))
+	for _, e := range highlight {
+		set[keyOf(e, g.Directed)] = true
+	}
+	return g.writeDOT(w, func(e *Edge) string {
+		if set[keyOf(e, g.Directed)] {
+			return `color="red", penwidth=3`
+		}
+		return ""
+	})
+}
+
+func (g *Graph) writeDOT(w io.Writer, style EdgeStyle) error {
+	bw := bufio.NewWriter(w)
+	kind, arrow := "graph", "--"
+	if g.Directed {
+		kind, arrow = "digraph", "->"
+	}
+
+	fmt.Fprintf(bw, "%s G {\n", kind)
+
+	ids := g.SortedVertexIDs()
+	for _, id := range ids {
+		name := g.Vertices[id].Name
+		if name == "" {
+			name = strconv.Itoa(id)
+		}
+		fmt.Fprintf(bw, "  %d [label=%s%s];\n", id, strconv.Quote(name), dotAttrs(g.Vertices[id].Attrs))
+	}
+
+	for _, e := range g.Edges {
+		attrs := fmt.Sprintf("label=\"%d\"", e.Weight) + dotAttrs(e.Attrs)
+		if style != nil {
+			if extra := style(e); extra != "" {
+				attrs += ", " + extra
+			}
+		}
+		fmt.Fprintf(bw, "  %d %s %d [%s];\n", e.From.ID, arrow, e.To.ID, attrs)
+	}
+
+	fmt.Fprintln(bw, "}")
+	return bw.Flush()
+}
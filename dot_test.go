@@ -0,0 +1,76 @@
+package mst
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// TestWriteDOT tests DOT rendering with highlighted MST edges
+func TestWriteDOT(t *testing.T) {
+	fmt.Println("\n=== DOT RENDERER TEST ===")
+
+	g := NewGraph(false)
+	v0 := &Vertex{ID: 0, Name: "A", Edges: make([]*Edge, 0)}
+	v1 := &Vertex{ID: 1, Name: "B", Edges: make([]*Edge, 0)}
+	v2 := &Vertex{ID: 2, Name: "C", Edges: make([]*Edge, 0)}
+	g.AddEdge(Edge{From: v0, To: v1, Weight: 4})
+	g.AddEdge(Edge{From: v1, To: v2, Weight: 2})
+	g.AddEdge(Edge{From: v0, To: v2, Weight: 3})
+
+	mst, _ := g.Prim(0)
+
+	var buf bytes.Buffer
+	if err := g.WriteDOTHighlighted(&buf, mst); err != nil {
+		t.Fatalf("WriteDOTHighlighted failed: %v", err)
+	}
+	out := buf.String()
+	fmt.Print(out)
+
+	if !strings.HasPrefix(out, "graph G {") {
+		t.Error("Expected an undirected DOT graph")
+	}
+	if !strings.Contains(out, `0 [label="A"];`) {
+		t.Error("Expected vertex 0 labeled A")
+	}
+	if strings.Count(out, "penwidth=3") != 2 {
+		t.Errorf("Expected 2 highlighted edges, got %d", strings.Count(out, "penwidth=3"))
+	}
+	if !strings.Contains(out, `0 -- 1 [label="4"];`) {
+		t.Error("Expected non-tree edge 0 -- 1 without highlight")
+	}
+}
+
+// TestExportFrames tests animation frame export from a trace
+func TestExportFrames(t *testing.T) {
+	fmt.Println("\n=== ANIMATION FRAMES TEST ===")
+
+	g := buildCompleteGraph(4)
+	rec := NewTraceRecorder()
+	g.Kruskal(WithTracer(rec))
+
+	frames := g.BuildFrames(rec.Events)
+	if len(frames) != len(rec.Events) {
+		t.Fatalf("Expected %d frames, got %d", len(rec.Events), len(frames))
+	}
+	if last := frames[len(frames)-1]; len(last.Tree) != 3 {
+		t.Errorf("Expected 3 tree edges in the last frame, got %d", len(last.Tree))
+	}
+
+	dir := t.TempDir()
+	for _, format := range []FrameFormat{FrameDOT, FrameSVG} {
+		paths, err := g.ExportFrames(dir, rec.Events, format)
+		if err != nil {
+			t.Fatalf("ExportFrames(%s) failed: %v", format, err)
+		}
+		if len(paths) != len(frames) {
+			t.Errorf("Expected %d %s files, got %d", len(frames), format, len(paths))
+		}
+		fmt.Printf("✓ %d %s frames written\n", len(paths), format)
+	}
+
+	if _, err := g.ExportFrames(dir, rec.Events, "gif"); err == nil {
+		t.Error("Expected an error for an unsupported format")
+	}
+}
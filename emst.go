@@ -0,0 +1,241 @@
+package mst
+
+import (
+	"fmt"
+	"math"
+	"slices"
+)
+
+// ==================== APPROXIMATE EUCLIDEAN MST ====================
+
+// emstLeafSize is the most points kept in a leaf of the Borůvka k-d tree
+const emstLeafSize = 16
+
+// ApproximateEMST finds a spanning tree of a point set whose Euclidean
+// length is at most 1+epsilon times that of the exact Euclidean MST, and
+// returns it as a graph on vertices 0 to len(points)-1 with its length
+// Borůvka rounds find, for every component, its nearest point in another
+// component with a k-d tree whose subtrees are skipped when they lie
+// within one component. Distances are compared after rounding up to a
+// power of 1+epsilon, so any tree that is minimal under the rounded
+// lengths is a (1+ε)-approximation, and a subtree whose rounded distance
+// ties the best candidate is skipped too; this is what makes a larger
+// epsilon faster. An epsilon of 0 finds the exact tree. Edges carry their
+// length in Data and its rank in Weight like KNNGraph
+func ApproximateEMST(points [][]float64, epsilon float64) (Graph, float64, error) {
+	if !(epsilon >= 0) || math.IsInf(epsilon, 1) {
+		return NewGraph(false), 0, fmt.Errorf("epsilon must be finite and non-negative, got %v", epsilon)
+	}
+	if err := checkDimensions(points); err != nil {
+		return NewGraph(false), 0, err
+	}
+
+	b := newEMSTBoruvka(points, epsilon)
+	tree := b.run()
+	total := 0.0
+	for _, e := range tree {
+		total += e.dist
+	}
+	return newPointGraph(len(points), tree), total, nil
+}
+
+// emstNode is a node of the k-d tree, covering order[start:end]
+type emstNode struct {
+	lo, hi      []float64 // bounding box of the points
+	start, end  int
+	left, right int // child nodes, or -1 for a leaf
+	comp        int // component of every point below, or -1 when mixed
+}
+
+// emstBoruvka holds the state of one ApproximateEMST run
+type emstBoruvka struct {
+	points  [][]float64
+	order   []int
+	nodes   []emstNode
+	logBase float64 // log(1+ε), or 0 for exact distances
+
+	uf   *DenseUnionFind
+	comp []int // component of every point in the current round
+
+	// Best candidate edge of every component in the current round, and the
+	// squared distance a better one must be within
+	best  []pointEdge
+	limit []float64
+}
+
+func newEMSTBoruvka(points [][]float64, epsilon float64) *emstBoruvka {
+	b := &emstBoruvka{
+		points:  points,
+		order:   make([]int, len(points)),
+		logBase: math.Log1p(epsilon),
+		uf:      NewDenseUnionFind(len(points)),
+		comp:    make([]int, len(points)),
+		best:    make([]pointEdge, len(points)),
+		limit:   make([]float64, len(points)),
+	}
+	for i := range b.order {
+		b.order[i] = i
+	}
+	if len(points) > 0 {
+		b.build(0, len(points))
+	}
+	return b
+}
+
+// improves reports whether a squared distance d2 beats component c's
+// candidate; exact distances must be shorter, rounded ones must round to
+// a smaller power of 1+ε
+func (b *emstBoruvka) improves(d2 float64, c int) bool {
+	if b.logBase == 0 {
+		return d2 < b.limit[c]
+	}
+	return d2 <= b.limit[c]
+}
+
+// offer makes the edge from i to j component c's candidate, if it is better
+func (b *emstBoruvka) offer(c, i, j int) {
+	d2 := squaredDistance(b.points[i], b.points[j])
+	if !b.improves(d2, c) {
+		return
+	}
+	d := math.Sqrt(d2)
+	b.best[c] = pointEdge{a: min(i, j), b: max(i, j), dist: d}
+	b.limit[c] = d2
+	if b.logBase != 0 {
+		// Anything up to the next lower power of 1+ε rounds to less
+		lower := math.Exp((math.Ceil(math.Log(d)/b.logBase) - 1) * b.logBase)
+		b.limit[c] = min(lower*lower, math.Nextafter(d2, 0))
+	}
+}
+
+// build adds the node for order[start:end] and its subtrees, splitting on
+// the widest side of the bounding box, and returns its index
+func (b *emstBoruvka) build(start, end int) int {
+	dim := len(b.points[0])
+	node := emstNode{
+		lo:    slices.Clone(b.points[b.order[start]]),
+		hi:    slices.Clone(b.points[b.order[start]]),
+		start: start, end: end, left: -1, right: -1,
+	}
+	for _, i := range b.order[start+1 : end] {
+		for d, x := range b.points[i] {
+			node.lo[d], node.hi[d] = min(node.lo[d], x), max(node.hi[d], x)
+		}
+	}
+	id := len(b.nodes)
+	b.nodes = append(b.nodes, node)
+
+	axis := 0
+	for d := range dim {
+		if node.hi[d]-node.lo[d] > node.hi[axis]-node.lo[axis] {
+			axis = d
+		}
+	}
+	if end-start <= emstLeafSize || node.hi[axis] == node.lo[axis] {
+		return id
+	}
+	slices.SortFunc(b.order[start:end], func(x, y int) int {
+		return cmpFloat(b.points[x][axis], b.points[y][axis])
+	})
+	mid := (start + end) / 2
+	left := b.build(start, mid)
+	right := b.build(mid, end)
+	b.nodes[id].left, b.nodes[id].right = left, right
+	return id
+}
+
+// run performs Borůvka rounds until no component has a nearest neighbor
+// outside it and returns the tree edges
+func (b *emstBoruvka) run() []pointEdge {
+	tree := make([]pointEdge, 0, max(len(b.points)-1, 0))
+	for len(b.points) > 0 {
+		for i := range b.points {
+			b.comp[i] = b.uf.Find(i)
+			b.limit[i] = math.Inf(1)
+		}
+		b.label(0)
+		if b.nodes[0].comp >= 0 {
+			return tree
+		}
+		// Neighboring queries in tree order share components and paths
+		for _, i := range b.order {
+			b.nearest(0, i, 0)
+		}
+		for c := range b.points {
+			if b.comp[c] != c || math.IsInf(b.limit[c], 1) {
+				continue
+			}
+			if e := b.best[c]; b.uf.Union(e.a, e.b) {
+				tree = append(tree, e)
+			}
+		}
+	}
+	return tree
+}
+
+// label sets the component of every node whose points share one
+func (b *emstBoruvka) label(id int) int {
+	n := &b.nodes[id]
+	if n.left < 0 {
+		n.comp = b.comp[b.order[n.start]]
+		for _, i := range b.order[n.start:n.end] {
+			if b.comp[i] != n.comp {
+				n.comp = -1
+				break
+			}
+		}
+		return n.comp
+	}
+	left, right := b.label(n.left), b.label(n.right)
+	n.comp = -1
+	if left == right {
+		n.comp = left
+	}
+	return n.comp
+}
+
+// nearest improves the candidate of point i's component with points
+// below a node that belong to other components, given the squared
+// distance from i to the node's box
+func (b *emstBoruvka) nearest(id, i int, box float64) {
+	n := &b.nodes[id]
+	c := b.comp[i]
+	if n.comp == c || !b.improves(box, c) {
+		return
+	}
+	if n.left < 0 {
+		for _, j := range b.order[n.start:n.end] {
+			if b.comp[j] != c {
+				b.offer(c, i, j)
+			}
+		}
+		return
+	}
+	near, far := n.left, n.right
+	nearBox, farBox := b.boxDistance(&b.nodes[near], i), b.boxDistance(&b.nodes[far], i)
+	if farBox < nearBox {
+		near, far, nearBox, farBox = far, near, farBox, nearBox
+	}
+	b.nearest(near, i, nearBox)
+	b.nearest(far, i, farBox)
+}
+
+// boxDistance is the squared distance from point i to a node's box
+func (b *emstBoruvka) boxDistance(n *emstNode, i int) float64 {
+	sum := 0.0
+	for d, x := range b.points[i] {
+		gap := max(n.lo[d]-x, x-n.hi[d], 0)
+		sum += gap * gap
+	}
+	return sum
+}
+
+// squaredDistance is the squared Euclidean distance between two points
+func squaredDistance(p, q []float64) float64 {
+	sum := 0.0
+	for d := range p {
+		diff := p[d] - q[d]
+		sum += diff * diff
+	}
+	return sum
+}
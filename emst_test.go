@@ -0,0 +1,72 @@
+package mst
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+// exactEMST returns the length of the Euclidean MST over the complete graph
+func exactEMST(t *testing.T, points [][]float64) float64 {
+	g, err := KNNGraph(points, len(points), Euclidean)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, length := g.KruskalFloat(DataWeight)
+	return length
+}
+
+// TestApproximateEMST tests the approximation bound against the exact tree
+func TestApproximateEMST(t *testing.T) {
+	fmt.Println("\n=== APPROXIMATE EMST TEST ===")
+
+	rng := rand.New(rand.NewSource(3))
+	for _, dim := range []int{2, 3} {
+		points := make([][]float64, 300)
+		for i := range points {
+			points[i] = make([]float64, dim)
+			for d := range points[i] {
+				points[i][d] = rng.Float64() * 100
+			}
+		}
+		exact := exactEMST(t, points)
+		for _, epsilon := range []float64{0, 0.05, 0.5, 2} {
+			g, length, err := ApproximateEMST(points, epsilon)
+			if err != nil {
+				t.Fatal(err)
+			}
+			fmt.Printf("  %dD ε=%.2f: length %.2f, exact %.2f\n", dim, epsilon, length, exact)
+			if g.EdgeCount() != len(points)-1 || !g.IsConnected() {
+				t.Errorf("%dD ε=%v: expected a spanning tree, got %d edges", dim, epsilon, g.EdgeCount())
+			}
+			if length > (1+epsilon)*exact+1e-9 || length < exact-1e-9 {
+				t.Errorf("%dD ε=%v: length %v outside [%v, %v]", dim, epsilon, length, exact, (1+epsilon)*exact)
+			}
+		}
+	}
+}
+
+// TestApproximateEMSTDuplicates tests repeated points and tiny inputs
+func TestApproximateEMSTDuplicates(t *testing.T) {
+	fmt.Println("\n=== APPROXIMATE EMST DUPLICATES TEST ===")
+
+	points := [][]float64{{0, 0}, {3, 4}, {0, 0}, {3, 4}, {0, 0}, {6, 8}}
+	g, length, err := ApproximateEMST(points, 0.1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if length != 10 || g.EdgeCount() != 5 || !g.IsConnected() {
+		t.Errorf("Expected a 5-edge tree of length 10, got %d edges of length %v", g.EdgeCount(), length)
+	}
+
+	g, length, _ = ApproximateEMST([][]float64{{1, 2, 3}}, 1)
+	if g.VertexCount() != 1 || length != 0 {
+		t.Errorf("Expected a single vertex, got %d vertices of length %v", g.VertexCount(), length)
+	}
+	if _, _, err := ApproximateEMST(points, -1); err == nil {
+		t.Error("Expected an error for a negative epsilon")
+	}
+	if g, _, err := ApproximateEMST(nil, 1); err != nil || g.VertexCount() != 0 {
+		t.Errorf("Expected an empty graph, got %d vertices, %v", g.VertexCount(), err)
+	}
+}
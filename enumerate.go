@@ -0,0 +1,92 @@
+package mst
+
+import "iter"
+
+// ==================== SPANNING TREE ENUMERATION ====================
+
+// AllSpanningTrees streams every spanning tree of the graph, stopping after
+// limit trees (limit <= 0 means no limit). Each tree is a fresh slice
+// It backtracks over the edges, including an edge when it joins two
+// components and excluding it only while the remaining edges can still span
+// the graph, so every branch ends in a tree and the work per tree is
+// polynomial. Self-loops are skipped and a disconnected graph yields nothing
+func (g *Graph) AllSpanningTrees(limit int) iter.Seq[[]*Edge] {
+	return func(yield func([]*Edge) bool) {
+		if g.Directed {
+			panic("AllSpanningTrees only works for undirected graphs")
+		}
+
+		ids := g.SortedVertexIDs()
+		index := make(map[int]int, len(ids))
+		for i, id := range ids {
+			index[id] = i
+		}
+		n := len(ids)
+
+		type arc struct {
+			edge *Edge
+			u, v int
+		}
+		arcs := make([]arc, 0, len(g.Edges))
+		for _, e := range g.Edges {
+			if e.From.ID != e.To.ID {
+				arcs = append(arcs, arc{edge: e, u: index[e.From.ID], v: index[e.To.ID]})
+			}
+		}
+
+		// spans reports whether the chosen edges plus arcs[from:] connect the graph
+		chosen := make([]int, 0, n)
+		spans := func(from int) bool {
+			uf := NewDenseUnionFind(n)
+			for _, i := range chosen {
+				uf.Union(arcs[i].u, arcs[i].v)
+			}
+			for _, a := range arcs[from:] {
+				uf.Union(a.u, a.v)
+			}
+			return uf.Count() <= 1
+		}
+		if n == 0 || !spans(0) {
+			return
+		}
+
+		uf := NewRollbackDisjointSet[int]()
+		for i := 0; i < n; i++ {
+			uf.MakeSet(i)
+		}
+
+		count := 0
+		var search func(i int) bool
+		search = func(i int) bool {
+			if len(chosen) == n-1 {
+				tree := make([]*Edge, len(chosen))
+				for j, c := range chosen {
+					tree[j] = arcs[c].edge
+				}
+				count++
+				return yield(tree) && (limit <= 0 || count < limit)
+			}
+			if i == len(arcs) {
+				return true
+			}
+
+			a := arcs[i]
+			if !uf.Connected(a.u, a.v) {
+				snapshot := uf.Snapshot()
+				uf.Union(a.u, a.v)
+				chosen = append(chosen, i)
+				more := search(i + 1)
+				chosen = chosen[:len(chosen)-1]
+				uf.Rollback(snapshot)
+				if !more {
+					return false
+				}
+			}
+			if spans(i + 1) {
+				return search(i + 1)
+			}
+			return true
+		}
+		search(0)
+	}
+}
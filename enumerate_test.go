@@ -0,0 +1,70 @@
+package mst
+
+import (
+	"fmt"
+	"testing"
+)
+
+// TestAllSpanningTrees tests enumeration against Kirchhoff's count
+func TestAllSpanningTrees(t *testing.T) {
+	fmt.Println("\n=== SPANNING TREE ENUMERATION TEST ===")
+
+	g := buildCompleteGraph(5)
+	want := int(g.SpanningTreeCount().Int64()) // 5^3 = 125
+
+	seen := make(map[string]bool)
+	minWeight := -1
+	for tree := range g.AllSpanningTrees(0) {
+		if len(tree) != 4 {
+			t.Fatalf("Expected 4 edges per tree, got %d", len(tree))
+		}
+		uf := NewUnionFind()
+		for id := range g.Vertices {
+			uf.MakeSet(id)
+		}
+		key := ""
+		w := 0
+		for _, e := range tree {
+			if !uf.Union(e.From.ID, e.To.ID) {
+				t.Fatal("Expected an acyclic tree")
+			}
+			key += fmt.Sprintf("%p,", e)
+			w += e.Weight
+		}
+		seen[key] = true
+		if minWeight < 0 || w < minWeight {
+			minWeight = w
+		}
+	}
+	if len(seen) != want {
+		t.Errorf("Expected %d distinct trees, got %d", want, len(seen))
+	}
+	if _, w := g.Kruskal(); w != minWeight {
+		t.Errorf("Expected the lightest enumerated tree to weigh %d, got %d", w, minWeight)
+	}
+
+	n := 0
+	for range g.AllSpanningTrees(10) {
+		n++
+	}
+	if n != 10 {
+		t.Errorf("Expected the limit to stop at 10 trees, got %d", n)
+	}
+
+	n = 0
+	for range g.AllSpanningTrees(0) {
+		n++
+		if n == 3 {
+			break
+		}
+	}
+	if n != 3 {
+		t.Errorf("Expected break to stop the iterator, got %d", n)
+	}
+
+	split := newPathGraph(1)
+	split.AddVertex(Vertex{ID: 7})
+	for range split.AllSpanningTrees(0) {
+		t.Error("Expected no trees for a disconnected graph")
+	}
+}
@@ -0,0 +1,167 @@
+package mst
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ==================== EULERIAN PATHS ====================
+
+// ErrNotEulerian is returned when no walk uses every edge exactly once
+var ErrNotEulerian = errors.New("graph is not Eulerian")
+
+// HasEulerianCircuit reports whether a closed walk uses every edge exactly once
+func (g *Graph) HasEulerianCircuit() bool {
+	_, _, err := g.eulerEndpoints(true)
+	return err == nil
+}
+
+// HasEulerianPath reports whether a walk, open or closed, uses every edge exactly once
+func (g *Graph) HasEulerianPath() bool {
+	_, _, err := g.eulerEndpoints(false)
+	return err == nil
+}
+
+// EulerianCircuit returns a closed walk that uses every edge exactly once,
+// built with Hierholzer's algorithm in O(V + E). Edges are oriented along
+// the walk, so each edge's To is the next edge's From
+// It returns an error wrapping ErrNotEulerian if no such circuit exists
+func (g *Graph) EulerianCircuit() ([]*Edge, error) {
+	start, _, err := g.eulerEndpoints(true)
+	if err != nil {
+		return nil, err
+	}
+	return g.hierholzer(start)
+}
+
+// EulerianPath returns a walk that uses every edge exactly once, starting
+// at the required odd (or surplus out-degree) vertex when the walk is open
+// It returns an error wrapping ErrNotEulerian if no such walk exists
+func (g *Graph) EulerianPath() ([]*Edge, error) {
+	start, _, err := g.eulerEndpoints(false)
+	if err != nil {
+		return nil, err
+	}
+	return g.hierholzer(start)
+}
+
+// eulerEndpoints checks connectivity and the degree conditions and returns
+// the start and end vertex of an Eulerian walk (equal for circuits)
+func (g *Graph) eulerEndpoints(circuit bool) (start, end int, err error) {
+	if !g.edgesConnected() {
+		return 0, 0, fmt.Errorf("%w: edges are not all connected", ErrNotEulerian)
+	}
+
+	ids := g.SortedVertexIDs()
+	start, end = -1, -1
+	for _, id := range ids {
+		if len(g.Vertices[id].Edges) > 0 {
+			start, end = id, id
+			break
+		}
+	}
+
+	if !g.Directed {
+		odd := make([]int, 0, 2)
+		for _, id := range ids {
+			if len(g.Vertices[id].Edges)%2 == 1 {
+				odd = append(odd, id)
+			}
+		}
+		switch {
+		case len(odd) == 0:
+			return start, end, nil
+		case len(odd) == 2 && !circuit:
+			return odd[0], odd[1], nil
+		default:
+			return 0, 0, fmt.Errorf("%w: %d vertices of odd degree", ErrNotEulerian, len(odd))
+		}
+	}
+
+	in := make(map[int]int, len(ids))
+	for _, e := range g.Edges {
+		in[e.To.ID]++
+	}
+	var surplus, deficit []int
+	for _, id := range ids {
+		switch d := len(g.Vertices[id].Edges) - in[id]; {
+		case d == 1:
+			surplus = append(surplus, id)
+		case d == -1:
+			deficit = append(deficit, id)
+		case d != 0:
+			return 0, 0, fmt.Errorf("%w: vertex %d has out-degree minus in-degree %d", ErrNotEulerian, id, d)
+		}
+	}
+	switch {
+	case len(surplus) == 0 && len(deficit) == 0:
+		return start, end, nil
+	case len(surplus) == 1 && len(deficit) == 1 && !circuit:
+		return surplus[0], deficit[0], nil
+	default:
+		return 0, 0, fmt.Errorf("%w: %d vertices with unbalanced degree", ErrNotEulerian, len(surplus)+len(deficit))
+	}
+}
+
+// edgesConnected reports whether all edges lie in one weakly connected component
+func (g *Graph) edgesConnected() bool {
+	uf := NewUnionFind()
+	for _, e := range g.Edges {
+		uf.MakeSet(e.From.ID)
+		uf.MakeSet(e.To.ID)
+		uf.Union(e.From.ID, e.To.ID)
+	}
+	return uf.Count() <= 1
+}
+
+// hierholzer walks every edge from start
+func (g *Graph) hierholzer(start int) ([]*Edge, error) {
+	if len(g.Edges) == 0 {
+		return []*Edge{}, nil
+	}
+
+	// Both adjacency copies of an undirected edge share one slot
+	slot := make(map[*Edge]int, 2*len(g.Edges))
+	for i, e := range g.Edges {
+		slot[e] = i
+		if e.twin != nil {
+			slot[e.twin] = i
+		}
+	}
+	used := make([]bool, len(g.Edges))
+	next := make(map[int]int, len(g.Vertices))
+
+	type step struct {
+		v    int
+		edge *Edge
+	}
+	stack := []step{{v: start}}
+	walk := make([]*Edge, 0, len(g.Edges))
+	for len(stack) > 0 {
+		top := stack[len(stack)-1]
+		adj := g.Vertices[top.v].Edges
+		i := next[top.v]
+		for i < len(adj) && used[slot[adj[i]]] {
+			i++
+		}
+		if i < len(adj) {
+			next[top.v] = i + 1
+			used[slot[adj[i]]] = true
+			stack = append(stack, step{v: adj[i].To.ID, edge: adj[i]})
+			continue
+		}
+		next[top.v] = i
+		stack = stack[:len(stack)-1]
+		if top.edge != nil {
+			walk = append(walk, top.edge)
+		}
+	}
+
+	if len(walk) != len(g.Edges) {
+		return nil, fmt.Errorf("%w: edges are not all connected", ErrNotEulerian)
+	}
+	for i, j := 0, len(walk)-1; i < j; i, j = i+1, j-1 {
+		walk[i], walk[j] = walk[j], walk[i]
+	}
+	return walk, nil
+}
@@ -0,0 +1,93 @@
+package mst
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+)
+
+// checkWalk verifies that a walk is continuous and uses every edge once
+func checkWalk(t *testing.T, g *Graph, walk []*Edge) {
+	t.Helper()
+	if len(walk) != g.EdgeCount() {
+		t.Fatalf("Expected %d edges, got %d", g.EdgeCount(), len(walk))
+	}
+	seen := make(map[*Edge]bool)
+	for i, e := range walk {
+		if i > 0 && walk[i-1].To.ID != e.From.ID {
+			t.Errorf("Walk breaks between steps %d and %d", i-1, i)
+		}
+		if !slices.Contains(g.Edges, e) {
+			e = e.twin // reverse adjacency copy of an undirected edge
+		}
+		if seen[e] {
+			t.Errorf("Edge %v used twice", e)
+		}
+		seen[e] = true
+	}
+}
+
+// TestEulerian tests Eulerian circuits and paths
+func TestEulerian(t *testing.T) {
+	fmt.Println("\n=== EULERIAN PATH TEST ===")
+
+	// Two triangles sharing vertex 0: every degree is even
+	bowtie := NewGraph(false)
+	v := make([]Vertex, 5)
+	for i := range v {
+		v[i] = Vertex{ID: i}
+	}
+	for _, e := range [][2]int{{0, 1}, {1, 2}, {2, 0}, {0, 3}, {3, 4}, {4, 0}} {
+		bowtie.AddEdge(Edge{From: &v[e[0]], To: &v[e[1]], Weight: 1})
+	}
+	circuit, err := bowtie.EulerianCircuit()
+	if err != nil {
+		t.Fatalf("EulerianCircuit failed: %v", err)
+	}
+	checkWalk(t, &bowtie, circuit)
+	if circuit[0].From.ID != circuit[len(circuit)-1].To.ID {
+		t.Error("Expected the circuit to be closed")
+	}
+
+	// Removing one edge leaves an open path between its endpoints
+	bowtie.RemoveEdge(bowtie.Edges[5])
+	if bowtie.HasEulerianCircuit() || !bowtie.HasEulerianPath() {
+		t.Error("Expected a path but no circuit")
+	}
+	path, err := bowtie.EulerianPath()
+	if err != nil {
+		t.Fatalf("EulerianPath failed: %v", err)
+	}
+	checkWalk(t, &bowtie, path)
+	if path[0].From.ID != 0 || path[len(path)-1].To.ID != 4 {
+		t.Errorf("Expected the path to run from 0 to 4, got %d to %d", path[0].From.ID, path[len(path)-1].To.ID)
+	}
+
+	// Directed: 0 -> 1 -> 2 -> 0 -> 3
+	d := NewGraph(true)
+	for _, e := range [][2]int{{0, 1}, {1, 2}, {2, 0}, {0, 3}} {
+		d.AddEdge(Edge{From: &v[e[0]], To: &v[e[1]], Weight: 1})
+	}
+	if _, err := d.EulerianCircuit(); !errors.Is(err, ErrNotEulerian) {
+		t.Errorf("Expected ErrNotEulerian, got %v", err)
+	}
+	dpath, err := d.EulerianPath()
+	if err != nil || dpath[len(dpath)-1].To.ID != 3 {
+		t.Errorf("Expected a directed path ending at 3, got %v (%v)", dpath, err)
+	}
+
+	// Even degrees but two separate cycles
+	split := NewGraph(false)
+	for _, e := range [][2]int{{0, 1}, {1, 2}, {2, 0}} {
+		split.AddEdge(Edge{From: &v[e[0]], To: &v[e[1]], Weight: 1})
+		a, b := Vertex{ID: e[0] + 10}, Vertex{ID: e[1] + 10}
+		split.AddEdge(Edge{From: &a, To: &b, Weight: 1})
+	}
+	if split.HasEulerianCircuit() {
+		t.Error("Expected no circuit across two components")
+	}
+	if _, err := split.EulerianCircuit(); !errors.Is(err, ErrNotEulerian) {
+		t.Errorf("Expected ErrNotEulerian for two components, got %v", err)
+	}
+}
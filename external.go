@@ -0,0 +1,219 @@
+package mst
+
+import (
+	"bufio"
+	"container/heap"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ==================== EXTERNAL-MEMORY KRUSKAL ====================
+
+// EdgeRecord is a plain edge used when edges are streamed rather than stored in a Graph
+type EdgeRecord struct {
+	From, To, Weight int
+}
+
+// ExternalConfig controls the on-disk sort of ExternalKruskal
+// The zero value sorts runs of 1<<20 edges in the default temporary directory
+type ExternalConfig struct {
+	RunSize int    // edges sorted in memory per run
+	TempDir string // directory for run files, os.TempDir() when empty
+}
+
+// edgeRecordSize is the on-disk size of an EdgeRecord: three int64 values
+const edgeRecordSize = 24
+
+// ExternalKruskal computes a minimum spanning forest of an edge stream too
+// large for memory, returning its edges and total weight
+// Edges are cut into runs of cfg.RunSize that are sorted in memory and
+// spilled to temporary files, which are k-way merged and streamed into a
+// union-find. Memory use is one run plus O(V) for the union-find and the
+// forest; run files are removed before returning. A stream that fits into a
+// single run is never written to disk
+func ExternalKruskal(edges iter.Seq2[EdgeRecord, error], cfg ExternalConfig) ([]EdgeRecord, int, error) {
+	if cfg.RunSize <= 0 {
+		cfg.RunSize = 1 << 20
+	}
+
+	runs := make([]*os.File, 0)
+	defer func() {
+		for _, f := range runs {
+			f.Close()
+			os.Remove(f.Name())
+		}
+	}()
+
+	buffer := make([]EdgeRecord, 0, cfg.RunSize)
+	spill := func() error {
+		sortRecords(buffer)
+		f, err := os.CreateTemp(cfg.TempDir, "mst-run-*")
+		if err != nil {
+			return err
+		}
+		runs = append(runs, f)
+		bw := bufio.NewWriter(f)
+		var b [edgeRecordSize]byte
+		for _, e := range buffer {
+			binary.LittleEndian.PutUint64(b[0:], uint64(e.From))
+			binary.LittleEndian.PutUint64(b[8:], uint64(e.To))
+			binary.LittleEndian.PutUint64(b[16:], uint64(e.Weight))
+			bw.Write(b[:])
+		}
+		if err := bw.Flush(); err != nil {
+			return err
+		}
+		buffer = buffer[:0]
+		_, err = f.Seek(0, io.SeekStart)
+		return err
+	}
+
+	for e, err := range edges {
+		if err != nil {
+			return nil, 0, err
+		}
+		buffer = append(buffer, e)
+		if len(buffer) == cfg.RunSize {
+			if err := spill(); err != nil {
+				return nil, 0, fmt.Errorf("external kruskal: %w", err)
+			}
+		}
+	}
+
+	uf := NewUnionFind()
+	forest := make([]EdgeRecord, 0)
+	total := 0
+	accept := func(e EdgeRecord) {
+		uf.MakeSet(e.From)
+		uf.MakeSet(e.To)
+		if uf.Union(e.From, e.To) {
+			forest = append(forest, e)
+			total += e.Weight
+		}
+	}
+
+	if len(runs) == 0 {
+		sortRecords(buffer)
+		for _, e := range buffer {
+			accept(e)
+		}
+		return forest, total, nil
+	}
+	if len(buffer) > 0 {
+		if err := spill(); err != nil {
+			return nil, 0, fmt.Errorf("external kruskal: %w", err)
+		}
+	}
+
+	merge := &runMerger{}
+	for _, f := range runs {
+		r := &runReader{r: bufio.NewReader(f)}
+		if r.next() {
+			merge.runs = append(merge.runs, r)
+		} else if r.err != nil {
+			return nil, 0, fmt.Errorf("external kruskal: %w", r.err)
+		}
+	}
+	heap.Init(merge)
+	for merge.Len() > 0 {
+		r := merge.runs[0]
+		accept(r.head)
+		if r.next() {
+			heap.Fix(merge, 0)
+		} else {
+			if r.err != nil {
+				return nil, 0, fmt.Errorf("external kruskal: %w", r.err)
+			}
+			heap.Pop(merge)
+		}
+	}
+	return forest, total, nil
+}
+
+// sortRecords orders edges by weight, keeping the input order of ties
+func sortRecords(edges []EdgeRecord) {
+	sort.SliceStable(edges, func(i, j int) bool {
+		return edges[i].Weight < edges[j].Weight
+	})
+}
+
+// runReader reads one sorted run file record by record
+type runReader struct {
+	r    *bufio.Reader
+	head EdgeRecord
+	err  error
+}
+
+// next loads the following record into head, reporting false at the end of the run
+func (rr *runReader) next() bool {
+	var b [edgeRecordSize]byte
+	if _, err := io.ReadFull(rr.r, b[:]); err != nil {
+		if !errors.Is(err, io.EOF) {
+			rr.err = err
+		}
+		return false
+	}
+	rr.head = EdgeRecord{
+		From:   int(int64(binary.LittleEndian.Uint64(b[0:]))),
+		To:     int(int64(binary.LittleEndian.Uint64(b[8:]))),
+		Weight: int(int64(binary.LittleEndian.Uint64(b[16:]))),
+	}
+	return true
+}
+
+// runMerger is a min-heap of runs keyed by their current record
+type runMerger struct {
+	runs []*runReader
+}
+
+func (m *runMerger) Len() int { return len(m.runs) }
+func (m *runMerger) Less(i, j int) bool {
+	return m.runs[i].head.Weight < m.runs[j].head.Weight
+}
+func (m *runMerger) Swap(i, j int) { m.runs[i], m.runs[j] = m.runs[j], m.runs[i] }
+func (m *runMerger) Push(x any)    { m.runs = append(m.runs, x.(*runReader)) }
+func (m *runMerger) Pop() any {
+	r := m.runs[len(m.runs)-1]
+	m.runs = m.runs[:len(m.runs)-1]
+	return r
+}
+
+// ReadEdgeList streams a whitespace-separated "from to weight" edge list
+// Blank lines and lines starting with # are skipped; a missing weight is 1
+func ReadEdgeList(r io.Reader) iter.Seq2[EdgeRecord, error] {
+	return func(yield func(EdgeRecord, error) bool) {
+		sc := bufio.NewScanner(r)
+		for line := 1; sc.Scan(); line++ {
+			fields := strings.Fields(sc.Text())
+			if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+				continue
+			}
+			if len(fields) < 2 || len(fields) > 3 {
+				yield(EdgeRecord{}, fmt.Errorf("edge list line %d: expected 2 or 3 fields", line))
+				return
+			}
+			values := []int{0, 0, 1}
+			for i, field := range fields {
+				v, err := strconv.Atoi(field)
+				if err != nil {
+					yield(EdgeRecord{}, fmt.Errorf("edge list line %d: %w", line, err))
+					return
+				}
+				values[i] = v
+			}
+			if !yield(EdgeRecord{From: values[0], To: values[1], Weight: values[2]}, nil) {
+				return
+			}
+		}
+		if err := sc.Err(); err != nil {
+			yield(EdgeRecord{}, err)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package mst
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+)
+
+// TestExternalKruskal tests spilling sorted runs to disk and merging them
+func TestExternalKruskal(t *testing.T) {
+	fmt.Println("\n=== EXTERNAL KRUSKAL TEST ===")
+
+	g := buildCompleteGraph(30)
+	var list strings.Builder
+	list.WriteString("# complete graph\n")
+	for _, e := range g.Edges {
+		fmt.Fprintf(&list, "%d %d %d\n", e.From.ID, e.To.ID, e.Weight)
+	}
+	_, want := g.Kruskal()
+
+	dir := t.TempDir()
+	for _, runSize := range []int{7, 100, 1 << 20} {
+		forest, total, err := ExternalKruskal(ReadEdgeList(strings.NewReader(list.String())),
+			ExternalConfig{RunSize: runSize, TempDir: dir})
+		if err != nil {
+			t.Fatalf("run size %d: %v", runSize, err)
+		}
+		fmt.Printf("  run size %d: %d edges, total %d\n", runSize, len(forest), total)
+		if total != want || len(forest) != 29 {
+			t.Errorf("run size %d: expected total %d with 29 edges, got %d with %d",
+				runSize, want, total, len(forest))
+		}
+	}
+
+	if left, _ := os.ReadDir(dir); len(left) != 0 {
+		t.Errorf("Expected run files to be removed, %d remain", len(left))
+	}
+
+	if _, _, err := ExternalKruskal(ReadEdgeList(strings.NewReader("0 1 2\n0 x 1\n")), ExternalConfig{}); err == nil {
+		t.Error("Expected a parse error")
+	}
+}
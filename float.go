@@ -0,0 +1,221 @@
+package mst
+
+import (
+	"container/heap"
+	"math"
+	"sort"
+)
+
+// ==================== FLOAT WEIGHTS ====================
+
+// FloatWeight returns the real-valued weight of an edge
+type FloatWeight func(edge *Edge) float64
+
+// DataWeight is a FloatWeight that reads a float64 (or int) from Edge.Data
+// and falls back to the integer Weight otherwise
+func DataWeight(edge *Edge) float64 {
+	switch w := edge.Data.(type) {
+	case float64:
+		return w
+	case int:
+		return float64(w)
+	default:
+		return float64(edge.Weight)
+	}
+}
+
+// WithEpsilon sets the tolerance under which two float weights count as a tie
+// Tied edges are ordered by their endpoint IDs, so results stay deterministic
+// when weights differ only by rounding noise
+func WithEpsilon(epsilon float64) Option {
+	return func(o *options) {
+		o.epsilon = math.Abs(epsilon)
+	}
+}
+
+// floatEdge is an edge with its weight resolved once
+type floatEdge struct {
+	edge   *Edge
+	weight float64
+	pair   pairKey
+}
+
+// floatLess orders by weight, treating weights within epsilon as equal and
+// breaking those ties by endpoint pair
+func floatLess(a, b floatEdge, epsilon float64) bool {
+	if math.Abs(a.weight-b.weight) > epsilon {
+		return a.weight < b.weight
+	}
+	if a.pair.a != b.pair.a {
+		return a.pair.a < b.pair.a
+	}
+	return a.pair.b < b.pair.b
+}
+
+// KruskalFloat finds MST using Kruskal's algorithm with float64 weights
+// Edges are sorted by weight, then every run of weights within epsilon of the
+// run's lightest edge is ordered by endpoint IDs. The tree is therefore
+// within (V-1)·epsilon of the exact optimum and identical across runs
+func (g *Graph) KruskalFloat(weight FloatWeight, opts ...Option) ([]*Edge, float64) {
+	if g.Directed {
+		panic("Kruskal algorithm only works for undirected graphs")
+	}
+
+	o := newOptions(opts)
+	o.prepareConstraints(g)
+	c := o.constraints
+
+	edges := make([]floatEdge, 0, len(g.Edges))
+	required := 0
+	for _, edge := range g.Edges {
+		if !c.allowed(edge) {
+			continue
+		}
+		fe := floatEdge{edge: edge, weight: weight(edge), pair: pairOf(edge)}
+		if c.isRequired(edge) {
+			required++
+		}
+		edges = append(edges, fe)
+	}
+
+	// Required edges first, then exact weight order
+	sort.SliceStable(edges, func(i, j int) bool {
+		ri, rj := c.isRequired(edges[i].edge), c.isRequired(edges[j].edge)
+		if ri != rj {
+			return ri
+		}
+		return edges[i].weight < edges[j].weight
+	})
+	// Reorder each run of near-equal weights by endpoints
+	for start := required; start < len(edges); {
+		end := start + 1
+		for end < len(edges) && edges[end].weight-edges[start].weight <= o.epsilon {
+			end++
+		}
+		run := edges[start:end]
+		sort.Slice(run, func(i, j int) bool {
+			return floatLess(run[i], run[j], math.Inf(1))
+		})
+		start = end
+	}
+
+	uf := NewUnionFind()
+	for id := range g.Vertices {
+		uf.MakeSet(id)
+	}
+
+	mst := make([]*Edge, 0)
+	total := 0.0
+	for _, fe := range edges {
+		o.traceEdge("kruskal_float", EventEdgeConsidered, fe.edge)
+		if !uf.Union(fe.edge.From.ID, fe.edge.To.ID) {
+			o.traceEdge("kruskal_float", EventEdgeRejected, fe.edge)
+			continue
+		}
+		o.traceEdge("kruskal_float", EventEdgeAccepted, fe.edge)
+		mst = append(mst, fe.edge)
+		total += fe.weight
+		if len(mst) == g.VertexCount()-1 {
+			break
+		}
+	}
+	return mst, total
+}
+
+// floatQueue is a min-heap of float-weighted edges
+// With an epsilon, weights are compared by the multiple of epsilon they fall
+// in, so near-equal weights tie and endpoint pairs decide between them.
+// Unlike |a-b| <= epsilon this order is transitive, which the heap needs
+type floatQueue struct {
+	items   []floatEdge
+	epsilon float64
+}
+
+func (q *floatQueue) Len() int { return len(q.items) }
+
+func (q *floatQueue) Less(i, j int) bool {
+	a, b := q.items[i], q.items[j]
+	if q.epsilon > 0 {
+		a.weight, b.weight = math.Floor(a.weight/q.epsilon), math.Floor(b.weight/q.epsilon)
+	}
+	return floatLess(a, b, 0)
+}
+
+func (q *floatQueue) Swap(i, j int) {
+	q.items[i], q.items[j] = q.items[j], q.items[i]
+}
+
+func (q *floatQueue) Push(x any) {
+	q.items = append(q.items, x.(floatEdge))
+}
+
+func (q *floatQueue) Pop() any {
+	n := len(q.items)
+	item := q.items[n-1]
+	q.items = q.items[:n-1]
+	return item
+}
+
+// PrimFloat finds MST using Prim's algorithm with float64 weights
+// Weights in the same multiple of epsilon tie and are ordered by endpoint
+// IDs, so each edge taken is within epsilon of the lightest one leaving the
+// tree and the tree is within (V-1)·epsilon of the exact optimum. Without
+// epsilon only exactly equal weights tie and the tree is exactly minimal
+func (g *Graph) PrimFloat(startID int, weight FloatWeight, opts ...Option) ([]*Edge, float64) {
+	if g.Directed {
+		panic("Prim algorithm only works for undirected graphs")
+	}
+
+	start, exists := g.Vertices[startID]
+	if !exists {
+		return nil, 0
+	}
+
+	o := newOptions(opts)
+	o.prepareConstraints(g)
+	c := o.constraints
+
+	mst := make([]*Edge, 0)
+	total := 0.0
+	visited := map[int]bool{start.ID: true}
+
+	pq := &floatQueue{epsilon: o.epsilon}
+	requiredPQ := &floatQueue{epsilon: o.epsilon}
+	push := func(v Vertex) {
+		for _, edge := range v.Edges {
+			if visited[edge.To.ID] || !c.allowed(edge) {
+				continue
+			}
+			fe := floatEdge{edge: edge, weight: weight(edge), pair: pairOf(edge)}
+			if c.isRequired(edge) {
+				heap.Push(requiredPQ, fe)
+			} else {
+				heap.Push(pq, fe)
+			}
+		}
+	}
+
+	o.traceVertex("prim_float", start.ID)
+	push(start)
+	for pq.Len()+requiredPQ.Len() > 0 && len(mst) < g.VertexCount()-1 {
+		var fe floatEdge
+		if requiredPQ.Len() > 0 {
+			fe = heap.Pop(requiredPQ).(floatEdge)
+		} else {
+			fe = heap.Pop(pq).(floatEdge)
+		}
+		o.traceEdge("prim_float", EventEdgeConsidered, fe.edge)
+		if visited[fe.edge.To.ID] {
+			o.traceEdge("prim_float", EventEdgeRejected, fe.edge)
+			continue
+		}
+
+		o.traceEdge("prim_float", EventEdgeAccepted, fe.edge)
+		mst = append(mst, fe.edge)
+		total += fe.weight
+		visited[fe.edge.To.ID] = true
+		o.traceVertex("prim_float", fe.edge.To.ID)
+		push(g.Vertices[fe.edge.To.ID])
+	}
+	return mst, total
+}
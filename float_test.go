@@ -0,0 +1,126 @@
+package mst
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+// newFloatGraph builds a square whose sides differ only by rounding noise
+func newFloatGraph() Graph {
+	g := NewGraph(false)
+	v := make([]Vertex, 4)
+	for i := range v {
+		v[i] = Vertex{ID: i}
+	}
+	sides := []struct {
+		from, to int
+		km       float64
+	}{
+		{2, 3, 1.0 + 1e-12},
+		{0, 1, 1.0},
+		{1, 2, 1.0 - 1e-12},
+		{0, 3, 1.0 + 2e-12},
+		{0, 2, 1.4142},
+	}
+	for _, s := range sides {
+		g.AddEdge(Edge{From: &v[s.from], To: &v[s.to], Data: s.km})
+	}
+	return g
+}
+
+// TestKruskalFloat tests float weights and epsilon tie-breaking
+func TestKruskalFloat(t *testing.T) {
+	fmt.Println("\n=== FLOAT WEIGHT TEST ===")
+
+	g := newFloatGraph()
+
+	// Without epsilon the exact order decides: 1-2, 0-1, 2-3
+	exact, total := g.KruskalFloat(DataWeight)
+	if math.Abs(total-3) > 1e-9 || exact[2].From.ID != 2 {
+		t.Errorf("Expected exact order to pick 2-3 last, got %v (%f)", exact, total)
+	}
+
+	// With epsilon the four sides tie and endpoint order decides: 0-1, 0-3, 1-2
+	tied, _ := g.KruskalFloat(DataWeight, WithEpsilon(1e-9))
+	want := []pairKey{{0, 1}, {0, 3}, {1, 2}}
+	for i, e := range tied {
+		if pairOf(e) != want[i] {
+			t.Errorf("Edge %d: expected %v, got %v", i, want[i], pairOf(e))
+		}
+	}
+
+	// Without epsilon Prim keeps exact order: 0-1, 1-2, 2-3
+	prim, primTotal := g.PrimFloat(0, DataWeight)
+	if len(prim) != 3 || math.Abs(primTotal-3) > 1e-9 {
+		t.Errorf("Expected a 3-edge tree of weight 3, got %d edges (%f)", len(prim), primTotal)
+	}
+	for i, pair := range []pairKey{{0, 1}, {1, 2}, {2, 3}} {
+		if i < len(prim) && pairOf(prim[i]) != pair {
+			t.Errorf("Prim edge %d: expected %v, got %v", i, pair, pairOf(prim[i]))
+		}
+	}
+}
+
+// TestPrimFloatTies tests that PrimFloat treats weights in the same multiple
+// of epsilon as a tie and orders them by endpoints
+func TestPrimFloatTies(t *testing.T) {
+	g := NewGraph(false)
+	for _, s := range []struct {
+		from, to int
+		w        float64
+	}{{0, 1, 10.0}, {0, 3, 10.4}, {1, 2, 10.2}, {2, 3, 10.1}} {
+		g.AddEdge(Edge{From: &Vertex{ID: s.from}, To: &Vertex{ID: s.to}, Data: s.w})
+	}
+
+	// Exact order takes 1-2 before 0-3 and 2-3 before 0-3
+	exact, _ := g.PrimFloat(0, DataWeight)
+	// With epsilon 1 all four tie, so endpoints decide: 0-1, then 0-3 over 1-2, then 1-2 over 2-3
+	tied, total := g.PrimFloat(0, DataWeight, WithEpsilon(1))
+	for i, want := range [][]pairKey{{{0, 1}, {1, 2}, {2, 3}}, {{0, 1}, {0, 3}, {1, 2}}} {
+		got := [][]*Edge{exact, tied}[i]
+		if len(got) != len(want) {
+			t.Fatalf("Expected %d edges, got %d", len(want), len(got))
+		}
+		for k := range want {
+			if pairOf(got[k]) != want[k] {
+				t.Errorf("Tree %d edge %d: expected %v, got %v", i, k, want[k], pairOf(got[k]))
+			}
+		}
+	}
+	if math.Abs(total-30.6) > 1e-9 {
+		t.Errorf("Expected the tied tree to weigh 30.6, got %f", total)
+	}
+}
+
+// TestPrimFloatEpsilon tests that a large epsilon keeps Prim within
+// (V-1)·epsilon of the optimum on random graphs
+func TestPrimFloatEpsilon(t *testing.T) {
+	fmt.Println("\n=== PRIM FLOAT EPSILON TEST ===")
+
+	rng := rand.New(rand.NewPCG(2, 1))
+	for trial := range 1000 {
+		n := 2 + trial%4
+		g := NewGraph(false)
+		for i := 1; i < n; i++ {
+			g.AddEdge(Edge{From: &Vertex{ID: rng.IntN(i)}, To: &Vertex{ID: i}, Data: 5 * rng.Float64()})
+		}
+		// Parallel edges with weights spread over several epsilons
+		for range 10 * n {
+			u, v := rng.IntN(n), rng.IntN(n)
+			if u != v {
+				g.AddEdge(Edge{From: &Vertex{ID: u}, To: &Vertex{ID: v}, Data: 5 * rng.Float64()})
+			}
+		}
+		epsilon := 1.0
+		_, optimum := g.KruskalFloat(DataWeight)
+		_, total := g.PrimFloat(0, DataWeight, WithEpsilon(epsilon))
+		if total > optimum+float64(n-1)*epsilon+1e-9 {
+			t.Fatalf("Trial %d: Prim weight %f exceeds optimum %f by more than (V-1)·ε", trial, total, optimum)
+		}
+		if _, tied := g.KruskalFloat(DataWeight, WithEpsilon(epsilon)); math.Abs(total-tied) > float64(n-1)*epsilon+1e-9 {
+			t.Fatalf("Trial %d: Prim %f and Kruskal %f differ by more than (V-1)·ε", trial, total, tied)
+		}
+	}
+}
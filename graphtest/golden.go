@@ -0,0 +1,91 @@
+package graphtest
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/l00pss/mst"
+)
+
+// ==================== GOLDEN FILES ====================
+
+// UpdateEnv names the environment variable that makes the golden file
+// assertions rewrite their files instead of comparing against them:
+//
+//	GRAPHTEST_UPDATE=1 go test ./...
+//
+// It is read on every call rather than registered as a flag, so importing
+// the package leaves the test binary's flags alone
+const UpdateEnv = "GRAPHTEST_UPDATE"
+
+// updating reports whether UpdateEnv asks for golden files to be rewritten
+func updating() bool {
+	v := os.Getenv(UpdateEnv)
+	return v != "" && v != "0" && v != "false"
+}
+
+// AssertGolden compares got with the golden file testdata/<name> of the
+// package under test, failing at the first differing line
+// With UpdateEnv set the file is written instead, creating testdata if needed
+func AssertGolden(t TB, name string, got []byte) bool {
+	t.Helper()
+	path := filepath.Join("testdata", name)
+	if updating() {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("graphtest: %v", err)
+		}
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatalf("graphtest: %v", err)
+		}
+		return true
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("graphtest: %v (set "+UpdateEnv+"=1 to create it)", err)
+		return false
+	}
+	if bytes.Equal(got, want) {
+		return true
+	}
+	gotLines := strings.Split(string(got), "\n")
+	wantLines := strings.Split(string(want), "\n")
+	for i := range max(len(gotLines), len(wantLines)) {
+		var g, w string
+		if i < len(gotLines) {
+			g = gotLines[i]
+		}
+		if i < len(wantLines) {
+			w = wantLines[i]
+		}
+		if g != w {
+			t.Errorf("graphtest: %s differs at line %d\n got: %s\nwant: %s", path, i+1, g, w)
+			break
+		}
+	}
+	return false
+}
+
+// AssertGoldenDOT compares the DOT rendering of g with a golden file
+func AssertGoldenDOT(t TB, name string, g *mst.Graph) bool {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := g.WriteDOT(&buf); err != nil {
+		t.Fatalf("graphtest: %v", err)
+	}
+	return AssertGolden(t, name, buf.Bytes())
+}
+
+// AssertGoldenJSON compares the snapshot JSON of g with a golden file
+// Snapshots list vertices by ID and edges in insertion order, so the dump
+// is stable across runs
+func AssertGoldenJSON(t TB, name string, g *mst.Graph) bool {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := g.WriteSnapshot(&buf); err != nil {
+		t.Fatalf("graphtest: %v", err)
+	}
+	return AssertGolden(t, name, buf.Bytes())
+}
@@ -0,0 +1,30 @@
+package graphtest
+
+import (
+	"fmt"
+	"testing"
+)
+
+// TestGolden tests DOT and JSON dumps against the files in testdata
+func TestGolden(t *testing.T) {
+	fmt.Println("\n=== GOLDEN FILE TEST ===")
+
+	g := MustParse("A-B:4 B-C:2 C-A:7 D")
+	AssertGoldenDOT(t, "triangle.dot", &g)
+	AssertGoldenJSON(t, "triangle.json", &g)
+
+	if !updating() {
+		r := &recorder{}
+		changed := MustParse("A-B:4 B-C:3 C-A:7 D")
+		if AssertGoldenDOT(r, "triangle.dot", &changed) || len(r.errors) != 1 {
+			t.Errorf("Expected one mismatch report, got %v", r.errors)
+		}
+		fmt.Println(r.errors)
+
+		r = &recorder{}
+		AssertGolden(r, "missing.golden", nil)
+		if !r.fatal {
+			t.Error("Expected a missing golden file to be fatal")
+		}
+	}
+}
@@ -0,0 +1,219 @@
+// Package graphtest provides test helpers for code built on the mst package
+// It builds graphs from compact literals, asserts graph equality and MST
+// validity, and compares DOT or JSON dumps against golden files
+package graphtest
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/l00pss/mst"
+)
+
+// TB is the subset of testing.TB the assertions use
+type TB interface {
+	Helper()
+	Errorf(format string, args ...any)
+	Fatalf(format string, args ...any)
+}
+
+// ==================== GRAPH LITERALS ====================
+
+// Parse builds a graph from a compact literal such as
+//
+//	"A-B:4 B-C:2 C-A:7 D"
+//
+// Items are separated by spaces, commas, semicolons, or newlines. "u-v:w"
+// is an undirected edge and "u->v:w" a directed one; the weight defaults
+// to 1, a lone "u" is an isolated vertex, and one literal cannot mix both
+// edge kinds. If every vertex is written as a non-negative integer it is
+// used as the vertex ID; otherwise vertices are named and numbered from 0
+// in order of first appearance. Names cannot contain '-', '>' or ':'
+func Parse(literal string) (mst.Graph, error) {
+	type item struct {
+		from, to string
+		weight   int
+		edge     bool
+	}
+	items := make([]item, 0)
+	arrows := map[string]bool{}
+	fields := strings.FieldsFunc(literal, func(r rune) bool {
+		return r == ' ' || r == '\t' || r == '\n' || r == '\r' || r == ',' || r == ';'
+	})
+	for _, field := range fields {
+		body, weight, weighted := field, 1, false
+		if i := strings.IndexByte(field, ':'); i >= 0 {
+			w, err := strconv.Atoi(field[i+1:])
+			if err != nil {
+				return mst.Graph{}, fmt.Errorf("graphtest: bad weight in %q", field)
+			}
+			body, weight, weighted = field[:i], w, true
+		}
+		arrow := "->"
+		from, to, found := strings.Cut(body, arrow)
+		if !found {
+			arrow = "-"
+			from, to, found = strings.Cut(body, arrow)
+		}
+		if !found {
+			if weighted {
+				return mst.Graph{}, fmt.Errorf("graphtest: weight on lone vertex %q", field)
+			}
+			items = append(items, item{from: body})
+			continue
+		}
+		if from == "" || to == "" || strings.ContainsAny(to, "->") {
+			return mst.Graph{}, fmt.Errorf("graphtest: bad edge %q", field)
+		}
+		arrows[arrow] = true
+		items = append(items, item{from: from, to: to, weight: weight, edge: true})
+	}
+	if len(arrows) > 1 {
+		return mst.Graph{}, fmt.Errorf("graphtest: literal mixes directed and undirected edges")
+	}
+
+	names := make([]string, 0)
+	for _, it := range items {
+		for _, name := range []string{it.from, it.to} {
+			if name != "" && !slices.Contains(names, name) {
+				names = append(names, name)
+			}
+		}
+	}
+	ids := make(map[string]int, len(names))
+	for _, name := range names {
+		id, err := strconv.Atoi(name)
+		if err != nil || id < 0 {
+			ids = nil
+			break
+		}
+		ids[name] = id
+	}
+
+	g := mst.NewGraph(arrows["->"])
+	vertices := make(map[string]*mst.Vertex, len(names))
+	for i, name := range names {
+		v := mst.Vertex{ID: i, Name: name}
+		if ids != nil {
+			v = mst.Vertex{ID: ids[name]}
+		}
+		vertices[name] = g.AddVertex(v)
+	}
+	edges := make([]mst.Edge, 0, len(items))
+	for _, it := range items {
+		if it.edge {
+			edges = append(edges, mst.Edge{From: vertices[it.from], To: vertices[it.to], Weight: it.weight})
+		}
+	}
+	g.AddEdges(edges)
+	return g, nil
+}
+
+// MustParse is like Parse but panics on a malformed literal
+func MustParse(literal string) mst.Graph {
+	g, err := Parse(literal)
+	if err != nil {
+		panic(err)
+	}
+	return g
+}
+
+// ==================== ASSERTIONS ====================
+
+// AssertEqual fails the test unless the graphs have the same direction,
+// the same vertices with the same names and weights, and the same edges
+// Edges are compared as by mst.Diff, so their order does not matter. It
+// reports whether the graphs were equal
+func AssertEqual(t TB, got, want *mst.Graph) bool {
+	t.Helper()
+	if got.Directed != want.Directed {
+		t.Errorf("graphtest: got directed=%v, want directed=%v", got.Directed, want.Directed)
+		return false
+	}
+
+	equal := true
+	for _, id := range want.SortedVertexIDs() {
+		w := want.Vertices[id]
+		g, exists := got.Vertices[id]
+		switch {
+		case !exists:
+			t.Errorf("graphtest: missing vertex %d", id)
+			equal = false
+		case g.Name != w.Name || g.Weight != w.Weight:
+			t.Errorf("graphtest: vertex %d is %q (weight %d), want %q (weight %d)", id, g.Name, g.Weight, w.Name, w.Weight)
+			equal = false
+		}
+	}
+
+	delta := mst.Diff(want, got)
+	for _, v := range delta.AddedVertices {
+		t.Errorf("graphtest: unexpected vertex %d", v.ID)
+	}
+	for _, e := range delta.AddedEdges {
+		t.Errorf("graphtest: unexpected edge %d-%d:%d", e.From, e.To, e.Weight)
+	}
+	for _, e := range delta.RemovedEdges {
+		t.Errorf("graphtest: missing edge %d-%d:%d", e.From, e.To, e.Weight)
+	}
+	for _, c := range delta.WeightChanges {
+		t.Errorf("graphtest: edge %d-%d has weight %d, want %d", c.From, c.To, c.NewWeight, c.OldWeight)
+	}
+	return equal && delta.IsEmpty()
+}
+
+// AssertValidMST fails the test unless edges form a minimum spanning
+// forest of g: every edge belongs to g, no edge closes a cycle, every
+// component of g is spanned, and the total weight matches Kruskal's
+// It reports whether the forest was valid
+func AssertValidMST(t TB, g *mst.Graph, edges []*mst.Edge) bool {
+	t.Helper()
+	if g.Directed {
+		t.Fatalf("graphtest: AssertValidMST needs an undirected graph")
+	}
+
+	member := make(map[*mst.Edge]bool, 2*len(g.Edges))
+	for _, v := range g.Vertices {
+		for _, e := range v.Edges {
+			member[e] = true
+		}
+	}
+	uf := mst.NewUnionFind()
+	for id := range g.Vertices {
+		uf.MakeSet(id)
+	}
+	valid := true
+	for _, e := range edges {
+		if !member[e] {
+			t.Errorf("graphtest: edge %d-%d:%d is not an edge of the graph", e.From.ID, e.To.ID, e.Weight)
+			valid = false
+			continue
+		}
+		if !uf.Union(e.From.ID, e.To.ID) {
+			t.Errorf("graphtest: edge %d-%d:%d closes a cycle", e.From.ID, e.To.ID, e.Weight)
+			valid = false
+		}
+	}
+
+	_, want := g.Kruskal()
+	components := mst.NewUnionFind()
+	for id := range g.Vertices {
+		components.MakeSet(id)
+	}
+	spanning := 0
+	for _, e := range g.Edges {
+		if components.Union(e.From.ID, e.To.ID) {
+			spanning++
+		}
+	}
+	if valid && len(edges) != spanning {
+		t.Errorf("graphtest: forest has %d edges, a spanning forest needs %d", len(edges), spanning)
+		valid = false
+	}
+	if got := mst.GetMSTWeight(edges); valid && got != want {
+		t.Errorf("graphtest: forest weighs %d, the minimum is %d", got, want)
+		valid = false
+	}
+	return valid
+}
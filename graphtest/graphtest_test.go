@@ -0,0 +1,103 @@
+package graphtest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/l00pss/mst"
+)
+
+// recorder is a TB that records failures instead of failing the test
+type recorder struct {
+	errors []string
+	fatal  bool
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func (r *recorder) Fatalf(format string, args ...any) {
+	r.Errorf(format, args...)
+	r.fatal = true
+}
+
+// TestParse tests named, numeric, and directed literals
+func TestParse(t *testing.T) {
+	fmt.Println("\n=== GRAPH LITERAL TEST ===")
+
+	g := MustParse("A-B:4, B-C:2; C-A:7 D")
+	if g.Directed || g.VertexCount() != 4 || g.EdgeCount() != 3 {
+		t.Fatalf("Expected 4 vertices and 3 undirected edges, got %d and %d", g.VertexCount(), g.EdgeCount())
+	}
+	if v, _ := g.GetVertexByName("C"); v == nil || v.ID != 2 {
+		t.Error("Expected C to be numbered 2 in order of appearance")
+	}
+
+	numeric := MustParse("3-7 7-10:5")
+	if _, exists := numeric.Vertices[10]; !exists || numeric.Vertices[3].Name != "" {
+		t.Error("Expected integer vertices to be used as IDs")
+	}
+	if e, ok := numeric.GetEdge(3, 7); !ok || e.Weight != 1 {
+		t.Error("Expected the default weight 1")
+	}
+
+	directed := MustParse("0->1:2 1->2:3")
+	if !directed.Directed || directed.HasEdge(1, 0) {
+		t.Error("Expected a directed graph")
+	}
+
+	for _, bad := range []string{"A-B:x", "A->B B-C", "A:3", "A-B-C", "-B"} {
+		if _, err := Parse(bad); err == nil {
+			t.Errorf("Expected %q to be rejected", bad)
+		}
+	}
+}
+
+// TestAssertEqual tests that equal graphs pass and differences are reported
+func TestAssertEqual(t *testing.T) {
+	fmt.Println("\n=== ASSERT EQUAL TEST ===")
+
+	a := MustParse("0-1:4 1-2:2 2-0:7")
+	b := MustParse("2-1:2 0-2:7 1-0:4")
+	if !AssertEqual(t, &a, &b) {
+		t.Error("Expected edge order and direction of undirected edges not to matter")
+	}
+
+	r := &recorder{}
+	c := MustParse("0-1:4 1-2:3 2-3")
+	if AssertEqual(r, &c, &a) {
+		t.Error("Expected different graphs to fail")
+	}
+	fmt.Println(r.errors)
+	if len(r.errors) != 4 {
+		t.Errorf("Expected a changed weight, a missing edge, an extra vertex, and an extra edge, got %v", r.errors)
+	}
+}
+
+// TestAssertValidMST tests accepted and rejected forests
+func TestAssertValidMST(t *testing.T) {
+	fmt.Println("\n=== ASSERT VALID MST TEST ===")
+
+	g := MustParse("0-1:1 1-2:2 0-2:3 3-4:5 5")
+	tree, _ := g.Kruskal()
+	if !AssertValidMST(t, &g, tree) {
+		t.Error("Expected Kruskal's forest to be valid")
+	}
+
+	cases := map[string][]*mst.Edge{
+		"heavier":    {g.Edges[0], g.Edges[2], g.Edges[3]},
+		"cycle":      {g.Edges[0], g.Edges[1], g.Edges[2], g.Edges[3]},
+		"incomplete": {g.Edges[0], g.Edges[1]},
+		"foreign":    {g.Edges[0], g.Edges[1], {From: &mst.Vertex{ID: 3}, To: &mst.Vertex{ID: 4}, Weight: 5}},
+	}
+	for name, edges := range cases {
+		r := &recorder{}
+		if AssertValidMST(r, &g, edges) || len(r.errors) == 0 {
+			t.Errorf("%s: expected the forest to be rejected", name)
+		}
+		fmt.Printf("%s: %v\n", name, r.errors)
+	}
+}
@@ -0,0 +1,90 @@
+package graphtest
+
+import (
+	"bytes"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/l00pss/mst"
+)
+
+// ==================== ROUND TRIPS ====================
+
+// RoundTrip encodes g with codec, decodes the result, and fails the test
+// unless the copy has the same direction, vertex IDs, names, weights, and
+// attributes, and the same edges with the same weight, named Weights, and
+// attributes
+// Attribute values are compared by their formatted value, since formats
+// may change numeric types, e.g. int64 to int. Data and the weight unit
+// are kept by some formats only and are not compared.
+// It returns the decoded graph
+func RoundTrip(t TB, codec mst.Codec, g *mst.Graph) mst.Graph {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := codec.Encode(&buf, g); err != nil {
+		t.Fatalf("graphtest: encode: %v", err)
+		return mst.Graph{}
+	}
+	encoded := buf.String()
+	decoded, err := codec.Decode(&buf)
+	if err != nil {
+		t.Fatalf("graphtest: decode: %v\n%s", err, encoded)
+		return mst.Graph{}
+	}
+
+	if decoded.Directed != g.Directed {
+		t.Errorf("graphtest: round trip changed directed from %v to %v", g.Directed, decoded.Directed)
+	}
+	before, after := vertexSummaries(g), vertexSummaries(&decoded)
+	if !slices.Equal(before, after) {
+		t.Errorf("graphtest: round trip changed the vertices\nbefore: %v\n after: %v", before, after)
+	}
+	before, after = edgeSummaries(g), edgeSummaries(&decoded)
+	if !slices.Equal(before, after) {
+		t.Errorf("graphtest: round trip changed the edges\nbefore: %v\n after: %v", before, after)
+	}
+	return decoded
+}
+
+// vertexSummaries describes every vertex as a comparable string, in ID order
+func vertexSummaries(g *mst.Graph) []string {
+	summaries := make([]string, 0, len(g.Vertices))
+	for _, id := range g.SortedVertexIDs() {
+		v := g.Vertices[id]
+		summaries = append(summaries, fmt.Sprintf("%d %q w=%d%s", id, v.Name, v.Weight, formatAttrs(v.Attrs)))
+	}
+	return summaries
+}
+
+// edgeSummaries describes every edge as a comparable string, sorted, with
+// undirected endpoints in ascending order
+func edgeSummaries(g *mst.Graph) []string {
+	summaries := make([]string, 0, len(g.Edges))
+	for _, e := range g.Edges {
+		from, to := e.From.ID, e.To.ID
+		if !g.Directed && from > to {
+			from, to = to, from
+		}
+		summaries = append(summaries, fmt.Sprintf("%d-%d:%d%s%s", from, to, e.Weight, formatWeights(e.Weights), formatAttrs(e.Attrs)))
+	}
+	slices.Sort(summaries)
+	return summaries
+}
+
+func formatWeights(weights map[string]int) string {
+	var b strings.Builder
+	for _, k := range slices.Sorted(maps.Keys(weights)) {
+		fmt.Fprintf(&b, " %s:%d", k, weights[k])
+	}
+	return b.String()
+}
+
+func formatAttrs(attrs mst.Attributes) string {
+	var b strings.Builder
+	for _, k := range slices.Sorted(maps.Keys(attrs)) {
+		fmt.Fprintf(&b, " %s=%v", k, attrs[k])
+	}
+	return b.String()
+}
@@ -0,0 +1,255 @@
+package graphtest
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/l00pss/mst"
+)
+
+// graphFromBytes builds a small graph from fuzz input: the first byte picks
+// direction, size, names, vertex attributes, and vertex weights, and every
+// following three bytes make an edge with an optional string and float
+// attribute and named criterion
+func graphFromBytes(data []byte) mst.Graph {
+	if len(data) == 0 {
+		return mst.NewGraph(false)
+	}
+	head, data := data[0], data[1:]
+	g := mst.NewGraph(head&1 == 1)
+	n := int(head>>1)%8 + 1
+	vertices := make([]*mst.Vertex, n)
+	for i := range n {
+		v := mst.Vertex{ID: i * 3}
+		if head&0x10 != 0 {
+			v.Name = fmt.Sprintf("v%d", i)
+		}
+		if head&0x20 != 0 {
+			v.SetAttr("rack", i%3)
+		}
+		if head&0x40 != 0 {
+			v.Weight = i - 2
+		}
+		vertices[i] = g.AddVertex(v)
+	}
+	for len(data) >= 3 {
+		e := g.AddEdge(mst.Edge{From: vertices[int(data[0])%n], To: vertices[int(data[1])%n], Weight: int(int8(data[2]))})
+		if data[2]&1 != 0 {
+			e.SetAttr("kind", "fiber")
+		}
+		if data[2]&2 != 0 {
+			e.SetAttr("capacity", float64(data[0])/4)
+		}
+		if data[2]&4 != 0 {
+			g.SetEdgeWeightBy(e, "latency", int(data[1])-100)
+		}
+		data = data[3:]
+	}
+	return g
+}
+
+// roundTripFixtures are graphs exercising names, attributes, named
+// criteria, vertex weights, parallel edges, self-loops, negative weights,
+// isolated vertices, and direction
+func roundTripFixtures() map[string]mst.Graph {
+	named := MustParse("A-B:4 B-C:2 C-A:-7 A-B:4 D")
+	ab, _ := named.GetEdge(0, 1)
+	named.SetEdgeAttr(ab, "kind", "leased")
+	named.SetEdgeAttr(ab, "capacity", 2.5)
+	named.SetEdgeWeightBy(ab, "latency", 12)
+	named.SetEdgeWeightBy(ab, "loss", 0)
+	bc, _ := named.GetEdge(1, 2)
+	named.SetEdgeWeightBy(bc, "latency", -3)
+	named.SetVertexAttr(2, "site", "hq")
+	named.SetVertexAttr(2, "floors", 3)
+	named.SetVertexAttr(2, "active", true)
+	named.SetVertexWeight(0, 5)
+	named.SetVertexWeight(3, -1)
+
+	loops := MustParse("0-0:1 0-5:3 9")
+	return map[string]mst.Graph{
+		"named":    named,
+		"loops":    loops,
+		"directed": MustParse("0->1:2 1->0:3 1->2:1"),
+		"empty":    mst.NewGraph(false),
+		"complete": graphFromBytes([]byte{0x7e, 0, 1, 9, 1, 2, 8, 0, 2, 7, 3, 4, 6, 2, 5, 3, 4, 6, 12}),
+	}
+}
+
+// TestRoundTrip tests every built-in codec on the fixtures
+func TestRoundTrip(t *testing.T) {
+	fmt.Println("\n=== ROUND TRIP TEST ===")
+
+	codecs := mst.Codecs()
+	for _, format := range slices.Sorted(maps.Keys(codecs)) {
+		for name, g := range roundTripFixtures() {
+			t.Run(format+"/"+name, func(t *testing.T) {
+				decoded := RoundTrip(t, codecs[format], &g)
+				if name == "named" {
+					fmt.Printf("%s: %d vertices, %d edges\n", format, decoded.VertexCount(), decoded.EdgeCount())
+				}
+			})
+		}
+	}
+
+	// A codec that drops edges is caught
+	r := &recorder{}
+	g := MustParse("0-1:1 1-2:2")
+	RoundTrip(r, lossyCodec{}, &g)
+	if len(r.errors) != 1 {
+		t.Errorf("Expected one reported difference, got %v", r.errors)
+	}
+
+	// So is one that drops vertex weights and named criteria
+	r = &recorder{}
+	named := roundTripFixtures()["named"]
+	RoundTrip(r, lossyCodec{}, &named)
+	if len(r.errors) != 2 || !strings.Contains(r.errors[0], "w=5") || !strings.Contains(r.errors[1], "latency:12") {
+		t.Errorf("Expected the vertex weight and criteria to be reported, got %v", r.errors)
+	}
+}
+
+// lossyCodec writes snapshots but forgets the last edge, vertex weights,
+// and named criteria
+type lossyCodec struct{}
+
+func (lossyCodec) Encode(w io.Writer, g *mst.Graph) error {
+	copied := mst.NewGraph(g.Directed)
+	for _, v := range g.Vertices {
+		copied.AddVertex(mst.Vertex{ID: v.ID, Name: v.Name, Attrs: v.Attrs})
+	}
+	for _, e := range g.Edges[:len(g.Edges)-1] {
+		copied.AddEdge(mst.Edge{From: e.From, To: e.To, Weight: e.Weight})
+	}
+	return copied.WriteSnapshot(w)
+}
+
+func (lossyCodec) Decode(r io.Reader) (mst.Graph, error) {
+	return mst.ReadSnapshot(r)
+}
+
+// FuzzRoundTrip checks that every codec preserves generated graphs
+func FuzzRoundTrip(f *testing.F) {
+	f.Add([]byte{0x3e, 0, 1, 9, 1, 2, 8, 0, 2, 7})
+	f.Add([]byte{0x7e, 0, 1, 13, 1, 2, 6, 0, 2, 4})
+	f.Add([]byte{0x01, 0, 0, 0xff, 1, 0, 3})
+	f.Add([]byte{0x12, 5, 5, 2, 5, 5, 2})
+	codecs := mst.Codecs()
+	f.Fuzz(func(t *testing.T, data []byte) {
+		g := graphFromBytes(data)
+		for _, codec := range codecs {
+			RoundTrip(t, codec, &g)
+		}
+	})
+}
+
+// FuzzDecode checks that every codec rejects or faithfully re-encodes
+// arbitrary input without panicking
+func FuzzDecode(f *testing.F) {
+	codecs := mst.Codecs()
+	for _, g := range roundTripFixtures() {
+		for _, codec := range codecs {
+			var buf bytes.Buffer
+			codec.Encode(&buf, &g)
+			f.Add(buf.Bytes())
+		}
+	}
+	f.Add([]byte(`{"nodes": [{"id": "a"}], "links": [{"source": "a", "target": "a", "weight": 1.5}]}`))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		for _, codec := range codecs {
+			g, err := codec.Decode(bytes.NewReader(data))
+			if err == nil {
+				RoundTrip(t, codec, &g)
+			}
+		}
+	})
+}
+
+// TestCSRRoundTrip tests that the CSR format keeps direction, vertex IDs,
+// and edges with their weights for every fixture; it stores no names or
+// attributes, so it is not a Codec
+func TestCSRRoundTrip(t *testing.T) {
+	for name, g := range roundTripFixtures() {
+		t.Run(name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := g.WriteCSR(&buf); err != nil {
+				t.Fatalf("WriteCSR failed: %v", err)
+			}
+			c, err := mst.ReadCSR(buf.Bytes())
+			if err != nil {
+				t.Fatalf("ReadCSR failed: %v", err)
+			}
+
+			if c.Directed() != g.Directed {
+				t.Errorf("Expected directed %v, got %v", g.Directed, c.Directed())
+			}
+			ids := make([]int, c.VertexCount())
+			for i := range ids {
+				ids[i] = c.VertexID(i)
+			}
+			if want := g.SortedVertexIDs(); !slices.Equal(ids, want) {
+				t.Errorf("Expected vertices %v, got %v", want, ids)
+			}
+
+			want := make([]string, 0, len(g.Edges))
+			for _, e := range g.Edges {
+				want = append(want, edgeKey(g.Directed, e.From.ID, e.To.ID, e.Weight))
+			}
+			got := make([]string, c.EdgeCount())
+			for i := range got {
+				u, v, w := c.EdgeAt(i)
+				got[i] = edgeKey(c.Directed(), c.VertexID(u), c.VertexID(v), w)
+			}
+			slices.Sort(want)
+			slices.Sort(got)
+			if !slices.Equal(got, want) {
+				t.Errorf("Expected edges %v, got %v", want, got)
+			}
+		})
+	}
+}
+
+// edgeKey describes an edge as a comparable string, with undirected
+// endpoints in ascending order
+func edgeKey(directed bool, from, to, weight int) string {
+	if !directed && from > to {
+		from, to = to, from
+	}
+	return fmt.Sprintf("%d-%d:%d", from, to, weight)
+}
+
+// FuzzReadCSR checks that ReadCSR with validation rejects corrupted input,
+// and that every query on input it accepts stays within bounds
+func FuzzReadCSR(f *testing.F) {
+	for _, g := range roundTripFixtures() {
+		var buf bytes.Buffer
+		g.WriteCSR(&buf)
+		f.Add(buf.Bytes())
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		c, err := mst.ReadCSR(data, mst.WithCSRValidation())
+		if err != nil {
+			return
+		}
+		for u := range c.VertexCount() {
+			c.Adjacent(u, func(v, _, edge int) bool {
+				if from, to, _ := c.EdgeAt(edge); from != u && to != u {
+					t.Errorf("edge %d in the adjacency of %d joins %d and %d", edge, u, from, to)
+				}
+				return v >= 0
+			})
+		}
+		if c.Directed() {
+			return
+		}
+		c.Kruskal()
+		if c.VertexCount() > 0 {
+			c.Prim(c.VertexID(0))
+		}
+	})
+}
@@ -0,0 +1,74 @@
+package mst
+
+import (
+	"fmt"
+	"hash/fnv"
+)
+
+// ==================== GRAPH FINGERPRINT ====================
+
+// Hash returns a fingerprint of the graph's contents
+// It covers directedness, every vertex with its ID, name, weight and
+// attributes, and every edge with its endpoints, weight, named criteria and
+// attributes, but not Data payloads. Attribute values are hashed by their
+// type and printed form. The result does not depend on map iteration or
+// edge insertion order, and undirected edges hash the same in either
+// orientation, so two graphs with the same content have the same hash
+func (g *Graph) Hash() uint64 {
+	var sum uint64
+	for id, v := range g.Vertices {
+		sum += mix64(1, uint64(id), hashString(v.Name), uint64(v.Weight), hashAttrs(v.Attrs))
+	}
+	for _, e := range g.Edges {
+		from, to := e.From.ID, e.To.ID
+		if !g.Directed && from > to {
+			from, to = to, from
+		}
+		sum += mix64(2, uint64(from), uint64(to), uint64(e.Weight), hashWeights(e.Weights), hashAttrs(e.Attrs))
+	}
+
+	directed := uint64(0)
+	if g.Directed {
+		directed = 1
+	}
+	return mix64(sum, directed, uint64(len(g.Vertices)), uint64(len(g.Edges)))
+}
+
+// mix64 folds values into a well-distributed 64-bit hash using the SplitMix64 finalizer
+// Summing mixed element hashes gives an order-independent multiset hash
+func mix64(values ...uint64) uint64 {
+	h := uint64(0x9e3779b97f4a7c15)
+	for _, x := range values {
+		h ^= x
+		h += 0x9e3779b97f4a7c15
+		h = (h ^ (h >> 30)) * 0xbf58476d1ce4e5b9
+		h = (h ^ (h >> 27)) * 0x94d049bb133111eb
+		h ^= h >> 31
+	}
+	return h
+}
+
+// hashWeights hashes named criteria independently of map order
+func hashWeights(weights map[string]int) uint64 {
+	var sum uint64
+	for name, w := range weights {
+		sum += mix64(3, hashString(name), uint64(w))
+	}
+	return sum
+}
+
+// hashAttrs hashes attributes independently of map order
+func hashAttrs(attrs Attributes) uint64 {
+	var sum uint64
+	for key, v := range attrs {
+		sum += mix64(4, hashString(key), hashString(fmt.Sprintf("%T=%v", v, v)))
+	}
+	return sum
+}
+
+// hashString hashes a string with FNV-1a
+func hashString(s string) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(s))
+	return h.Sum64()
+}
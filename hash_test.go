@@ -0,0 +1,70 @@
+package mst
+
+import (
+	"fmt"
+	"testing"
+)
+
+// TestHash tests that the fingerprint ignores order but detects changes
+func TestHash(t *testing.T) {
+	fmt.Println("\n=== GRAPH HASH TEST ===")
+
+	build := func(directed bool, edges [][3]int) Graph {
+		g := NewGraph(directed)
+		for _, e := range edges {
+			from := Vertex{ID: e[0], Name: fmt.Sprintf("V%d", e[0])}
+			to := Vertex{ID: e[1], Name: fmt.Sprintf("V%d", e[1])}
+			g.AddEdge(Edge{From: &from, To: &to, Weight: e[2]})
+		}
+		return g
+	}
+
+	a := build(false, [][3]int{{0, 1, 4}, {1, 2, 2}, {0, 2, 3}})
+	b := build(false, [][3]int{{2, 0, 3}, {0, 1, 4}, {2, 1, 2}})
+	fmt.Printf("Hash: %016x\n", a.Hash())
+	if a.Hash() != b.Hash() {
+		t.Error("Expected reordered and reoriented edges to hash the same")
+	}
+
+	d := build(true, [][3]int{{0, 1, 4}, {1, 2, 2}, {0, 2, 3}})
+	if a.Hash() == d.Hash() {
+		t.Error("Expected directedness to change the hash")
+	}
+	r := build(true, [][3]int{{1, 0, 4}, {1, 2, 2}, {0, 2, 3}})
+	if d.Hash() == r.Hash() {
+		t.Error("Expected reversing a directed edge to change the hash")
+	}
+
+	before := a.Hash()
+	a.SetEdgeWeight(a.Edges[0], 5)
+	if a.Hash() == before {
+		t.Error("Expected a weight change to change the hash")
+	}
+	a.SetEdgeWeight(a.Edges[0], 4)
+	if a.Hash() != before {
+		t.Error("Expected restoring the weight to restore the hash")
+	}
+
+	// Named criteria, attributes and vertex weights count too
+	for name, change := range map[string]func(){
+		"criterion":        func() { a.SetEdgeWeightBy(a.Edges[1], "latency", 7) },
+		"edge attribute":   func() { a.SetEdgeAttr(a.Edges[1], "kind", "fiber") },
+		"vertex attribute": func() { a.SetVertexAttr(2, "zone", "east") },
+		"vertex weight":    func() { a.SetVertexWeight(2, 3) },
+	} {
+		tx := a.Begin()
+		change()
+		if a.Hash() == before {
+			t.Errorf("Expected a %s change to change the hash", name)
+		}
+		tx.Rollback()
+		if a.Hash() != before {
+			t.Errorf("Expected rolling back the %s change to restore the hash", name)
+		}
+	}
+
+	a.AddEdge(Edge{From: a.Edges[0].From, To: a.Edges[0].To, Weight: 4})
+	if a.Hash() == before {
+		t.Error("Expected a parallel edge to change the hash")
+	}
+}
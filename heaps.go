@@ -0,0 +1,475 @@
+package mst
+
+import "fmt"
+
+// ==================== PLUGGABLE VERTEX HEAPS ====================
+
+// VertexHeap is a min-priority queue of vertices with decrease-key support
+// Push inserts a vertex, or lowers its key if it is already queued with a larger one
+type VertexHeap interface {
+	Len() int
+	Push(id, key int, edge *Edge)
+	PopMin() (id int, key int, edge *Edge)
+}
+
+// HeapKind identifies a VertexHeap implementation
+type HeapKind int
+
+const (
+	// HeapBinary is the array-based binary heap (IndexedPriorityQueue)
+	HeapBinary HeapKind = iota
+	// HeapPairing is a pairing heap with O(1) insert and amortized O(log n) decrease-key
+	HeapPairing
+	// HeapFibonacci is a Fibonacci heap with amortized O(1) decrease-key
+	HeapFibonacci
+	// HeapDAry is an array-based heap with a configurable branching factor
+	HeapDAry
+)
+
+func (k HeapKind) String() string {
+	switch k {
+	case HeapBinary:
+		return "binary"
+	case HeapPairing:
+		return "pairing"
+	case HeapFibonacci:
+		return "fibonacci"
+	case HeapDAry:
+		return "d-ary"
+	default:
+		return fmt.Sprintf("HeapKind(%d)", int(k))
+	}
+}
+
+// newVertexHeap creates a heap of the requested kind sized for a graph
+func newVertexHeap(o *options, vertexCount, edgeCount int) VertexHeap {
+	switch o.heap {
+	case HeapPairing:
+		return NewPairingHeap(vertexCount)
+	case HeapFibonacci:
+		return NewFibonacciHeap(vertexCount)
+	case HeapDAry:
+		d := o.arity
+		if d <= 0 {
+			d = autoArity(vertexCount, edgeCount)
+		}
+		return NewDAryHeap(d, vertexCount)
+	default:
+		return NewIndexedPriorityQueue(vertexCount)
+	}
+}
+
+// autoArity picks a branching factor from the average degree E/V
+// Denser graphs perform more decrease-key operations per pop, which favors wider nodes
+func autoArity(vertexCount, edgeCount int) int {
+	if vertexCount == 0 {
+		return 2
+	}
+	d := edgeCount / vertexCount
+	if d < 2 {
+		return 2
+	}
+	if d > 16 {
+		return 16
+	}
+	return d
+}
+
+// ==================== D-ARY HEAP ====================
+
+// DAryHeap is an indexed min-heap where every node has up to d children
+// A larger d makes the tree shallower, so decrease-key touches fewer
+// cache lines at the cost of scanning more children on pop
+type DAryHeap struct {
+	d     int
+	items []pqItem
+	index map[int]int
+}
+
+// NewDAryHeap creates an empty heap with branching factor d (at least 2)
+func NewDAryHeap(d, capacity int) *DAryHeap {
+	if d < 2 {
+		d = 2
+	}
+	return &DAryHeap{
+		d:     d,
+		items: make([]pqItem, 0, capacity),
+		index: make(map[int]int, capacity),
+	}
+}
+
+// Arity returns the branching factor of the heap
+func (h *DAryHeap) Arity() int { return h.d }
+
+// Len returns the number of vertices in the heap
+func (h *DAryHeap) Len() int { return len(h.items) }
+
+// Push inserts a vertex or decreases its key
+func (h *DAryHeap) Push(id, key int, edge *Edge) {
+	if i, exists := h.index[id]; exists {
+		if key < h.items[i].key {
+			h.items[i].key = key
+			h.items[i].edge = edge
+			h.up(i)
+		}
+		return
+	}
+	h.items = append(h.items, pqItem{id: id, key: key, edge: edge})
+	h.index[id] = len(h.items) - 1
+	h.up(len(h.items) - 1)
+}
+
+// PopMin removes and returns the vertex with the smallest key
+func (h *DAryHeap) PopMin() (id int, key int, edge *Edge) {
+	top := h.items[0]
+	last := len(h.items) - 1
+	h.swap(0, last)
+	h.items = h.items[:last]
+	delete(h.index, top.id)
+	if last > 0 {
+		h.down(0)
+	}
+	return top.id, top.key, top.edge
+}
+
+func (h *DAryHeap) swap(i, j int) {
+	h.items[i], h.items[j] = h.items[j], h.items[i]
+	h.index[h.items[i].id] = i
+	h.index[h.items[j].id] = j
+}
+
+func (h *DAryHeap) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / h.d
+		if h.items[parent].key <= h.items[i].key {
+			break
+		}
+		h.swap(i, parent)
+		i = parent
+	}
+}
+
+func (h *DAryHeap) down(i int) {
+	n := len(h.items)
+	for {
+		smallest := i
+		first := h.d*i + 1
+		for c := first; c < first+h.d && c < n; c++ {
+			if h.items[c].key < h.items[smallest].key {
+				smallest = c
+			}
+		}
+		if smallest == i {
+			return
+		}
+		h.swap(i, smallest)
+		i = smallest
+	}
+}
+
+// ==================== PAIRING HEAP ====================
+
+type pairingNode struct {
+	id      int
+	key     int
+	edge    *Edge
+	child   *pairingNode
+	sibling *pairingNode
+	prev    *pairingNode // parent for the first child, previous sibling otherwise
+}
+
+// PairingHeap is a self-adjusting heap-ordered multiway tree
+type PairingHeap struct {
+	root  *pairingNode
+	nodes map[int]*pairingNode
+}
+
+// NewPairingHeap creates an empty pairing heap
+func NewPairingHeap(capacity int) *PairingHeap {
+	return &PairingHeap{nodes: make(map[int]*pairingNode, capacity)}
+}
+
+// Len returns the number of vertices in the heap
+func (h *PairingHeap) Len() int { return len(h.nodes) }
+
+// Push inserts a vertex or decreases its key
+func (h *PairingHeap) Push(id, key int, edge *Edge) {
+	if n, exists := h.nodes[id]; exists {
+		if key >= n.key {
+			return
+		}
+		n.key = key
+		n.edge = edge
+		if n == h.root {
+			return
+		}
+		// Detach the subtree rooted at n and meld it back in
+		if n.prev.child == n {
+			n.prev.child = n.sibling
+		} else {
+			n.prev.sibling = n.sibling
+		}
+		if n.sibling != nil {
+			n.sibling.prev = n.prev
+		}
+		n.sibling = nil
+		n.prev = nil
+		h.root = pairingMeld(h.root, n)
+		return
+	}
+
+	n := &pairingNode{id: id, key: key, edge: edge}
+	h.nodes[id] = n
+	h.root = pairingMeld(h.root, n)
+}
+
+// PopMin removes and returns the vertex with the smallest key
+func (h *PairingHeap) PopMin() (id int, key int, edge *Edge) {
+	top := h.root
+	delete(h.nodes, top.id)
+	h.root = pairingMergePairs(top.child)
+	return top.id, top.key, top.edge
+}
+
+// pairingMeld links two detached roots, the larger becoming a child of the smaller
+func pairingMeld(a, b *pairingNode) *pairingNode {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	if b.key < a.key {
+		a, b = b, a
+	}
+	b.prev = a
+	b.sibling = a.child
+	if a.child != nil {
+		a.child.prev = b
+	}
+	a.child = b
+	return a
+}
+
+// pairingMergePairs combines a sibling list with the standard two-pass strategy
+func pairingMergePairs(first *pairingNode) *pairingNode {
+	if first == nil {
+		return nil
+	}
+
+	list := make([]*pairingNode, 0)
+	for n := first; n != nil; {
+		next := n.sibling
+		n.sibling = nil
+		n.prev = nil
+		list = append(list, n)
+		n = next
+	}
+
+	// First pass: meld pairs left to right
+	pairs := list[:0]
+	for i := 0; i < len(list); i += 2 {
+		if i+1 < len(list) {
+			pairs = append(pairs, pairingMeld(list[i], list[i+1]))
+		} else {
+			pairs = append(pairs, list[i])
+		}
+	}
+
+	// Second pass: meld right to left
+	root := pairs[len(pairs)-1]
+	for i := len(pairs) - 2; i >= 0; i-- {
+		root = pairingMeld(pairs[i], root)
+	}
+	return root
+}
+
+// ==================== FIBONACCI HEAP ====================
+
+type fibNode struct {
+	id     int
+	key    int
+	edge   *Edge
+	parent *fibNode
+	child  *fibNode
+	left   *fibNode
+	right  *fibNode
+	degree int
+	mark   bool
+}
+
+// FibonacciHeap is a collection of heap-ordered trees with lazy consolidation
+type FibonacciHeap struct {
+	min   *fibNode
+	nodes map[int]*fibNode
+}
+
+// NewFibonacciHeap creates an empty Fibonacci heap
+func NewFibonacciHeap(capacity int) *FibonacciHeap {
+	return &FibonacciHeap{nodes: make(map[int]*fibNode, capacity)}
+}
+
+// Len returns the number of vertices in the heap
+func (h *FibonacciHeap) Len() int { return len(h.nodes) }
+
+// Push inserts a vertex or decreases its key
+func (h *FibonacciHeap) Push(id, key int, edge *Edge) {
+	if n, exists := h.nodes[id]; exists {
+		h.decreaseKey(n, key, edge)
+		return
+	}
+
+	n := &fibNode{id: id, key: key, edge: edge}
+	n.left, n.right = n, n
+	h.nodes[id] = n
+	h.addRoot(n)
+}
+
+// PopMin removes and returns the vertex with the smallest key
+func (h *FibonacciHeap) PopMin() (id int, key int, edge *Edge) {
+	z := h.min
+	delete(h.nodes, z.id)
+
+	// Move all children of z to the root list
+	for _, c := range fibSiblings(z.child) {
+		c.parent = nil
+		c.mark = false
+		c.left, c.right = c, c
+		fibSplice(z, c)
+	}
+	z.child = nil
+
+	if z.right == z {
+		h.min = nil
+	} else {
+		h.min = z.right
+		fibRemove(z)
+		h.consolidate()
+	}
+	return z.id, z.key, z.edge
+}
+
+func (h *FibonacciHeap) addRoot(n *fibNode) {
+	if h.min == nil {
+		h.min = n
+		return
+	}
+	fibSplice(h.min, n)
+	if n.key < h.min.key {
+		h.min = n
+	}
+}
+
+func (h *FibonacciHeap) decreaseKey(n *fibNode, key int, edge *Edge) {
+	if key >= n.key {
+		return
+	}
+	n.key = key
+	n.edge = edge
+	if p := n.parent; p != nil && n.key < p.key {
+		h.cut(n, p)
+		h.cascadingCut(p)
+	}
+	if n.key < h.min.key {
+		h.min = n
+	}
+}
+
+// consolidate links roots of equal degree until all degrees are distinct
+func (h *FibonacciHeap) consolidate() {
+	roots := fibSiblings(h.min)
+	byDegree := make([]*fibNode, 0)
+	for _, x := range roots {
+		x.left, x.right = x, x
+		d := x.degree
+		for {
+			for d >= len(byDegree) {
+				byDegree = append(byDegree, nil)
+			}
+			y := byDegree[d]
+			if y == nil {
+				break
+			}
+			if y.key < x.key {
+				x, y = y, x
+			}
+			h.link(y, x)
+			byDegree[d] = nil
+			d++
+		}
+		byDegree[d] = x
+	}
+
+	h.min = nil
+	for _, n := range byDegree {
+		if n != nil {
+			h.addRoot(n)
+		}
+	}
+}
+
+// link makes the detached root y a child of x
+func (h *FibonacciHeap) link(y, x *fibNode) {
+	y.parent = x
+	y.mark = false
+	if x.child == nil {
+		x.child = y
+	} else {
+		fibSplice(x.child, y)
+	}
+	x.degree++
+}
+
+// cut moves n from the child list of p to the root list
+func (h *FibonacciHeap) cut(n, p *fibNode) {
+	if n.right == n {
+		p.child = nil
+	} else {
+		if p.child == n {
+			p.child = n.right
+		}
+		fibRemove(n)
+	}
+	p.degree--
+	n.parent = nil
+	n.mark = false
+	fibSplice(h.min, n)
+}
+
+func (h *FibonacciHeap) cascadingCut(n *fibNode) {
+	for p := n.parent; p != nil; p = n.parent {
+		if !n.mark {
+			n.mark = true
+			return
+		}
+		h.cut(n, p)
+		n = p
+	}
+}
+
+// fibSplice inserts the detached node n to the right of a
+func fibSplice(a, n *fibNode) {
+	n.left = a
+	n.right = a.right
+	a.right.left = n
+	a.right = n
+}
+
+// fibRemove unlinks n from its circular list
+func fibRemove(n *fibNode) {
+	n.left.right = n.right
+	n.right.left = n.left
+	n.left, n.right = n, n
+}
+
+// fibSiblings returns the nodes of a circular list as a slice
+func fibSiblings(start *fibNode) []*fibNode {
+	if start == nil {
+		return nil
+	}
+	nodes := []*fibNode{start}
+	for n := start.right; n != start; n = n.right {
+		nodes = append(nodes, n)
+	}
+	return nodes
+}
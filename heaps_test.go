@@ -0,0 +1,108 @@
+package mst
+
+import (
+	"fmt"
+	"testing"
+)
+
+// TestVertexHeaps tests every heap implementation against the same workload
+func TestVertexHeaps(t *testing.T) {
+	fmt.Println("\n=== VERTEX HEAP TEST ===")
+
+	kinds := []HeapKind{HeapBinary, HeapPairing, HeapFibonacci, HeapDAry}
+	for _, kind := range kinds {
+		h := newVertexHeap(newOptions([]Option{WithHeap(kind)}), 0, 0)
+		for id := 0; id < 100; id++ {
+			h.Push(id, (id*37)%101+100, nil)
+		}
+		// Pop a few to force consolidation, then decrease keys
+		popped := make(map[int]bool)
+		for i := 0; i < 10; i++ {
+			id, _, _ := h.PopMin()
+			popped[id] = true
+		}
+		for id := 0; id < 100; id += 3 {
+			if !popped[id] {
+				h.Push(id, id, nil)
+			}
+		}
+
+		last := -1
+		count := 0
+		for h.Len() > 0 {
+			_, key, _ := h.PopMin()
+			if key < last {
+				t.Errorf("%s heap: keys out of order, %d after %d", kind, key, last)
+			}
+			last = key
+			count++
+		}
+		if count != 90 {
+			t.Errorf("%s heap: expected 90 items, got %d", kind, count)
+		}
+	}
+}
+
+// TestPrimEagerHeaps tests that every heap yields the same MST weight
+func TestPrimEagerHeaps(t *testing.T) {
+	g := buildCompleteGraph(40)
+	_, expected := g.Kruskal()
+
+	for _, kind := range []HeapKind{HeapBinary, HeapPairing, HeapFibonacci, HeapDAry} {
+		mst, weight := g.PrimEager(0, WithHeap(kind))
+		fmt.Printf("%-10s - Edges: %d, Weight: %d\n", kind, len(mst), weight)
+		if weight != expected {
+			t.Errorf("%s heap: expected MST weight %d, got %d", kind, expected, weight)
+		}
+	}
+}
+
+// BenchmarkPrimEagerPairing benchmarks eager Prim with a pairing heap
+func BenchmarkPrimEagerPairing(b *testing.B) {
+	g := buildCompleteGraph(200)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		g.PrimEager(0, WithHeap(HeapPairing))
+	}
+}
+
+// BenchmarkPrimEagerFibonacci benchmarks eager Prim with a Fibonacci heap
+func BenchmarkPrimEagerFibonacci(b *testing.B) {
+	g := buildCompleteGraph(200)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		g.PrimEager(0, WithHeap(HeapFibonacci))
+	}
+}
+
+// TestDAryHeapArity tests explicit and automatic branching factors
+func TestDAryHeapArity(t *testing.T) {
+	g := buildCompleteGraph(40)
+	_, expected := g.Kruskal()
+
+	for _, d := range []int{0, 2, 4, 8} {
+		_, weight := g.PrimEager(0, WithDAryHeap(d))
+		if weight != expected {
+			t.Errorf("%d-ary heap: expected MST weight %d, got %d", d, expected, weight)
+		}
+	}
+
+	if d := autoArity(100, 50); d != 2 {
+		t.Errorf("Expected arity 2 for a sparse graph, got %d", d)
+	}
+	if d := autoArity(100, 800); d != 8 {
+		t.Errorf("Expected arity 8 for average degree 8, got %d", d)
+	}
+}
+
+// BenchmarkPrimEagerDAry benchmarks eager Prim with a 4-ary and an 8-ary heap
+func BenchmarkPrimEagerDAry(b *testing.B) {
+	g := buildCompleteGraph(200)
+	for _, d := range []int{4, 8} {
+		b.Run(fmt.Sprintf("d=%d", d), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				g.PrimEager(0, WithDAryHeap(d))
+			}
+		})
+	}
+}
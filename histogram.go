@@ -0,0 +1,102 @@
+package mst
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ==================== WEIGHT HISTOGRAM ====================
+
+// Histogram is the distribution of edge weights over equal-width buckets
+// Bucket i covers weights in [Bounds[i], Bounds[i+1])
+type Histogram struct {
+	Bounds []int
+	Counts []int
+	Total  int
+	Min    int
+	Max    int
+	Mean   float64
+}
+
+// WeightHistogram returns the weight distribution of every edge in the graph
+func (g *Graph) WeightHistogram(buckets int) Histogram {
+	return EdgeWeightHistogram(g.Edges, buckets)
+}
+
+// EdgeWeightHistogram returns the weight distribution of an edge list, such as an MST
+// The number of buckets is reduced when the weight range is narrower than requested
+func EdgeWeightHistogram(edges []*Edge, buckets int) Histogram {
+	h := Histogram{Total: len(edges)}
+	if len(edges) == 0 || buckets < 1 {
+		return h
+	}
+
+	h.Min, h.Max = edges[0].Weight, edges[0].Weight
+	sum := 0
+	for _, e := range edges {
+		h.Min = min(h.Min, e.Weight)
+		h.Max = max(h.Max, e.Weight)
+		sum += e.Weight
+	}
+	h.Mean = float64(sum) / float64(len(edges))
+
+	span := h.Max - h.Min + 1
+	buckets = min(buckets, span)
+	width := (span + buckets - 1) / buckets
+
+	h.Bounds = make([]int, buckets+1)
+	for i := range h.Bounds {
+		h.Bounds[i] = h.Min + i*width
+	}
+	h.Counts = make([]int, buckets)
+	for _, e := range edges {
+		h.Counts[(e.Weight-h.Min)/width]++
+	}
+	return h
+}
+
+// ASCII renders the histogram as horizontal bars scaled to at most width characters
+func (h Histogram) ASCII(width int) string {
+	var sb strings.Builder
+	peak := 0
+	for _, c := range h.Counts {
+		peak = max(peak, c)
+	}
+	for i, c := range h.Counts {
+		bar := 0
+		if peak > 0 {
+			bar = c * width / peak
+		}
+		// Pad by hand since the bar glyph is wider than one byte
+		fmt.Fprintf(&sb, "[%6d, %6d) %s%s %d\n", h.Bounds[i], h.Bounds[i+1],
+			strings.Repeat("█", bar), strings.Repeat(" ", width-bar), c)
+	}
+	return sb.String()
+}
+
+// WriteSVG renders the histogram as a vertical bar chart
+func (h Histogram) WriteSVG(w io.Writer) error {
+	const width, height, margin = 400, 200, 20
+	bw := bufio.NewWriter(w)
+
+	fmt.Fprintf(bw, "<svg xmlns=\"http://www.w3.org/2000/svg\" width=\"%d\" height=\"%d\">\n", width, height+margin)
+	peak := 0
+	for _, c := range h.Counts {
+		peak = max(peak, c)
+	}
+	if len(h.Counts) > 0 && peak > 0 {
+		barWidth := float64(width-2*margin) / float64(len(h.Counts))
+		for i, c := range h.Counts {
+			barHeight := float64(c) * float64(height-2*margin) / float64(peak)
+			x := float64(margin) + float64(i)*barWidth
+			y := float64(height-margin) - barHeight
+			fmt.Fprintf(bw, "  <rect x=\"%.1f\" y=\"%.1f\" width=\"%.1f\" height=\"%.1f\" fill=\"steelblue\" stroke=\"white\"/>\n",
+				x, y, barWidth, barHeight)
+			fmt.Fprintf(bw, "  <text x=\"%.1f\" y=\"%d\" font-size=\"10\">%d</text>\n", x, height, h.Bounds[i])
+		}
+	}
+	fmt.Fprintln(bw, "</svg>")
+	return bw.Flush()
+}
@@ -0,0 +1,49 @@
+package mst
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// TestWeightHistogram tests bucket boundaries and counts
+func TestWeightHistogram(t *testing.T) {
+	fmt.Println("\n=== WEIGHT HISTOGRAM TEST ===")
+
+	g, _ := buildConstraintGraph()
+
+	// Weights: 4 2 1 5 8 10 2 6 3, range 1..10
+	h := g.WeightHistogram(5)
+	fmt.Print(h.ASCII(20))
+
+	expectedBounds := []int{1, 3, 5, 7, 9, 11}
+	expectedCounts := []int{3, 2, 2, 1, 1}
+	for i, b := range expectedBounds {
+		if h.Bounds[i] != b {
+			t.Errorf("Bound %d: expected %d, got %d", i, b, h.Bounds[i])
+		}
+	}
+	for i, c := range expectedCounts {
+		if h.Counts[i] != c {
+			t.Errorf("Bucket %d: expected count %d, got %d", i, c, h.Counts[i])
+		}
+	}
+	if h.Total != 9 || h.Min != 1 || h.Max != 10 {
+		t.Errorf("Unexpected summary %+v", h)
+	}
+
+	mst, _ := g.Kruskal()
+	mh := EdgeWeightHistogram(mst, 100)
+	if len(mh.Counts) != 5 {
+		t.Errorf("Expected the bucket count to shrink to the weight range, got %d", len(mh.Counts))
+	}
+
+	var buf bytes.Buffer
+	if err := h.WriteSVG(&buf); err != nil {
+		t.Fatalf("WriteSVG failed: %v", err)
+	}
+	if strings.Count(buf.String(), "<rect") != 5 {
+		t.Errorf("Expected 5 bars in the SVG")
+	}
+}
@@ -0,0 +1,246 @@
+package mst
+
+// ==================== HEAVY-LIGHT DECOMPOSITION ====================
+
+// HeavyLight splits an MST (or spanning forest) into heavy paths laid out
+// in one array, so that sums and maxima over tree paths and subtrees take
+// O(log² V) and O(log V), and edge weights can change in O(log V)
+// The tree holding rootID is rooted there and the others at their smallest
+// vertex, which decides what a subtree is. Every edge is stored at the
+// position of its lower endpoint, and each subtree is a contiguous range
+type HeavyLight struct {
+	index  map[int]int // vertex ID -> dense index
+	ids    []int       // dense index -> vertex ID
+	parent []int
+	depth  []int
+	size   []int // vertices in the subtree
+	head   []int // top vertex of the heavy path
+	pos    []int // position in the base array, preorder with heavy children first
+	root   []int
+
+	edges []*Edge // edge to the parent at each position, nil for roots
+	sum   []int   // segment tree of weight sums over positions
+	max   []*Edge // segment tree of heaviest edges over positions
+}
+
+// NewHeavyLight builds the decomposition of the given tree edges
+func NewHeavyLight(mst []*Edge, rootID int) *HeavyLight {
+	rf := rootForest(mst, rootID)
+	n := len(rf.order)
+	h := &HeavyLight{
+		index:  make(map[int]int, n),
+		ids:    rf.order,
+		parent: make([]int, n),
+		depth:  make([]int, n),
+		size:   make([]int, n),
+		head:   make([]int, n),
+		pos:    make([]int, n),
+		root:   make([]int, n),
+		edges:  make([]*Edge, n),
+		sum:    make([]int, 2*n),
+		max:    make([]*Edge, 2*n),
+	}
+	for i, id := range rf.order {
+		h.index[id] = i
+	}
+	for i, id := range rf.order {
+		h.parent[i] = h.index[rf.parent[id]]
+		h.depth[i] = rf.depth[id]
+	}
+
+	// BFS order lists parents before children, so accumulate sizes backwards
+	heavy := make([]int, n)
+	children := make([][]int, n)
+	for i := n - 1; i >= 0; i-- {
+		h.size[i]++
+		heavy[i] = -1
+		if p := h.parent[i]; p != i {
+			h.size[p] += h.size[i]
+			children[p] = append(children[p], i)
+		}
+	}
+	for i := range n {
+		for _, c := range children[i] {
+			if heavy[i] < 0 || h.size[c] > h.size[heavy[i]] {
+				heavy[i] = c
+			}
+		}
+	}
+
+	// Preorder with the heavy child visited first keeps heavy paths and
+	// subtrees contiguous
+	next := 0
+	for i := range n {
+		if h.parent[i] != i {
+			continue
+		}
+		stack := []int{i}
+		h.head[i], h.root[i] = i, i
+		for len(stack) > 0 {
+			v := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			h.pos[v] = next
+			h.edges[next] = rf.parentEdge[h.ids[v]]
+			next++
+			for _, c := range children[v] {
+				if c != heavy[v] {
+					h.head[c], h.root[c] = c, h.root[v]
+					stack = append(stack, c)
+				}
+			}
+			if c := heavy[v]; c >= 0 {
+				h.head[c], h.root[c] = h.head[v], h.root[v]
+				stack = append(stack, c)
+			}
+		}
+	}
+
+	for p, e := range h.edges {
+		if e != nil {
+			h.sum[n+p], h.max[n+p] = e.Weight, e
+		}
+	}
+	for p := n - 1; p > 0; p-- {
+		h.pull(p)
+	}
+	return h
+}
+
+// pull recomputes an inner segment tree node from its children
+func (h *HeavyLight) pull(p int) {
+	h.sum[p] = h.sum[2*p] + h.sum[2*p+1]
+	h.max[p] = heavier(h.max[2*p], h.max[2*p+1])
+}
+
+// rangeQuery aggregates the edges at positions lo to hi inclusive
+func (h *HeavyLight) rangeQuery(lo, hi int) (int, *Edge) {
+	total, heaviest := 0, (*Edge)(nil)
+	for lo, hi = lo+len(h.edges), hi+len(h.edges)+1; lo < hi; lo, hi = lo/2, hi/2 {
+		if lo&1 == 1 {
+			total, heaviest = total+h.sum[lo], heavier(heaviest, h.max[lo])
+			lo++
+		}
+		if hi&1 == 1 {
+			hi--
+			total, heaviest = total+h.sum[hi], heavier(heaviest, h.max[hi])
+		}
+	}
+	return total, heaviest
+}
+
+// path aggregates the edges on the tree path between two vertices
+func (h *HeavyLight) path(u, v int) (int, *Edge, bool) {
+	a, okA := h.index[u]
+	b, okB := h.index[v]
+	if !okA || !okB || h.root[a] != h.root[b] {
+		return 0, nil, false
+	}
+	total, heaviest := 0, (*Edge)(nil)
+	for h.head[a] != h.head[b] {
+		if h.depth[h.head[a]] < h.depth[h.head[b]] {
+			a, b = b, a
+		}
+		s, m := h.rangeQuery(h.pos[h.head[a]], h.pos[a])
+		total, heaviest = total+s, heavier(heaviest, m)
+		a = h.parent[h.head[a]]
+	}
+	if a != b {
+		if h.depth[a] < h.depth[b] {
+			a, b = b, a
+		}
+		// b is the LCA, whose own parent edge is not on the path
+		s, m := h.rangeQuery(h.pos[b]+1, h.pos[a])
+		total, heaviest = total+s, heavier(heaviest, m)
+	}
+	return total, heaviest, true
+}
+
+// PathSum returns the total weight of the tree path between two vertices
+// It returns false if either vertex is missing or they are in different trees
+func (h *HeavyLight) PathSum(u, v int) (int, bool) {
+	total, _, ok := h.path(u, v)
+	return total, ok
+}
+
+// PathMax returns the heaviest edge on the tree path between two vertices
+// It returns false if either vertex is missing, they are in different trees, or u == v
+func (h *HeavyLight) PathMax(u, v int) (*Edge, bool) {
+	_, heaviest, ok := h.path(u, v)
+	if !ok || heaviest == nil {
+		return nil, false
+	}
+	return heaviest, true
+}
+
+// LCA returns the lowest common ancestor of two vertices
+// It returns false if either vertex is missing or they are in different trees
+func (h *HeavyLight) LCA(u, v int) (int, bool) {
+	a, okA := h.index[u]
+	b, okB := h.index[v]
+	if !okA || !okB || h.root[a] != h.root[b] {
+		return 0, false
+	}
+	for h.head[a] != h.head[b] {
+		if h.depth[h.head[a]] < h.depth[h.head[b]] {
+			a, b = b, a
+		}
+		a = h.parent[h.head[a]]
+	}
+	if h.depth[a] > h.depth[b] {
+		a = b
+	}
+	return h.ids[a], true
+}
+
+// SubtreeSize returns the number of vertices in the subtree of a vertex
+func (h *HeavyLight) SubtreeSize(id int) (int, bool) {
+	i, exists := h.index[id]
+	if !exists {
+		return 0, false
+	}
+	return h.size[i], true
+}
+
+// SubtreeSum returns the total weight of the edges below a vertex
+func (h *HeavyLight) SubtreeSum(id int) (int, bool) {
+	i, exists := h.index[id]
+	if !exists {
+		return 0, false
+	}
+	total, _ := h.rangeQuery(h.pos[i]+1, h.pos[i]+h.size[i]-1)
+	return total, true
+}
+
+// SubtreeMax returns the heaviest edge below a vertex
+// It returns false if the vertex is missing or a leaf
+func (h *HeavyLight) SubtreeMax(id int) (*Edge, bool) {
+	i, exists := h.index[id]
+	if !exists {
+		return nil, false
+	}
+	_, heaviest := h.rangeQuery(h.pos[i]+1, h.pos[i]+h.size[i]-1)
+	return heaviest, heaviest != nil
+}
+
+// UpdateEdge refreshes the sums and maxima after a tree edge's weight
+// changed, e.g. with SetEdgeWeight
+// It returns false if the edge does not join a vertex to its parent
+func (h *HeavyLight) UpdateEdge(e *Edge) bool {
+	a, okA := h.index[e.From.ID]
+	b, okB := h.index[e.To.ID]
+	if !okA || !okB {
+		return false
+	}
+	if h.parent[a] != b {
+		a, b = b, a
+	}
+	if h.parent[a] != b || a == b {
+		return false
+	}
+	p := h.pos[a] + len(h.edges)
+	h.sum[p] = h.edges[h.pos[a]].Weight
+	for p /= 2; p > 0; p /= 2 {
+		h.pull(p)
+	}
+	return true
+}
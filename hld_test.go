@@ -0,0 +1,122 @@
+package mst
+
+import (
+	"fmt"
+	"testing"
+)
+
+// naivePath walks from both vertices up to their meeting point and returns
+// the path weight and heaviest edge weight
+func naivePath(rf *rootedForest, u, v int) (int, int) {
+	total, heaviest := 0, 0
+	for u != v {
+		if rf.depth[u] < rf.depth[v] {
+			u, v = v, u
+		}
+		e := rf.parentEdge[u]
+		total += e.Weight
+		heaviest = max(heaviest, e.Weight)
+		u = rf.parent[u]
+	}
+	return total, heaviest
+}
+
+// TestHeavyLight tests path and subtree queries against naive walks
+func TestHeavyLight(t *testing.T) {
+	fmt.Println("\n=== HEAVY-LIGHT DECOMPOSITION TEST ===")
+
+	g := buildCompleteGraph(40)
+	mst, _ := g.Kruskal()
+	h := NewHeavyLight(mst, 7)
+
+	check := func(stage string) {
+		rf := rootForest(mst, 7)
+		for u := range 40 {
+			for v := range 40 {
+				wantSum, wantMax := naivePath(rf, u, v)
+				sum, _ := h.PathSum(u, v)
+				heaviest, ok := h.PathMax(u, v)
+				if sum != wantSum || ok != (u != v) || (ok && heaviest.Weight != wantMax) {
+					t.Fatalf("%s: path %d-%d: expected sum %d and max %d, got %d and %v", stage, u, v, wantSum, wantMax, sum, heaviest)
+				}
+			}
+		}
+
+		tree := RootMST(mst, 7)
+		for v := range 40 {
+			want := 0
+			for u := range 40 {
+				for _, id := range tree.PathToRoot(u)[1:] {
+					if id == v {
+						want += tree.ParentEdge[u].Weight
+						break
+					}
+				}
+			}
+			if sum, _ := h.SubtreeSum(v); sum != want {
+				t.Fatalf("%s: subtree of %d: expected sum %d, got %d", stage, v, want, sum)
+			}
+			if size, _ := h.SubtreeSize(v); size != tree.SubtreeSize[v] {
+				t.Fatalf("%s: subtree of %d: expected size %d, got %d", stage, v, tree.SubtreeSize[v], size)
+			}
+		}
+	}
+	check("built")
+
+	// Weight changes are picked up after UpdateEdge
+	for i, e := range mst {
+		if i%3 == 0 {
+			g.SetEdgeWeight(e, e.Weight+50*i)
+			if !h.UpdateEdge(e) {
+				t.Fatalf("Expected UpdateEdge to accept tree edge %d-%d", e.From.ID, e.To.ID)
+			}
+		}
+	}
+	check("updated")
+
+	total, _ := h.SubtreeSum(7)
+	fmt.Printf("Tree weight after updates: %d\n", total)
+	if total != GetMSTWeight(mst) {
+		t.Errorf("Expected the root's subtree to hold the whole tree, %d vs %d", total, GetMSTWeight(mst))
+	}
+	if lca, ok := h.LCA(7, 7); !ok || lca != 7 {
+		t.Errorf("Expected LCA(7, 7) = 7, got %d", lca)
+	}
+	if e, _ := g.GetEdge(0, 1); !containsPair(mst, 0, 1) && h.UpdateEdge(e) {
+		t.Error("Expected UpdateEdge to reject a non-tree edge")
+	}
+}
+
+// TestHeavyLightForest tests queries across separate trees
+func TestHeavyLightForest(t *testing.T) {
+	fmt.Println("\n=== HEAVY-LIGHT FOREST TEST ===")
+
+	v := make([]*Vertex, 5)
+	for i := range v {
+		v[i] = &Vertex{ID: i}
+	}
+	g := NewGraph(false)
+	g.AddEdge(Edge{From: v[0], To: v[1], Weight: 3})
+	g.AddEdge(Edge{From: v[1], To: v[2], Weight: 5})
+	g.AddEdge(Edge{From: v[3], To: v[4], Weight: 2})
+	h := NewHeavyLight(g.Edges, 2)
+
+	if _, ok := h.PathSum(0, 4); ok {
+		t.Error("Expected no path between separate trees")
+	}
+	if lca, _ := h.LCA(0, 1); lca != 1 {
+		t.Errorf("Expected LCA(0, 1) = 1 with root 2, got %d", lca)
+	}
+	if e, ok := h.SubtreeMax(2); !ok || e.Weight != 5 {
+		t.Errorf("Expected the heaviest edge below 2 to weigh 5, got %v", e)
+	}
+	if _, ok := h.SubtreeMax(0); ok {
+		t.Error("Expected no edge below the leaf 0")
+	}
+	if sum, _ := h.SubtreeSum(3); sum != 2 {
+		t.Errorf("Expected subtree 3 to weigh 2, got %d", sum)
+	}
+	if _, ok := h.SubtreeSize(9); ok {
+		t.Error("Expected no subtree for a missing vertex")
+	}
+}
@@ -0,0 +1,68 @@
+package mst
+
+// ==================== EDGE INDEX ====================
+
+// arcKey identifies an ordered pair of vertices
+type arcKey struct {
+	from, to int
+}
+
+// edgeIndex maps vertex pairs to the edges joining them
+// It covers g.Edges[:n] and is extended lazily as edges are appended
+type edgeIndex struct {
+	arcs map[arcKey][]*Edge
+	n    int
+	last *Edge // g.Edges[n-1] when the index was last extended
+}
+
+// edgeIndex returns an index that covers every edge in g.Edges
+// The index is rebuilt if g.Edges no longer starts with the indexed edges,
+// e.g. after the slice was replaced or a copy of the graph diverged
+func (g *Graph) edgeIndex() *edgeIndex {
+	idx := g.index
+	if idx == nil || idx.n > len(g.Edges) || (idx.n > 0 && g.Edges[idx.n-1] != idx.last) {
+		idx = &edgeIndex{arcs: make(map[arcKey][]*Edge, len(g.Edges))}
+		g.index = idx
+	}
+	for _, edge := range g.Edges[idx.n:] {
+		idx.add(edge, g.Directed)
+	}
+	idx.n = len(g.Edges)
+	if idx.n > 0 {
+		idx.last = g.Edges[idx.n-1]
+	}
+	return idx
+}
+
+// add indexes an edge by its direction, plus the reverse direction for undirected graphs
+func (idx *edgeIndex) add(edge *Edge, directed bool) {
+	forward := arcKey{from: edge.From.ID, to: edge.To.ID}
+	idx.arcs[forward] = append(idx.arcs[forward], edge)
+	if directed || forward.from == forward.to {
+		return
+	}
+	reverse := edge.twin
+	if reverse == nil {
+		reverse = edge
+	}
+	backward := arcKey{from: edge.To.ID, to: edge.From.ID}
+	idx.arcs[backward] = append(idx.arcs[backward], reverse)
+}
+
+// GetEdge returns the edge from fromID to toID in O(1)
+// In undirected graphs the edge is oriented so that From is fromID, and among
+// parallel edges the lightest one is returned
+func (g *Graph) GetEdge(fromID, toID int) (*Edge, bool) {
+	var best *Edge
+	for _, edge := range g.edgeIndex().arcs[arcKey{from: fromID, to: toID}] {
+		if best == nil || edge.Weight < best.Weight {
+			best = edge
+		}
+	}
+	return best, best != nil
+}
+
+// HasEdge reports whether an edge runs from fromID to toID
+func (g *Graph) HasEdge(fromID, toID int) bool {
+	return len(g.edgeIndex().arcs[arcKey{from: fromID, to: toID}]) > 0
+}
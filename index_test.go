@@ -0,0 +1,54 @@
+package mst
+
+import (
+	"fmt"
+	"testing"
+)
+
+// TestGetEdge tests O(1) edge lookup between vertex pairs
+func TestGetEdge(t *testing.T) {
+	fmt.Println("\n=== GET EDGE TEST ===")
+
+	g := NewGraph(false)
+	vertices := make([]Vertex, 4)
+	for i := range vertices {
+		vertices[i] = Vertex{ID: i, Name: fmt.Sprintf("V%d", i)}
+		g.AddVertex(vertices[i])
+	}
+	g.AddEdge(Edge{From: &vertices[0], To: &vertices[1], Weight: 7})
+	g.AddEdge(Edge{From: &vertices[1], To: &vertices[2], Weight: 3})
+
+	edge, ok := g.GetEdge(1, 0)
+	if !ok || edge.From.ID != 1 || edge.To.ID != 0 || edge.Weight != 7 {
+		t.Errorf("Expected oriented edge 1-0 of weight 7, got %v (ok=%v)", edge, ok)
+	}
+	if g.HasEdge(0, 2) {
+		t.Error("Expected no edge between 0 and 2")
+	}
+
+	// Edges added after the first lookup are picked up, and the lightest parallel wins
+	g.AddEdges([]Edge{
+		{From: &vertices[0], To: &vertices[1], Weight: 2},
+		{From: &vertices[2], To: &vertices[3], Weight: 5},
+	})
+	if edge, _ := g.GetEdge(0, 1); edge.Weight != 2 {
+		t.Errorf("Expected lightest parallel edge of weight 2, got %d", edge.Weight)
+	}
+	if !g.HasEdge(3, 2) {
+		t.Error("Expected edge between 3 and 2")
+	}
+
+	// Cross-reference MST output with the graph
+	mst, _ := g.Kruskal()
+	for _, e := range mst {
+		if found, ok := g.GetEdge(e.From.ID, e.To.ID); !ok || found.Weight != e.Weight {
+			t.Errorf("MST edge %v not found in graph", e)
+		}
+	}
+
+	d := NewGraph(true)
+	d.AddEdge(Edge{From: &vertices[0], To: &vertices[1], Weight: 1})
+	if !d.HasEdge(0, 1) || d.HasEdge(1, 0) {
+		t.Error("Expected directed lookup to respect edge direction")
+	}
+}
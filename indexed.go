@@ -0,0 +1,257 @@
+package mst
+
+import (
+	"container/heap"
+	"sort"
+)
+
+// ==================== INDEXED GRAPH ALGORITHMS ====================
+
+// EdgeProvider is a read-only edge list over dense vertex indices
+// 0..VertexCount()-1 and edge indices 0..EdgeCount()-1
+// It is all Kruskal and Borůvka need, so users can run them directly over
+// their own edge storage without copying it into a Graph
+type EdgeProvider interface {
+	VertexCount() int
+	EdgeCount() int
+	// EdgeAt returns the endpoint indices and weight of edge i
+	EdgeAt(i int) (u, v, weight int)
+}
+
+// WeightedUndirected is an EdgeProvider that can also list the edges at a
+// vertex, which Prim needs to grow its tree
+// Graph, CompactGraph, and CSRGraph implement it
+type WeightedUndirected interface {
+	EdgeProvider
+	// Adjacent calls yield for every edge at vertex u until yield returns false
+	Adjacent(u int, yield func(v, weight, edge int) bool)
+}
+
+// IndexedGraph is the name WeightedUndirected had before EdgeProvider was split out
+// It lets the MST algorithms run on compact storage such as a CSRGraph
+// without building a Graph
+type IndexedGraph = WeightedUndirected
+
+// weightSorted is implemented by indexed graphs whose edge indices already
+// run in ascending weight order, letting Kruskal skip its sort
+type weightSorted interface {
+	SortedByWeight() bool
+}
+
+// KruskalIndexed runs Kruskal's algorithm on an indexed graph and returns the
+// indices of the spanning forest edges and their total weight
+// When the graph reports SortedByWeight, edges are streamed in index order
+// and only the O(V) union-find is held in memory
+func KruskalIndexed(g EdgeProvider) ([]int, int) {
+	m := g.EdgeCount()
+	var order []int
+	if s, ok := g.(weightSorted); !ok || !s.SortedByWeight() {
+		order = make([]int, m)
+		for i := range order {
+			order[i] = i
+		}
+		sort.SliceStable(order, func(i, j int) bool {
+			_, _, wi := g.EdgeAt(order[i])
+			_, _, wj := g.EdgeAt(order[j])
+			return wi < wj
+		})
+	}
+
+	uf := NewDenseUnionFind(g.VertexCount())
+	forest := make([]int, 0)
+	total := 0
+	for k := range m {
+		i := k
+		if order != nil {
+			i = order[k]
+		}
+		u, v, w := g.EdgeAt(i)
+		if uf.Union(u, v) {
+			forest = append(forest, i)
+			total += w
+			if len(forest) == g.VertexCount()-1 {
+				break
+			}
+		}
+	}
+	return forest, total
+}
+
+// indexedItem is a priority queue entry of PrimIndexed
+type indexedItem struct {
+	weight, edge, to int
+}
+
+type indexedQueue []indexedItem
+
+func (q indexedQueue) Len() int           { return len(q) }
+func (q indexedQueue) Less(i, j int) bool { return q[i].weight < q[j].weight }
+func (q indexedQueue) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+func (q *indexedQueue) Push(x any)        { *q = append(*q, x.(indexedItem)) }
+func (q *indexedQueue) Pop() any {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return item
+}
+
+// PrimIndexed runs lazy Prim's algorithm on an indexed graph from vertex index
+// start and returns the indices of the tree edges and their total weight
+func PrimIndexed(g WeightedUndirected, start int) ([]int, int) {
+	n := g.VertexCount()
+	if start < 0 || start >= n {
+		return nil, 0
+	}
+
+	visited := make([]bool, n)
+	pq := &indexedQueue{}
+	visit := func(u int) {
+		visited[u] = true
+		g.Adjacent(u, func(v, weight, edge int) bool {
+			if !visited[v] {
+				heap.Push(pq, indexedItem{weight: weight, edge: edge, to: v})
+			}
+			return true
+		})
+	}
+
+	tree := make([]int, 0)
+	total := 0
+	visit(start)
+	for pq.Len() > 0 && len(tree) < n-1 {
+		item := heap.Pop(pq).(indexedItem)
+		if visited[item.to] {
+			continue
+		}
+		tree = append(tree, item.edge)
+		total += item.weight
+		visit(item.to)
+	}
+	return tree, total
+}
+
+// BoruvkaIndexed runs Borůvka's algorithm on an edge provider and returns the
+// indices of the spanning forest edges and their total weight
+// Each round scans every edge once for the cheapest edge leaving each
+// component, with ties broken by edge index, so no sort is needed
+func BoruvkaIndexed(g EdgeProvider) ([]int, int) {
+	n, m := g.VertexCount(), g.EdgeCount()
+	uf := NewDenseUnionFind(n)
+	cheapest := make([]int, n)
+
+	forest := make([]int, 0)
+	total := 0
+	for len(forest) < n-1 {
+		for i := range cheapest {
+			cheapest[i] = -1
+		}
+		found := false
+		for i := range m {
+			u, v, w := g.EdgeAt(i)
+			ru, rv := uf.Find(u), uf.Find(v)
+			if ru == rv {
+				continue
+			}
+			found = true
+			for _, root := range [2]int{ru, rv} {
+				if best := cheapest[root]; best < 0 {
+					cheapest[root] = i
+				} else if _, _, bw := g.EdgeAt(best); w < bw {
+					cheapest[root] = i
+				}
+			}
+		}
+		if !found {
+			break
+		}
+
+		picked := make([]int, 0)
+		for _, i := range cheapest {
+			if i >= 0 {
+				picked = append(picked, i)
+			}
+		}
+		sort.Ints(picked)
+		for _, i := range picked {
+			u, v, w := g.EdgeAt(i)
+			if uf.Union(u, v) {
+				forest = append(forest, i)
+				total += w
+			}
+		}
+	}
+	return forest, total
+}
+
+// ==================== GRAPH AS AN EDGE PROVIDER ====================
+
+// denseIndex numbers the vertices of a Graph in ascending ID order and
+// records the position of every edge in g.Edges
+// It covers g.Edges[:n] and is extended lazily as edges are appended
+type denseIndex struct {
+	ids      []int
+	index    map[int]int
+	position map[*Edge]int // both adjacency copies of an undirected edge map to its index
+	n        int
+	last     *Edge
+}
+
+// denseIndex returns an index covering the current vertices and edges
+// It is rebuilt when the vertex count or the start of g.Edges changed
+func (g *Graph) denseIndex() *denseIndex {
+	d := g.dense
+	if d == nil || len(d.ids) != len(g.Vertices) || d.n > len(g.Edges) || (d.n > 0 && g.Edges[d.n-1] != d.last) {
+		ids := g.SortedVertexIDs()
+		d = &denseIndex{
+			ids:      ids,
+			index:    make(map[int]int, len(ids)),
+			position: make(map[*Edge]int, 2*len(g.Edges)),
+		}
+		for i, id := range ids {
+			d.index[id] = i
+		}
+		g.dense = d
+	}
+	for i := d.n; i < len(g.Edges); i++ {
+		edge := g.Edges[i]
+		d.position[edge] = i
+		if edge.twin != nil {
+			d.position[edge.twin] = i
+		}
+	}
+	d.n = len(g.Edges)
+	if d.n > 0 {
+		d.last = g.Edges[d.n-1]
+	}
+	return d
+}
+
+// VertexID returns the ID of the vertex at dense index i
+// Indices follow ascending vertex ID order
+func (g *Graph) VertexID(i int) int {
+	return g.denseIndex().ids[i]
+}
+
+// IndexOf returns the dense index of the vertex with the given ID
+func (g *Graph) IndexOf(id int) (int, bool) {
+	i, ok := g.denseIndex().index[id]
+	return i, ok
+}
+
+// EdgeAt returns the endpoint indices and weight of g.Edges[i]
+func (g *Graph) EdgeAt(i int) (u, v, weight int) {
+	d := g.denseIndex()
+	edge := g.Edges[i]
+	return d.index[edge.From.ID], d.index[edge.To.ID], edge.Weight
+}
+
+// Adjacent calls yield for every edge at the vertex with dense index u,
+// reporting the neighbor's index and the edge's index in g.Edges
+func (g *Graph) Adjacent(u int, yield func(v, weight, edge int) bool) {
+	d := g.denseIndex()
+	for _, edge := range g.Vertices[d.ids[u]].Edges {
+		if !yield(d.index[edge.To.ID], edge.Weight, d.position[edge]) {
+			return
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package mst
+
+import (
+	"fmt"
+	"testing"
+)
+
+// gridGraph is a user-owned w×h grid implementing WeightedUndirected
+// without any Graph behind it
+type gridGraph struct {
+	w, h int
+}
+
+func (g gridGraph) VertexCount() int { return g.w * g.h }
+func (g gridGraph) EdgeCount() int   { return (g.w-1)*g.h + g.w*(g.h-1) }
+
+// EdgeAt lists horizontal edges first, then vertical ones
+func (g gridGraph) EdgeAt(i int) (u, v, weight int) {
+	if horizontal := (g.w - 1) * g.h; i < horizontal {
+		row, col := i/(g.w-1), i%(g.w-1)
+		u = row*g.w + col
+		return u, u + 1, (u*7)%5 + 1
+	}
+	i -= (g.w - 1) * g.h
+	u = i
+	return u, u + g.w, (u*3)%4 + 2
+}
+
+func (g gridGraph) Adjacent(u int, yield func(v, weight, edge int) bool) {
+	for i := range g.EdgeCount() {
+		a, b, w := g.EdgeAt(i)
+		if a == u && !yield(b, w, i) || b == u && !yield(a, w, i) {
+			return
+		}
+	}
+}
+
+// TestIndexedInterfaces tests the index-based algorithms over Graph and a user type
+func TestIndexedInterfaces(t *testing.T) {
+	fmt.Println("\n=== INDEXED INTERFACES TEST ===")
+
+	g := buildCompleteGraph(25)
+	_, want := g.Kruskal()
+	for name, run := range map[string]func(WeightedUndirected) ([]int, int){
+		"kruskal": func(g WeightedUndirected) ([]int, int) { return KruskalIndexed(g) },
+		"prim":    func(g WeightedUndirected) ([]int, int) { return PrimIndexed(g, 0) },
+		"boruvka": func(g WeightedUndirected) ([]int, int) { return BoruvkaIndexed(g) },
+	} {
+		tree, total := run(&g)
+		fmt.Printf("%s over Graph: %d edges, total %d\n", name, len(tree), total)
+		if total != want || len(tree) != 24 {
+			t.Errorf("%s: expected total %d over 24 edges, got %d over %d", name, want, total, len(tree))
+		}
+		sum := 0
+		for _, i := range tree {
+			sum += g.Edges[i].Weight
+		}
+		if sum != total {
+			t.Errorf("%s: edge indices add up to %d, not %d", name, sum, total)
+		}
+	}
+
+	// Dense indices follow vertex IDs and survive vertex removal
+	g.RemoveVertex(0)
+	if i, ok := g.IndexOf(1); !ok || i != 0 || g.VertexID(0) != 1 {
+		t.Errorf("Expected vertex 1 at index 0 after removal, got %d", i)
+	}
+	_, remaining := g.Kruskal()
+	if _, total := KruskalIndexed(&g); total != remaining {
+		t.Error("Expected KruskalIndexed to match Kruskal after removal")
+	}
+
+	grid := gridGraph{w: 6, h: 5}
+	_, kruskal := KruskalIndexed(grid)
+	_, prim := PrimIndexed(grid, 0)
+	tree, boruvka := BoruvkaIndexed(grid)
+	if kruskal != prim || kruskal != boruvka || len(tree) != grid.VertexCount()-1 {
+		t.Errorf("Expected equal totals on the grid, got %d, %d, %d", kruskal, prim, boruvka)
+	}
+}
@@ -0,0 +1,118 @@
+package mst
+
+// ==================== INDEXED PRIORITY QUEUE (FOR EAGER PRIM) ====================
+
+// pqItem is a vertex entry in the indexed priority queue
+type pqItem struct {
+	id   int
+	key  int
+	edge *Edge
+}
+
+// IndexedPriorityQueue is a binary min-heap keyed by vertex ID
+// Each vertex appears at most once, so the queue never holds stale entries
+// and its key can be lowered in place with DecreaseKey
+type IndexedPriorityQueue struct {
+	items []pqItem
+	index map[int]int // vertex ID -> position in items
+}
+
+// NewIndexedPriorityQueue creates an empty queue with room for capacity vertices
+func NewIndexedPriorityQueue(capacity int) *IndexedPriorityQueue {
+	return &IndexedPriorityQueue{
+		items: make([]pqItem, 0, capacity),
+		index: make(map[int]int, capacity),
+	}
+}
+
+// Len returns the number of vertices in the queue
+func (pq *IndexedPriorityQueue) Len() int { return len(pq.items) }
+
+// Contains reports whether a vertex is in the queue
+func (pq *IndexedPriorityQueue) Contains(id int) bool {
+	_, exists := pq.index[id]
+	return exists
+}
+
+// Key returns the current key of a vertex
+func (pq *IndexedPriorityQueue) Key(id int) (int, bool) {
+	i, exists := pq.index[id]
+	if !exists {
+		return 0, false
+	}
+	return pq.items[i].key, true
+}
+
+// Push inserts a vertex with the given key and the edge that reaches it
+// If the vertex is already queued, Push behaves like DecreaseKey
+func (pq *IndexedPriorityQueue) Push(id, key int, edge *Edge) {
+	if pq.Contains(id) {
+		pq.DecreaseKey(id, key, edge)
+		return
+	}
+	pq.items = append(pq.items, pqItem{id: id, key: key, edge: edge})
+	pq.index[id] = len(pq.items) - 1
+	pq.up(len(pq.items) - 1)
+}
+
+// DecreaseKey lowers the key of a queued vertex
+// It returns false if the vertex is not queued or the new key is not smaller
+func (pq *IndexedPriorityQueue) DecreaseKey(id, key int, edge *Edge) bool {
+	i, exists := pq.index[id]
+	if !exists || key >= pq.items[i].key {
+		return false
+	}
+	pq.items[i].key = key
+	pq.items[i].edge = edge
+	pq.up(i)
+	return true
+}
+
+// PopMin removes and returns the vertex with the smallest key
+func (pq *IndexedPriorityQueue) PopMin() (id int, key int, edge *Edge) {
+	top := pq.items[0]
+	last := len(pq.items) - 1
+	pq.swap(0, last)
+	pq.items = pq.items[:last]
+	delete(pq.index, top.id)
+	if last > 0 {
+		pq.down(0)
+	}
+	return top.id, top.key, top.edge
+}
+
+func (pq *IndexedPriorityQueue) swap(i, j int) {
+	pq.items[i], pq.items[j] = pq.items[j], pq.items[i]
+	pq.index[pq.items[i].id] = i
+	pq.index[pq.items[j].id] = j
+}
+
+func (pq *IndexedPriorityQueue) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if pq.items[parent].key <= pq.items[i].key {
+			break
+		}
+		pq.swap(i, parent)
+		i = parent
+	}
+}
+
+func (pq *IndexedPriorityQueue) down(i int) {
+	n := len(pq.items)
+	for {
+		smallest := i
+		left, right := 2*i+1, 2*i+2
+		if left < n && pq.items[left].key < pq.items[smallest].key {
+			smallest = left
+		}
+		if right < n && pq.items[right].key < pq.items[smallest].key {
+			smallest = right
+		}
+		if smallest == i {
+			return
+		}
+		pq.swap(i, smallest)
+		i = smallest
+	}
+}
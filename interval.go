@@ -0,0 +1,105 @@
+package mst
+
+// ==================== INTERVAL WEIGHTS ====================
+
+// Criterion names read by IntervalFromWeights
+const (
+	CriterionMin = "min"
+	CriterionMax = "max"
+)
+
+// WeightInterval is the range an uncertain edge weight can take
+type WeightInterval struct {
+	Min int
+	Max int
+}
+
+// IntervalFunc returns the weight interval of an edge
+type IntervalFunc func(edge *Edge) WeightInterval
+
+// IntervalFromWeights is an IntervalFunc reading the "min" and "max" entries
+// of Edge.Weights; a missing bound falls back to Edge.Weight
+func IntervalFromWeights(edge *Edge) WeightInterval {
+	iv := WeightInterval{Min: edge.Weight, Max: edge.Weight}
+	if w, ok := edge.Weights[CriterionMin]; ok {
+		iv.Min = w
+	}
+	if w, ok := edge.Weights[CriterionMax]; ok {
+		iv.Max = w
+	}
+	return iv
+}
+
+// kruskalWith runs Kruskal minimizing an arbitrary weight function
+func (g *Graph) kruskalWith(weight func(*Edge) int) ([]*Edge, int) {
+	if g.Directed {
+		panic("Kruskal algorithm only works for undirected graphs")
+	}
+	o := newOptions(nil)
+	o.weight = weight
+	return g.kruskal(o)
+}
+
+// BestCaseMST returns the MST when every edge takes its lowest weight
+func (g *Graph) BestCaseMST(iv IntervalFunc) ([]*Edge, int) {
+	return g.kruskalWith(func(e *Edge) int { return iv(e).Min })
+}
+
+// WorstCaseMST returns the MST when every edge takes its highest weight
+func (g *Graph) WorstCaseMST(iv IntervalFunc) ([]*Edge, int) {
+	return g.kruskalWith(func(e *Edge) int { return iv(e).Max })
+}
+
+// MaxRegret returns the largest amount by which tree can exceed the optimum
+// over all weight scenarios. The worst scenario for a tree puts its own
+// edges at their maximum and every other edge at its minimum
+func (g *Graph) MaxRegret(tree []*Edge, iv IntervalFunc) int {
+	inTree := make(map[*Edge]bool, 2*len(tree))
+	treeWeight := 0
+	for _, e := range tree {
+		inTree[e] = true
+		if e.twin != nil {
+			inTree[e.twin] = true
+		}
+		treeWeight += iv(e).Max
+	}
+	_, best := g.kruskalWith(func(e *Edge) int {
+		if inTree[e] {
+			return iv(e).Max
+		}
+		return iv(e).Min
+	})
+	return treeWeight - best
+}
+
+// RobustMST is a spanning tree chosen for interval weights
+type RobustMST struct {
+	Tree      []*Edge
+	MaxRegret int // worst-case distance from the optimum, see MaxRegret
+	WorstCase int // tree weight when all its edges take their maximum
+}
+
+// MinMaxRegretMST returns a spanning tree with small maximum regret
+// The exact problem is NP-hard; this evaluates the midpoint-scenario MST,
+// which is guaranteed to be within a factor of 2 of the optimal regret,
+// together with the best- and worst-case MSTs, and keeps the best of the three
+func (g *Graph) MinMaxRegretMST(iv IntervalFunc) RobustMST {
+	midpoint, _ := g.kruskalWith(func(e *Edge) int {
+		i := iv(e)
+		return i.Min + i.Max // twice the midpoint, same order
+	})
+	best, _ := g.BestCaseMST(iv)
+	worst, _ := g.WorstCaseMST(iv)
+
+	var res RobustMST
+	for i, tree := range [][]*Edge{midpoint, best, worst} {
+		regret := g.MaxRegret(tree, iv)
+		if i == 0 || regret < res.MaxRegret {
+			res = RobustMST{Tree: tree, MaxRegret: regret}
+		}
+	}
+	for _, e := range res.Tree {
+		res.WorstCase += iv(e).Max
+	}
+	return res
+}
@@ -0,0 +1,52 @@
+package mst
+
+import (
+	"fmt"
+	"testing"
+)
+
+// TestMinMaxRegretMST tests best/worst-case and robust trees over intervals
+func TestMinMaxRegretMST(t *testing.T) {
+	fmt.Println("\n=== INTERVAL WEIGHT TEST ===")
+
+	g := NewGraph(false)
+	v := make([]Vertex, 3)
+	for i := range v {
+		v[i] = Vertex{ID: i}
+	}
+	add := func(from, to, lo, hi int) *Edge {
+		return g.AddEdge(Edge{From: &v[from], To: &v[to], Weight: lo,
+			Weights: map[string]int{CriterionMin: lo, CriterionMax: hi}})
+	}
+	add(0, 1, 5, 5)           // certain
+	risky := add(1, 2, 1, 20) // cheap on a good day
+	steady := add(0, 2, 6, 7) // always close to its estimate
+
+	best, bestWeight := g.BestCaseMST(IntervalFromWeights)
+	if bestWeight != 6 || !containsEdge(best, risky) {
+		t.Errorf("Expected best case 6 with the risky link, got %d", bestWeight)
+	}
+	if _, w := g.WorstCaseMST(IntervalFromWeights); w != 12 {
+		t.Errorf("Expected worst case 12, got %d", w)
+	}
+
+	// Taking the risky link can cost 20 against an optimum of 5+6
+	if r := g.MaxRegret(best, IntervalFromWeights); r != 14 {
+		t.Errorf("Expected regret 14 for the best-case tree, got %d", r)
+	}
+
+	robust := g.MinMaxRegretMST(IntervalFromWeights)
+	fmt.Printf("regret %d, worst case %d\n", robust.MaxRegret, robust.WorstCase)
+	if !containsEdge(robust.Tree, steady) || robust.MaxRegret != 6 || robust.WorstCase != 12 {
+		t.Errorf("Expected the steady link with regret 6, got %+v", robust)
+	}
+}
+
+func containsEdge(edges []*Edge, target *Edge) bool {
+	for _, e := range edges {
+		if e == target || e == target.twin {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,465 @@
+package mst
+
+import (
+	"fmt"
+	"slices"
+)
+
+// ==================== GRAPH MATCHING ====================
+
+// VertexMatcher reports whether vertex a of the searched graph may stand
+// for vertex b of the pattern
+type VertexMatcher func(a, b Vertex) bool
+
+// EdgeMatcher reports whether edge a of the searched graph may stand for
+// edge b of the pattern
+type EdgeMatcher func(a, b *Edge) bool
+
+// MatchOption configures IsIsomorphic and FindSubgraph
+type MatchOption func(*matchOptions)
+
+// matchOptions holds the settings collected from a list of MatchOption values
+type matchOptions struct {
+	vertex VertexMatcher
+	edge   EdgeMatcher
+	limit  int  // stop after this many matches, 0 for all
+	unique bool // one match per set of graph vertices
+}
+
+// WithVertexMatch only pairs vertices that match accepts
+func WithVertexMatch(match VertexMatcher) MatchOption {
+	return func(o *matchOptions) {
+		o.vertex = match
+	}
+}
+
+// WithEdgeMatch only pairs edges that match accepts
+func WithEdgeMatch(match EdgeMatcher) MatchOption {
+	return func(o *matchOptions) {
+		o.edge = match
+	}
+}
+
+// WithMatchLimit makes FindSubgraph stop after n matches; 0 or less finds all
+func WithMatchLimit(n int) MatchOption {
+	return func(o *matchOptions) {
+		o.limit = n
+	}
+}
+
+// WithUniqueOccurrences makes FindSubgraph report each set of graph
+// vertices once, instead of once per symmetry of the pattern
+// A ring of n vertices, for example, otherwise matches 2n times
+func WithUniqueOccurrences() MatchOption {
+	return func(o *matchOptions) {
+		o.unique = true
+	}
+}
+
+// SameVertexAttrs matches vertices whose attributes agree on every key,
+// present on both or on neither
+func SameVertexAttrs(keys ...string) VertexMatcher {
+	return func(a, b Vertex) bool {
+		return sameAttrs(a.Attrs, b.Attrs, keys)
+	}
+}
+
+// SameEdgeAttrs matches edges whose attributes agree on every key,
+// present on both or on neither
+func SameEdgeAttrs(keys ...string) EdgeMatcher {
+	return func(a, b *Edge) bool {
+		return sameAttrs(a.Attrs, b.Attrs, keys)
+	}
+}
+
+// sameAttrs compares attributes by their string form, so that 1 and 1.0
+// loaded from different formats agree
+func sameAttrs(a, b Attributes, keys []string) bool {
+	for _, key := range keys {
+		x, okA := a.GetString(key)
+		y, okB := b.GetString(key)
+		if okA != okB || x != y {
+			return false
+		}
+	}
+	return true
+}
+
+// IsIsomorphic reports whether g and other are the same graph up to vertex
+// IDs, and returns a mapping from other's vertex IDs to g's
+// Weights are ignored unless an EdgeMatcher compares them; parallel edges
+// count once. Both graphs must be directed or both undirected. It uses the
+// VF2 algorithm, exponential in the worst case but fast on most networks
+func (g *Graph) IsIsomorphic(other *Graph, opts ...MatchOption) (map[int]int, bool) {
+	if g.Directed != other.Directed || len(g.Vertices) != len(other.Vertices) {
+		return nil, false
+	}
+	big, small := newMatchGraph(g), newMatchGraph(other)
+	if big.arcs != small.arcs || !slices.Equal(big.degrees(), small.degrees()) {
+		return nil, false
+	}
+	o := newMatchOptions(opts)
+	o.limit = 1
+	matches := newVF2(big, small, true, o).run()
+	if len(matches) == 0 {
+		return nil, false
+	}
+	return matches[0], true
+}
+
+// FindSubgraph finds every induced subgraph of g that is isomorphic to
+// pattern, and returns each as a mapping from pattern's vertex IDs to g's
+// Induced means the matched vertices of g share no edge the pattern lacks,
+// so a ring pattern does not match a ring with a chord. Matches are found
+// in VF2 search order, which follows pattern and g vertex IDs. Edge
+// handling follows IsIsomorphic
+func (g *Graph) FindSubgraph(pattern *Graph, opts ...MatchOption) []map[int]int {
+	if g.Directed != pattern.Directed || len(pattern.Vertices) > len(g.Vertices) {
+		return nil
+	}
+	return newVF2(newMatchGraph(g), newMatchGraph(pattern), false, newMatchOptions(opts)).run()
+}
+
+// newMatchOptions applies opts over the default settings
+func newMatchOptions(opts []MatchOption) *matchOptions {
+	o := &matchOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// matchGraph is a graph with vertices numbered 0..n-1 in ID order and
+// sorted adjacency lists; undirected graphs share succ and pred
+type matchGraph struct {
+	ids      []int
+	vertices []Vertex
+	succ     [][]int
+	pred     [][]int
+	edge     []map[int]*Edge // first edge from i to each successor
+	loop     []*Edge
+	arcs     int
+	directed bool
+}
+
+func newMatchGraph(g *Graph) *matchGraph {
+	ids := g.SortedVertexIDs()
+	n := len(ids)
+	mg := &matchGraph{
+		ids:      ids,
+		vertices: make([]Vertex, n),
+		succ:     make([][]int, n),
+		edge:     make([]map[int]*Edge, n),
+		loop:     make([]*Edge, n),
+		directed: g.Directed,
+	}
+	index := make(map[int]int, n)
+	for i, id := range ids {
+		index[id] = i
+		mg.vertices[i] = g.Vertices[id]
+		mg.edge[i] = make(map[int]*Edge)
+	}
+	mg.pred = mg.succ
+	if g.Directed {
+		mg.pred = make([][]int, n)
+	}
+
+	for _, e := range g.Edges {
+		u, v := index[e.From.ID], index[e.To.ID]
+		if u == v {
+			if mg.loop[u] == nil {
+				mg.loop[u] = e
+				mg.arcs++
+			}
+			continue
+		}
+		if _, seen := mg.edge[u][v]; seen {
+			continue
+		}
+		mg.arcs++
+		mg.edge[u][v] = e
+		mg.succ[u] = append(mg.succ[u], v)
+		if g.Directed {
+			mg.pred[v] = append(mg.pred[v], u)
+		} else {
+			mg.edge[v][u] = e
+			mg.succ[v] = append(mg.succ[v], u)
+		}
+	}
+	for i := range n {
+		slices.Sort(mg.succ[i])
+		if g.Directed {
+			slices.Sort(mg.pred[i])
+		}
+	}
+	return mg
+}
+
+// degrees returns the sorted (out, in, loop) degree triples, which
+// isomorphic graphs share
+func (mg *matchGraph) degrees() [][3]int {
+	d := make([][3]int, len(mg.ids))
+	for i := range d {
+		d[i] = [3]int{len(mg.succ[i]), len(mg.pred[i]), 0}
+		if mg.loop[i] != nil {
+			d[i][2] = 1
+		}
+	}
+	slices.SortFunc(d, func(a, b [3]int) int {
+		for k := range a {
+			if a[k] != b[k] {
+				return a[k] - b[k]
+			}
+		}
+		return 0
+	})
+	return d
+}
+
+// vf2 is the search state of the VF2 algorithm, matching every vertex of
+// g2 to a distinct vertex of g1
+// core maps matched vertices to their partner, or -1. out and in record
+// the depth at which a vertex first joined or neighbored the matched part
+// as a successor or predecessor, or 0; unmatched vertices with a depth
+// form the terminal sets
+type vf2 struct {
+	g1, g2       *matchGraph
+	iso          bool
+	o            *matchOptions
+	core1, core2 []int
+	out1, out2   []int
+	in1, in2     []int
+	depth        int
+
+	matches [][]int
+	seen    map[string]bool
+}
+
+func newVF2(g1, g2 *matchGraph, iso bool, o *matchOptions) *vf2 {
+	n1, n2 := len(g1.ids), len(g2.ids)
+	s := &vf2{
+		g1: g1, g2: g2, iso: iso, o: o,
+		core1: slices.Repeat([]int{-1}, n1),
+		core2: slices.Repeat([]int{-1}, n2),
+		out1:  make([]int, n1),
+		out2:  make([]int, n2),
+		in1:   make([]int, n1),
+		in2:   make([]int, n2),
+	}
+	if o.unique {
+		s.seen = make(map[string]bool)
+	}
+	return s
+}
+
+// run searches and converts every match to an ID mapping
+func (s *vf2) run() []map[int]int {
+	s.match()
+	result := make([]map[int]int, len(s.matches))
+	for i, core := range s.matches {
+		m := make(map[int]int, len(core))
+		for p, v := range core {
+			m[s.g2.ids[p]] = s.g1.ids[v]
+		}
+		result[i] = m
+	}
+	return result
+}
+
+// done reports whether enough matches have been found
+func (s *vf2) done() bool {
+	return s.o.limit > 0 && len(s.matches) >= s.o.limit
+}
+
+// match extends the current partial mapping in every feasible way
+func (s *vf2) match() {
+	if s.depth == len(s.g2.ids) {
+		s.record()
+		return
+	}
+
+	// The next pattern vertex is the first in the out, then in terminal
+	// set; its partner must neighbor the image of a matched neighbor
+	m, candidates := -1, []int(nil)
+	for _, dir := range []int{0, 1} {
+		for p := range s.g2.ids {
+			if s.core2[p] == -1 && [][]int{s.out2, s.in2}[dir][p] != 0 {
+				m = p
+				break
+			}
+		}
+		if m == -1 {
+			continue
+		}
+		near, far := s.g2.pred, s.g1.succ
+		if dir == 1 {
+			near, far = s.g2.succ, s.g1.pred
+		}
+		for _, q := range near[m] {
+			if s.core2[q] != -1 {
+				candidates = far[s.core2[q]]
+				break
+			}
+		}
+		break
+	}
+	if m == -1 {
+		for p := range s.g2.ids {
+			if s.core2[p] == -1 {
+				m = p
+				break
+			}
+		}
+		candidates = make([]int, 0, len(s.g1.ids))
+		for v := range s.g1.ids {
+			candidates = append(candidates, v)
+		}
+	}
+
+	for _, n := range candidates {
+		if s.core1[n] != -1 || !s.feasible(n, m) {
+			continue
+		}
+		s.add(n, m)
+		s.match()
+		s.remove(n, m)
+		if s.done() {
+			return
+		}
+	}
+}
+
+// record saves the complete mapping held in core2
+func (s *vf2) record() {
+	if s.seen != nil {
+		key := slices.Clone(s.core2)
+		slices.Sort(key)
+		k := fmt.Sprint(key)
+		if s.seen[k] {
+			return
+		}
+		s.seen[k] = true
+	}
+	s.matches = append(s.matches, slices.Clone(s.core2))
+}
+
+// feasible checks whether pairing n of g1 with m of g2 keeps the mapping
+// consistent, and whether enough unmatched neighbors remain
+func (s *vf2) feasible(n, m int) bool {
+	g1, g2 := s.g1, s.g2
+	if s.o.vertex != nil && !s.o.vertex(g1.vertices[n], g2.vertices[m]) {
+		return false
+	}
+	if (g1.loop[n] != nil) != (g2.loop[m] != nil) {
+		return false
+	}
+	if g2.loop[m] != nil && s.o.edge != nil && !s.o.edge(g1.loop[n], g2.loop[m]) {
+		return false
+	}
+
+	// Matched neighbors of m must be matched neighbors of n through
+	// matching edges, and, as subgraphs are induced, the converse
+	for _, q := range g2.succ[m] {
+		if v := s.core2[q]; v != -1 {
+			e := g1.edge[n][v]
+			if e == nil || (s.o.edge != nil && !s.o.edge(e, g2.edge[m][q])) {
+				return false
+			}
+		}
+	}
+	for _, v := range g1.succ[n] {
+		if q := s.core1[v]; q != -1 && g2.edge[m][q] == nil {
+			return false
+		}
+	}
+	if g1.directed {
+		for _, q := range g2.pred[m] {
+			if v := s.core2[q]; v != -1 {
+				e := g1.edge[v][n]
+				if e == nil || (s.o.edge != nil && !s.o.edge(e, g2.edge[q][m])) {
+					return false
+				}
+			}
+		}
+		for _, v := range g1.pred[n] {
+			if q := s.core1[v]; q != -1 && g2.edge[q][m] == nil {
+				return false
+			}
+		}
+	}
+
+	// Unmatched neighbors in each terminal set, and outside them
+	count := func(neighbors []int, core, out, in []int) [3]int {
+		var c [3]int
+		for _, v := range neighbors {
+			switch {
+			case core[v] != -1:
+			case out[v] != 0:
+				c[0]++
+			case in[v] != 0:
+				c[1]++
+			default:
+				c[2]++
+			}
+		}
+		return c
+	}
+	lists := [][2][]int{{g1.succ[n], g2.succ[m]}}
+	if g1.directed {
+		lists = append(lists, [2][]int{g1.pred[n], g2.pred[m]})
+	}
+	for _, l := range lists {
+		a := count(l[0], s.core1, s.out1, s.in1)
+		b := count(l[1], s.core2, s.out2, s.in2)
+		for k := range a {
+			if a[k] < b[k] || (s.iso && a[k] != b[k]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// add pairs n with m and marks their neighbors in the terminal sets
+func (s *vf2) add(n, m int) {
+	s.depth++
+	s.core1[n], s.core2[m] = m, n
+	s.mark(n, s.g1.succ[n], s.out1)
+	s.mark(n, s.g1.pred[n], s.in1)
+	s.mark(m, s.g2.succ[m], s.out2)
+	s.mark(m, s.g2.pred[m], s.in2)
+}
+
+// remove undoes add, clearing the depths set at this depth
+func (s *vf2) remove(n, m int) {
+	s.unmark(n, s.g1.succ[n], s.out1)
+	s.unmark(n, s.g1.pred[n], s.in1)
+	s.unmark(m, s.g2.succ[m], s.out2)
+	s.unmark(m, s.g2.pred[m], s.in2)
+	s.core1[n], s.core2[m] = -1, -1
+	s.depth--
+}
+
+// mark records the current depth for v and its neighbors not yet marked
+func (s *vf2) mark(v int, neighbors, depths []int) {
+	if depths[v] == 0 {
+		depths[v] = s.depth
+	}
+	for _, x := range neighbors {
+		if depths[x] == 0 {
+			depths[x] = s.depth
+		}
+	}
+}
+
+// unmark clears the marks mark made at the current depth
+func (s *vf2) unmark(v int, neighbors, depths []int) {
+	if depths[v] == s.depth {
+		depths[v] = 0
+	}
+	for _, x := range neighbors {
+		if depths[x] == s.depth {
+			depths[x] = 0
+		}
+	}
+}
@@ -0,0 +1,173 @@
+package mst
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+)
+
+// buildRing returns an undirected ring over the given vertex IDs
+func buildRing(ids ...int) Graph {
+	g := NewGraph(false)
+	for i, id := range ids {
+		next := ids[(i+1)%len(ids)]
+		g.AddEdge(Edge{From: &Vertex{ID: id}, To: &Vertex{ID: next}, Weight: 1})
+	}
+	return g
+}
+
+// checkMapping reports whether mapping carries every edge of pattern onto
+// an edge of g between distinct vertices
+func checkMapping(g, pattern *Graph, mapping map[int]int) bool {
+	used := make(map[int]bool)
+	for _, v := range mapping {
+		if used[v] {
+			return false
+		}
+		used[v] = true
+	}
+	for _, e := range pattern.Edges {
+		if !g.HasEdge(mapping[e.From.ID], mapping[e.To.ID]) {
+			return false
+		}
+	}
+	return len(mapping) == len(pattern.Vertices)
+}
+
+// TestIsIsomorphic tests relabeled copies and near misses
+func TestIsIsomorphic(t *testing.T) {
+	fmt.Println("\n=== ISOMORPHISM TEST ===")
+
+	ring := buildRing(0, 1, 2, 3, 4, 5)
+	shuffled := buildRing(20, 14, 11, 17, 12, 19)
+	mapping, ok := ring.IsIsomorphic(&shuffled)
+	if !ok || !checkMapping(&ring, &shuffled, mapping) {
+		t.Fatalf("Expected relabeled rings to match, got %v", mapping)
+	}
+	fmt.Printf("Ring mapping: %v\n", mapping)
+
+	// Two triangles have the same degrees as a hexagon
+	triangles := buildRing(0, 1, 2)
+	for _, e := range buildRing(3, 4, 5).Edges {
+		triangles.AddEdge(Edge{From: &Vertex{ID: e.From.ID}, To: &Vertex{ID: e.To.ID}, Weight: 1})
+	}
+	if _, ok := ring.IsIsomorphic(&triangles); ok {
+		t.Error("Expected a hexagon and two triangles to differ")
+	}
+
+	// Edge matchers can require equal weights
+	shuffled.SetEdgeWeight(shuffled.Edges[0], 7)
+	sameWeight := WithEdgeMatch(func(a, b *Edge) bool { return a.Weight == b.Weight })
+	if _, ok := ring.IsIsomorphic(&shuffled, sameWeight); ok {
+		t.Error("Expected a weight difference to break the match")
+	}
+	ring.SetEdgeWeight(ring.Edges[3], 7)
+	if mapping, ok := ring.IsIsomorphic(&shuffled, sameWeight); !ok || !checkMapping(&ring, &shuffled, mapping) {
+		t.Error("Expected rings with one heavy edge each to match")
+	}
+
+	// Directed cycles only match in the same orientation
+	forward, backward := NewGraph(true), NewGraph(true)
+	v := make([]*Vertex, 3)
+	for i := range v {
+		v[i] = &Vertex{ID: i}
+	}
+	forward.AddEdge(Edge{From: v[0], To: v[1], Weight: 1})
+	forward.AddEdge(Edge{From: v[1], To: v[2], Weight: 1})
+	forward.AddEdge(Edge{From: v[2], To: v[0], Weight: 1})
+	backward.AddEdge(Edge{From: v[1], To: v[0], Weight: 1})
+	backward.AddEdge(Edge{From: v[2], To: v[1], Weight: 1})
+	backward.AddEdge(Edge{From: v[0], To: v[2], Weight: 1})
+	if _, ok := forward.IsIsomorphic(&backward); !ok {
+		t.Error("Expected a reversed 3-cycle to match")
+	}
+	backward.RemoveEdge(backward.Edges[2])
+	backward.AddEdge(Edge{From: v[2], To: v[0], Weight: 1})
+	if _, ok := forward.IsIsomorphic(&backward); ok {
+		t.Error("Expected a cycle and a transitive triangle to differ")
+	}
+}
+
+// TestIsIsomorphicRandom tests randomly permuted copies of random graphs
+func TestIsIsomorphicRandom(t *testing.T) {
+	fmt.Println("\n=== RANDOM ISOMORPHISM TEST ===")
+
+	rng := rand.New(rand.NewPCG(5, 1))
+	for trial := range 30 {
+		n := 8 + trial%5
+		perm := rng.Perm(n)
+		g, copied := NewGraph(trial%2 == 1), NewGraph(trial%2 == 1)
+		for i := range n {
+			g.AddVertex(Vertex{ID: i})
+			copied.AddVertex(Vertex{ID: 100 + perm[i]})
+		}
+		for u := range n {
+			for w := range n {
+				if u != w && (g.Directed || u < w) && rng.IntN(3) == 0 {
+					g.AddEdge(Edge{From: &Vertex{ID: u}, To: &Vertex{ID: w}, Weight: 1})
+					copied.AddEdge(Edge{From: &Vertex{ID: 100 + perm[u]}, To: &Vertex{ID: 100 + perm[w]}, Weight: 1})
+				}
+			}
+		}
+		mapping, ok := g.IsIsomorphic(&copied)
+		if !ok || !checkMapping(&g, &copied, mapping) {
+			t.Fatalf("Trial %d: expected a valid mapping, got %v", trial, mapping)
+		}
+	}
+}
+
+// TestFindSubgraph tests ring motifs in a grid
+func TestFindSubgraph(t *testing.T) {
+	fmt.Println("\n=== SUBGRAPH MATCHING TEST ===")
+
+	// A 3x3 grid holds four unit squares
+	grid := NewGraph(false)
+	for r := range 3 {
+		for c := range 3 {
+			id := 3*r + c
+			if c < 2 {
+				grid.AddEdge(Edge{From: &Vertex{ID: id}, To: &Vertex{ID: id + 1}, Weight: 1})
+			}
+			if r < 2 {
+				grid.AddEdge(Edge{From: &Vertex{ID: id}, To: &Vertex{ID: id + 3}, Weight: 1})
+			}
+		}
+	}
+	square := buildRing(0, 1, 2, 3)
+	all := grid.FindSubgraph(&square)
+	if len(all) != 4*8 {
+		t.Errorf("Expected 8 symmetric matches of each of 4 squares, got %d", len(all))
+	}
+	unique := grid.FindSubgraph(&square, WithUniqueOccurrences())
+	fmt.Printf("Squares in the grid: %d\n", len(unique))
+	if len(unique) != 4 {
+		t.Errorf("Expected 4 squares, got %d", len(unique))
+	}
+	for _, m := range unique {
+		if !checkMapping(&grid, &square, m) {
+			t.Errorf("Invalid match %v", m)
+		}
+	}
+	if got := grid.FindSubgraph(&square, WithMatchLimit(3)); len(got) != 3 {
+		t.Errorf("Expected the limit to stop at 3 matches, got %d", len(got))
+	}
+
+	// Matches are induced: a chord rules a square out
+	grid.AddEdge(Edge{From: &Vertex{ID: 0}, To: &Vertex{ID: 4}, Weight: 1})
+	if got := grid.FindSubgraph(&square, WithUniqueOccurrences()); len(got) != 3 {
+		t.Errorf("Expected the chorded square to drop out, got %d", len(got))
+	}
+
+	// Vertex attributes restrict which squares qualify
+	grid.SetVertexAttr(8, "role", "core")
+	square.SetVertexAttr(0, "role", "core")
+	got := grid.FindSubgraph(&square, WithVertexMatch(SameVertexAttrs("role")))
+	for _, m := range got {
+		if m[0] != 8 {
+			t.Errorf("Expected the core vertex to map to 8, got %v", m)
+		}
+	}
+	if len(got) != 2 {
+		t.Errorf("Expected the two rotations of the corner square at 8, got %d", len(got))
+	}
+}
@@ -0,0 +1,367 @@
+package mst
+
+import (
+	"errors"
+	"math"
+	"math/bits"
+	"math/rand/v2"
+)
+
+// ==================== KARGER MIN-CUT ====================
+
+// ErrNonPositiveWeight is returned when an algorithm that needs positive
+// weights, such as one treating them as capacities, meets a weight of 0 or less
+var ErrNonPositiveWeight = errors.New("edge weight is not positive")
+
+// ErrTooFewVertices is returned when a cut is asked of fewer than two vertices
+var ErrTooFewVertices = errors.New("graph has fewer than two vertices")
+
+// steinBase is the size at which Karger-Stein stops contracting and tries
+// every cut of the remaining super-vertices
+const steinBase = 6
+
+// KargerConfig configures KargerMinCut
+type KargerConfig struct {
+	Trials     int     // independent runs, 0 to derive them from Confidence
+	Confidence float64 // wanted chance of a minimum cut when Trials is 0, default 0.99
+	Stein      bool    // recursive Karger-Stein contraction instead of plain Karger
+	Seed       uint64  // random seed, 0 for a random one
+}
+
+// MinCut is a cut found by KargerMinCut
+type MinCut struct {
+	Weight int     // total weight of the cut edges
+	Side   []int   // vertices on the side of the lowest vertex ID, ascending
+	Edges  []*Edge // edges of g crossing the cut, in g.Edges order
+
+	Trials     int     // runs performed
+	Confidence float64 // lower bound on the chance that Weight is the minimum
+}
+
+// KargerMinCut finds a minimum cut, the lightest set of edges whose removal
+// disconnects the graph, by repeatedly contracting random edges until two
+// super-vertices are left
+// Edges are picked with probability proportional to their weight, which
+// must be positive, so weights act as capacities. One plain run finds a
+// minimum cut with probability at least 2/(V(V-1)); Karger-Stein contracts
+// to V/√2 twice and recurses on both, raising that to Ω(1/log V). The best
+// of all runs is returned with its confidence
+// Runs contract a V×V weight matrix rather than the Graph, so a plain run
+// costs O(V²) and a Karger-Stein run O(V² log V). Plain Karger needs
+// O(V² log V) runs and takes about a second at a hundred vertices;
+// Karger-Stein needs O(log² V) runs and takes a few seconds at a few
+// hundred. A disconnected graph has a cut of weight 0, returned with certainty
+func (g *Graph) KargerMinCut(cfg KargerConfig) (MinCut, error) {
+	if g.Directed {
+		panic("KargerMinCut only works for undirected graphs")
+	}
+	n := len(g.Vertices)
+	if n < 2 {
+		return MinCut{}, ErrTooFewVertices
+	}
+	for _, e := range g.Edges {
+		if e.Weight <= 0 {
+			return MinCut{}, ErrNonPositiveWeight
+		}
+	}
+	if groups := g.componentGroups(); len(groups) > 1 {
+		return MinCut{Side: groups[0], Edges: []*Edge{}, Confidence: 1}, nil
+	}
+
+	p := 2 / (float64(n) * float64(n-1))
+	if cfg.Stein {
+		depth := 0
+		for m := n; m > steinBase; m = steinSize(m) {
+			depth++
+		}
+		p = 1 / float64(depth+1)
+	}
+	trials := cfg.Trials
+	if trials <= 0 {
+		confidence := cfg.Confidence
+		if confidence <= 0 || confidence >= 1 {
+			confidence = 0.99
+		}
+		trials = 1
+		if p < 1 {
+			trials = int(math.Ceil(math.Log(1-confidence) / math.Log1p(-p)))
+		}
+	}
+
+	var rng *rand.Rand
+	if cfg.Seed == 0 {
+		rng = rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64()))
+	} else {
+		rng = rand.New(rand.NewPCG(cfg.Seed, cfg.Seed))
+	}
+	ids := g.SortedVertexIDs()
+	base := newCutMatrix(g, ids)
+	best := contractedCut{weight: math.MaxInt, side: make([]bool, n)}
+	var spare []*cutMatrix
+	for m := n; m > steinBase; m = steinSize(m) {
+		spare = append(spare, new(cutMatrix))
+	}
+	branch := new(cutMatrix)
+	for range trials {
+		base.cloneInto(branch)
+		if cfg.Stein {
+			kargerStein(branch, rng, &best, spare)
+		} else {
+			branch.contractRandom(2, rng)
+			branch.bestCut(&best)
+		}
+	}
+
+	// Report the side holding the lowest ID, which is ids[0]
+	side := make(map[int]bool, n)
+	result := MinCut{
+		Weight:     best.weight,
+		Side:       make([]int, 0),
+		Edges:      make([]*Edge, 0),
+		Trials:     trials,
+		Confidence: 1 - math.Pow(1-p, float64(trials)),
+	}
+	for i, id := range ids {
+		if best.side[i] == best.side[0] {
+			side[id] = true
+			result.Side = append(result.Side, id)
+		}
+	}
+	for _, e := range g.Edges {
+		if side[e.From.ID] != side[e.To.ID] {
+			result.Edges = append(result.Edges, e)
+		}
+	}
+	return result, nil
+}
+
+// contractedCut is the lightest cut found so far
+type contractedCut struct {
+	weight int
+	side   []bool // side of every vertex of g, in ascending ID order
+}
+
+// cutMatrix is an undirected graph of super-vertices as a dense k×k
+// matrix of summed edge weights, which contracts in O(k)
+// A matrix made by clone links back to the matrix it was copied from, so
+// the vertices of g behind each row are only worked out for a cut that
+// beats the best so far
+type cutMatrix struct {
+	k      int
+	w      []int   // w[u*k+v] is the weight between rows u and v
+	degree []int   // total weight at every row
+	total  int     // sum of degree, twice the total edge weight
+	parent []int32 // row every row was merged into, itself while alive
+	live   int     // number of alive rows
+
+	up    *cutMatrix // matrix this one was cloned from, nil for the vertices of g
+	remap []int32    // row of this matrix for every alive row of up
+}
+
+// newCutMatrix builds the matrix of g with one row per vertex, in ascending ID order
+// Self-loops are dropped and parallel edges summed
+func newCutMatrix(g *Graph, ids []int) *cutMatrix {
+	m := newEmptyCutMatrix(len(ids))
+	k := m.k
+	index := make(map[int]int, k)
+	for i, id := range ids {
+		index[id] = i
+	}
+	for _, e := range g.Edges {
+		u, v := index[e.From.ID], index[e.To.ID]
+		if u == v {
+			continue
+		}
+		m.w[u*k+v] += e.Weight
+		m.w[v*k+u] += e.Weight
+		m.degree[u] += e.Weight
+		m.degree[v] += e.Weight
+		m.total += 2 * e.Weight
+	}
+	return m
+}
+
+func newEmptyCutMatrix(k int) *cutMatrix {
+	m := new(cutMatrix)
+	m.reset(k)
+	return m
+}
+
+// reset makes m an edgeless matrix of k rows, reusing its storage
+func (m *cutMatrix) reset(k int) {
+	if cap(m.parent) < k {
+		m.w = make([]int, k*k)
+		m.degree = make([]int, k)
+		m.parent = make([]int32, k)
+	}
+	m.k, m.live, m.total = k, k, 0
+	m.w = m.w[:k*k]
+	m.degree = m.degree[:k]
+	m.parent = m.parent[:k]
+	clear(m.w)
+	clear(m.degree)
+	for i := range m.parent {
+		m.parent[i] = int32(i)
+	}
+}
+
+// find returns the alive row that row u was merged into
+func (m *cutMatrix) find(u int) int {
+	for int(m.parent[u]) != u {
+		m.parent[u] = m.parent[m.parent[u]]
+		u = int(m.parent[u])
+	}
+	return u
+}
+
+// cloneInto makes c an independent copy of m holding only its alive rows
+// c must not be m or one of its ancestors
+func (m *cutMatrix) cloneInto(c *cutMatrix) {
+	c.reset(m.live)
+	c.total = m.total
+	c.up = m
+	if cap(c.remap) < m.k {
+		c.remap = make([]int32, m.k)
+	}
+	c.remap = c.remap[:m.k]
+	i := 0
+	for u := range m.k {
+		if m.find(u) != u {
+			continue
+		}
+		c.remap[u] = int32(i)
+		c.degree[i] = m.degree[u]
+		j := 0
+		for v := range m.k {
+			if m.parent[v] == int32(v) {
+				c.w[i*c.k+j] = m.w[u*m.k+v]
+				j++
+			}
+		}
+		i++
+	}
+}
+
+// contractRandom contracts edges picked with probability proportional to
+// their weight until target super-vertices remain
+func (m *cutMatrix) contractRandom(target int, rng *rand.Rand) {
+	for m.live > target && m.total > 0 {
+		// Pick an endpoint by weighted degree, then a neighbor by edge weight
+		r := rng.IntN(m.total)
+		u := 0
+		for ; r >= m.degree[u]; u++ {
+			r -= m.degree[u]
+		}
+		row := m.w[u*m.k : (u+1)*m.k]
+		v := 0
+		for ; r >= row[v]; v++ {
+			r -= row[v]
+		}
+		m.contract(u, v)
+	}
+}
+
+// contract merges row v into row u
+func (m *cutMatrix) contract(u, v int) {
+	k := m.k
+	between := m.w[u*k+v]
+	for x := range k {
+		if x == u || x == v {
+			continue
+		}
+		if wx := m.w[v*k+x]; wx != 0 {
+			m.w[u*k+x] += wx
+			m.w[x*k+u] += wx
+			m.w[x*k+v] = 0
+			m.w[v*k+x] = 0
+		}
+	}
+	m.w[u*k+v], m.w[v*k+u] = 0, 0
+	m.degree[u] += m.degree[v] - 2*between
+	m.degree[v] = 0
+	m.total -= 2 * between
+	m.parent[v] = int32(u)
+	m.live--
+}
+
+// rowOf returns the alive row of m standing for vertex i of g
+func (m *cutMatrix) rowOf(i int) int {
+	if m.up == nil {
+		return m.find(i)
+	}
+	return m.find(int(m.remap[m.up.rowOf(i)]))
+}
+
+// steinSize is the size Karger-Stein contracts a graph of n vertices to
+func steinSize(n int) int {
+	return int(math.Ceil(1 + float64(n)/math.Sqrt2))
+}
+
+// kargerStein contracts two copies of m to steinSize super-vertices and
+// recurses on both, recording any cut lighter than best
+// The copies are made in spare, one matrix per recursion depth, since a
+// depth-first search only ever holds one branch per depth
+func kargerStein(m *cutMatrix, rng *rand.Rand, best *contractedCut, spare []*cutMatrix) {
+	if m.live <= steinBase {
+		m.bestCut(best)
+		return
+	}
+	branch := spare[0]
+	for range 2 {
+		m.cloneInto(branch)
+		branch.contractRandom(steinSize(m.live), rng)
+		kargerStein(branch, rng, best, spare[1:])
+	}
+}
+
+// bestCut tries every split of the few alive rows into two sides and
+// records the lightest in best if it beats it
+func (m *cutMatrix) bestCut(best *contractedCut) {
+	var buf [steinBase]int
+	rows := buf[:0]
+	for u := range m.k {
+		if m.find(u) == u {
+			rows = append(rows, u)
+		}
+	}
+
+	// Row 0 stays on the side of bit 1 so that each split is tried once;
+	// masks follow a Gray code, moving one row across per step
+	weight := 0
+	for _, v := range rows[1:] {
+		weight += m.w[rows[0]*m.k+v]
+	}
+	mask, full := 1, 1<<len(rows)-1
+	lightest, bestMask := weight, mask
+	for t := 1; t < 1<<(len(rows)-1); t++ {
+		i := bits.TrailingZeros(uint(t)) + 1
+		u := rows[i]
+		for j, v := range rows {
+			if j == i {
+				continue
+			}
+			if (mask>>i)&1 == (mask>>j)&1 {
+				weight += m.w[u*m.k+v]
+			} else {
+				weight -= m.w[u*m.k+v]
+			}
+		}
+		mask ^= 1 << i
+		if mask != full && weight < lightest {
+			lightest, bestMask = weight, mask
+		}
+	}
+	if lightest >= best.weight {
+		return
+	}
+
+	onSide := make([]bool, m.k)
+	for i, u := range rows {
+		onSide[u] = (bestMask>>i)&1 == 1
+	}
+	best.weight = lightest
+	best.side = make([]bool, len(best.side))
+	for i := range best.side {
+		best.side[i] = onSide[m.rowOf(i)]
+	}
+}
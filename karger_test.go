@@ -0,0 +1,132 @@
+package mst
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"reflect"
+	"testing"
+)
+
+// bruteForceMinCut tries every split of a small graph
+func bruteForceMinCut(g *Graph) int {
+	ids := g.SortedVertexIDs()
+	best := -1
+	for mask := 1; mask < 1<<len(ids)-1; mask += 2 {
+		side := make(map[int]bool)
+		for i, id := range ids {
+			side[id] = mask>>i&1 == 1
+		}
+		weight := 0
+		for _, e := range g.Edges {
+			if side[e.From.ID] != side[e.To.ID] {
+				weight += e.Weight
+			}
+		}
+		if best == -1 || weight < best {
+			best = weight
+		}
+	}
+	return best
+}
+
+// TestKargerMinCut tests that two heavy cliques split along their links
+func TestKargerMinCut(t *testing.T) {
+	fmt.Println("\n=== KARGER MIN-CUT TEST ===")
+
+	g := NewGraph(false)
+	for _, base := range []int{0, 5} {
+		for i := base; i < base+5; i++ {
+			for j := i + 1; j < base+5; j++ {
+				g.AddEdge(Edge{From: &Vertex{ID: i}, To: &Vertex{ID: j}, Weight: 4})
+			}
+		}
+	}
+	g.AddEdge(Edge{From: &Vertex{ID: 4}, To: &Vertex{ID: 5}, Weight: 1})
+	g.AddEdge(Edge{From: &Vertex{ID: 3}, To: &Vertex{ID: 6}, Weight: 2})
+
+	for _, stein := range []bool{false, true} {
+		cut, err := g.KargerMinCut(KargerConfig{Stein: stein, Seed: 11})
+		if err != nil {
+			t.Fatal(err)
+		}
+		fmt.Printf("Stein %v: weight %d, side %v, %d trials, confidence %.3f\n",
+			stein, cut.Weight, cut.Side, cut.Trials, cut.Confidence)
+		if cut.Weight != 3 || !reflect.DeepEqual(cut.Side, []int{0, 1, 2, 3, 4}) {
+			t.Errorf("Stein %v: expected the two links between the cliques, got %d with %v", stein, cut.Weight, cut.Side)
+		}
+		if len(cut.Edges) != 2 || cut.Edges[0].Weight != 1 || cut.Edges[1].Weight != 2 {
+			t.Errorf("Stein %v: expected both links in g.Edges order, got %v", stein, cut.Edges)
+		}
+		if cut.Confidence < 0.99 {
+			t.Errorf("Stein %v: expected the default confidence, got %v", stein, cut.Confidence)
+		}
+	}
+	if g.VertexCount() != 10 || g.EdgeCount() != 22 {
+		t.Error("Original graph must not change")
+	}
+
+	// A fixed number of trials reports the confidence it reached
+	cut, _ := g.KargerMinCut(KargerConfig{Trials: 3, Seed: 1})
+	if cut.Trials != 3 || cut.Confidence <= 0 || cut.Confidence >= 0.99 {
+		t.Errorf("Expected 3 trials with low confidence, got %d and %v", cut.Trials, cut.Confidence)
+	}
+}
+
+// TestKargerMinCutRandom tests random graphs against every possible cut
+func TestKargerMinCutRandom(t *testing.T) {
+	fmt.Println("\n=== RANDOM KARGER MIN-CUT TEST ===")
+
+	rng := rand.New(rand.NewPCG(8, 1))
+	for trial := range 10 {
+		g := NewGraph(false)
+		for i := 1; i < 9; i++ {
+			g.AddEdge(Edge{From: &Vertex{ID: rng.IntN(i)}, To: &Vertex{ID: i}, Weight: 1 + rng.IntN(5)})
+		}
+		for range 10 {
+			u, v := rng.IntN(9), rng.IntN(9)
+			if u != v {
+				g.AddEdge(Edge{From: &Vertex{ID: u}, To: &Vertex{ID: v}, Weight: 1 + rng.IntN(5)})
+			}
+		}
+		want := bruteForceMinCut(&g)
+		cut, err := g.KargerMinCut(KargerConfig{Stein: trial%2 == 0, Confidence: 0.999, Seed: uint64(trial + 1)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if cut.Weight != want {
+			t.Errorf("Trial %d: expected min cut %d, got %d", trial, want, cut.Weight)
+		}
+		total := 0
+		for _, e := range cut.Edges {
+			total += e.Weight
+		}
+		if total != cut.Weight {
+			t.Errorf("Trial %d: cut edges weigh %d, reported %d", trial, total, cut.Weight)
+		}
+	}
+}
+
+// TestKargerMinCutEdgeCases tests disconnected graphs and bad input
+func TestKargerMinCutEdgeCases(t *testing.T) {
+	fmt.Println("\n=== KARGER MIN-CUT EDGE CASES TEST ===")
+
+	g := NewGraph(false)
+	g.AddEdge(Edge{From: &Vertex{ID: 0}, To: &Vertex{ID: 1}, Weight: 3})
+	g.AddEdge(Edge{From: &Vertex{ID: 2}, To: &Vertex{ID: 3}, Weight: 3})
+	cut, err := g.KargerMinCut(KargerConfig{})
+	if err != nil || cut.Weight != 0 || cut.Confidence != 1 || !reflect.DeepEqual(cut.Side, []int{0, 1}) {
+		t.Errorf("Expected an empty cut around the first component, got %+v, %v", cut, err)
+	}
+
+	g.AddEdge(Edge{From: &Vertex{ID: 1}, To: &Vertex{ID: 2}, Weight: 0})
+	if _, err := g.KargerMinCut(KargerConfig{}); !errors.Is(err, ErrNonPositiveWeight) {
+		t.Errorf("Expected ErrNonPositiveWeight, got %v", err)
+	}
+
+	single := NewGraph(false)
+	single.AddVertex(Vertex{ID: 1})
+	if _, err := single.KargerMinCut(KargerConfig{}); !errors.Is(err, ErrTooFewVertices) {
+		t.Errorf("Expected ErrTooFewVertices, got %v", err)
+	}
+}
@@ -0,0 +1,257 @@
+package mst
+
+import (
+	"container/heap"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+)
+
+// ==================== K-NEAREST-NEIGHBOR GRAPHS ====================
+
+// ErrDimensionMismatch is returned when points do not all have the same
+// number of coordinates
+var ErrDimensionMismatch = errors.New("points have different dimensions")
+
+// Metric identifies a distance between points
+// Every metric is at least the difference in any single coordinate, which
+// lets the k-d tree skip subtrees beyond a splitting plane
+type Metric int
+
+const (
+	// Euclidean is the straight-line (L2) distance
+	Euclidean Metric = iota
+	// Manhattan is the sum of coordinate differences (L1)
+	Manhattan
+	// Chebyshev is the largest coordinate difference (L∞)
+	Chebyshev
+)
+
+func (m Metric) String() string {
+	switch m {
+	case Euclidean:
+		return "euclidean"
+	case Manhattan:
+		return "manhattan"
+	case Chebyshev:
+		return "chebyshev"
+	default:
+		return fmt.Sprintf("Metric(%d)", int(m))
+	}
+}
+
+// Distance returns the distance between two points of equal dimension
+func (m Metric) Distance(a, b []float64) float64 {
+	d := 0.0
+	for i := range a {
+		diff := math.Abs(a[i] - b[i])
+		switch m {
+		case Manhattan:
+			d += diff
+		case Chebyshev:
+			d = max(d, diff)
+		default:
+			d += diff * diff
+		}
+	}
+	if m == Euclidean {
+		return math.Sqrt(d)
+	}
+	return d
+}
+
+// KNNGraph builds the undirected k-nearest-neighbor graph of a point set
+// Vertex i is points[i], joined to each of its k nearest other points and
+// to every point that has it among its own k nearest. Neighbors are found
+// with a k-d tree in about O(k·n log n) for low dimensions; equal distances
+// prefer the lower index. Edge.Data holds the float64 distance, for
+// KruskalFloat with DataWeight, and Edge.Weight the rank of that distance
+// among all edges from 1, so Kruskal, Dendrogram, and CutHeaviest work on
+// the graph directly and find the same trees and clusters
+func KNNGraph(points [][]float64, k int, metric Metric) (Graph, error) {
+	if k < 1 {
+		return NewGraph(false), fmt.Errorf("k must be positive, got %d", k)
+	}
+	if err := checkDimensions(points); err != nil {
+		return NewGraph(false), err
+	}
+
+	tree := newKDTree(points, metric)
+	type pair struct{ a, b int }
+	dist := make(map[pair]float64)
+	for i := range points {
+		for _, nb := range tree.nearest(points[i], k, i) {
+			dist[pair{min(i, nb.point), max(i, nb.point)}] = nb.dist
+		}
+	}
+	edges := make([]pointEdge, 0, len(dist))
+	for p, d := range dist {
+		edges = append(edges, pointEdge{a: p.a, b: p.b, dist: d})
+	}
+	return newPointGraph(len(points), edges), nil
+}
+
+// checkDimensions reports whether all points have the same, nonzero
+// number of coordinates
+func checkDimensions(points [][]float64) error {
+	if len(points) > 0 && len(points[0]) == 0 {
+		return fmt.Errorf("points have no coordinates: %w", ErrDimensionMismatch)
+	}
+	for i, p := range points {
+		if len(p) != len(points[0]) {
+			return fmt.Errorf("point %d has %d coordinates, point 0 has %d: %w", i, len(p), len(points[0]), ErrDimensionMismatch)
+		}
+	}
+	return nil
+}
+
+// pointEdge joins points a < b at a distance
+type pointEdge struct {
+	a, b int
+	dist float64
+}
+
+// sortPointEdges orders edges by distance, then by endpoints
+func sortPointEdges(edges []pointEdge) {
+	slices.SortFunc(edges, func(x, y pointEdge) int {
+		if c := cmpFloat(x.dist, y.dist); c != 0 {
+			return c
+		}
+		if x.a != y.a {
+			return x.a - y.a
+		}
+		return x.b - y.b
+	})
+}
+
+// newPointGraph builds a graph with vertices 0 to n-1 and the edges, with
+// the distance in Data and its rank in Weight
+func newPointGraph(n int, edges []pointEdge) Graph {
+	sortPointEdges(edges)
+	g := NewGraph(false)
+	vertices := make([]*Vertex, n)
+	for i := range n {
+		vertices[i] = g.AddVertex(Vertex{ID: i})
+	}
+	batch := make([]Edge, len(edges))
+	rank := 0
+	for i, e := range edges {
+		if i == 0 || e.dist != edges[i-1].dist {
+			rank++
+		}
+		batch[i] = Edge{From: vertices[e.a], To: vertices[e.b], Weight: rank, Data: e.dist}
+	}
+	g.AddEdges(batch)
+	return g
+}
+
+// kdTree indexes points for nearest-neighbor queries
+// order is laid out as an implicit tree over a permutation of the points:
+// the median of every range is its root, splitting on axis depth % dim
+type kdTree struct {
+	points [][]float64
+	order  []int // point indices, each range's median being its root
+	metric Metric
+}
+
+func newKDTree(points [][]float64, metric Metric) *kdTree {
+	t := &kdTree{points: points, order: make([]int, len(points)), metric: metric}
+	for i := range t.order {
+		t.order[i] = i
+	}
+	t.build(t.order, 0)
+	return t
+}
+
+// build orders a range so that its median splits it on the depth's axis,
+// then builds both halves
+func (t *kdTree) build(order []int, depth int) {
+	if len(order) <= 1 {
+		return
+	}
+	axis := depth % len(t.points[order[0]])
+	slices.SortFunc(order, func(a, b int) int {
+		return cmpFloat(t.points[a][axis], t.points[b][axis])
+	})
+	mid := len(order) / 2
+	t.build(order[:mid], depth+1)
+	t.build(order[mid+1:], depth+1)
+}
+
+func cmpFloat(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// neighbor is a point found by a query with its distance
+type neighbor struct {
+	point int
+	dist  float64
+}
+
+// closer orders neighbors by distance, then by index
+func closer(a, b neighbor) bool {
+	if a.dist != b.dist {
+		return a.dist < b.dist
+	}
+	return a.point < b.point
+}
+
+// farthestFirst is a max-heap of the best neighbors found so far
+type farthestFirst []neighbor
+
+func (h farthestFirst) Len() int           { return len(h) }
+func (h farthestFirst) Less(i, j int) bool { return closer(h[j], h[i]) }
+func (h farthestFirst) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *farthestFirst) Push(x any)        { *h = append(*h, x.(neighbor)) }
+func (h *farthestFirst) Pop() any {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
+
+// nearest returns the k points closest to q other than skip, nearest first
+func (t *kdTree) nearest(q []float64, k, skip int) []neighbor {
+	best := make(farthestFirst, 0, k+1)
+	t.search(t.order, 0, q, k, skip, &best)
+	sort.Sort(sort.Reverse(best))
+	return best
+}
+
+func (t *kdTree) search(order []int, depth int, q []float64, k, skip int, best *farthestFirst) {
+	if len(order) == 0 {
+		return
+	}
+	mid := len(order) / 2
+	p := order[mid]
+	if p != skip {
+		nb := neighbor{point: p, dist: t.metric.Distance(q, t.points[p])}
+		if best.Len() < k || closer(nb, (*best)[0]) {
+			heap.Push(best, nb)
+			if best.Len() > k {
+				heap.Pop(best)
+			}
+		}
+	}
+
+	axis := depth % len(q)
+	diff := q[axis] - t.points[p][axis]
+	near, far := order[:mid], order[mid+1:]
+	if diff > 0 {
+		near, far = far, near
+	}
+	t.search(near, depth+1, q, k, skip, best)
+	// Points across the plane are at least |diff| away; ties still count
+	if best.Len() < k || math.Abs(diff) <= (*best)[0].dist {
+		t.search(far, depth+1, q, k, skip, best)
+	}
+}
@@ -0,0 +1,122 @@
+package mst
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+// randomPoints returns n reproducible points with coordinates on a coarse
+// grid, so that equal distances occur
+func randomPoints(n, dim int, seed int64) [][]float64 {
+	rng := rand.New(rand.NewSource(seed))
+	points := make([][]float64, n)
+	for i := range points {
+		points[i] = make([]float64, dim)
+		for j := range points[i] {
+			points[i][j] = float64(rng.Intn(20)) / 2
+		}
+	}
+	return points
+}
+
+// bruteNearest returns the k nearest other points by a full scan
+func bruteNearest(points [][]float64, i, k int, metric Metric) []neighbor {
+	all := make([]neighbor, 0, len(points))
+	for j := range points {
+		if j != i {
+			all = append(all, neighbor{point: j, dist: metric.Distance(points[i], points[j])})
+		}
+	}
+	sort.Slice(all, func(a, b int) bool { return closer(all[a], all[b]) })
+	return all[:min(k, len(all))]
+}
+
+// TestKDTreeNearest tests k-d tree queries against a full scan
+func TestKDTreeNearest(t *testing.T) {
+	fmt.Println("\n=== K-D TREE TEST ===")
+
+	for _, metric := range []Metric{Euclidean, Manhattan, Chebyshev} {
+		for _, dim := range []int{1, 2, 3} {
+			points := randomPoints(200, dim, int64(dim))
+			tree := newKDTree(points, metric)
+			for i := range points {
+				got, want := tree.nearest(points[i], 5, i), bruteNearest(points, i, 5, metric)
+				if fmt.Sprint(got) != fmt.Sprint(want) {
+					t.Fatalf("%v, %d dimensions, point %d: expected %v, got %v", metric, dim, i, want, got)
+				}
+			}
+		}
+	}
+}
+
+// TestKNNGraph tests the neighbor graph and its MST
+func TestKNNGraph(t *testing.T) {
+	fmt.Println("\n=== KNN GRAPH TEST ===")
+
+	points := randomPoints(300, 2, 7)
+	g, err := KNNGraph(points, 4, Euclidean)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g.VertexCount() != len(points) {
+		t.Fatalf("Expected %d vertices, got %d", len(points), g.VertexCount())
+	}
+	for i := range points {
+		for _, nb := range bruteNearest(points, i, 4, Euclidean) {
+			e, ok := g.GetEdge(i, nb.point)
+			if !ok {
+				t.Fatalf("Expected an edge from %d to its neighbor %d", i, nb.point)
+			}
+			if e.Data.(float64) != nb.dist {
+				t.Errorf("Edge %d-%d: expected distance %v, got %v", i, nb.point, nb.dist, e.Data)
+			}
+		}
+	}
+
+	// Ranks order edges exactly like the distances
+	for _, a := range g.Edges {
+		for _, b := range g.Edges[:10] {
+			if (a.Weight < b.Weight) != (a.Data.(float64) < b.Data.(float64)) {
+				t.Fatalf("Rank %d vs %d disagrees with distance %v vs %v", a.Weight, b.Weight, a.Data, b.Data)
+			}
+		}
+	}
+	_, exact := g.KruskalFloat(DataWeight)
+	ranked, _ := g.Kruskal()
+	total := 0.0
+	for _, e := range ranked {
+		total += e.Data.(float64)
+	}
+	fmt.Printf("%d edges, MST length %.3f\n", g.EdgeCount(), total)
+	if math.Abs(total-exact) > 1e-9 {
+		t.Errorf("Expected the ranked MST length %v to equal the float MST %v", total, exact)
+	}
+
+	// k beyond the point count gives the complete graph
+	g, _ = KNNGraph(points[:6], 10, Manhattan)
+	if g.EdgeCount() != 15 {
+		t.Errorf("Expected 15 edges in the complete graph, got %d", g.EdgeCount())
+	}
+}
+
+// TestKNNGraphErrors tests invalid input
+func TestKNNGraphErrors(t *testing.T) {
+	fmt.Println("\n=== KNN GRAPH ERRORS TEST ===")
+
+	if _, err := KNNGraph([][]float64{{0, 0}, {1}}, 1, Euclidean); !errors.Is(err, ErrDimensionMismatch) {
+		t.Errorf("Expected ErrDimensionMismatch, got %v", err)
+	}
+	if _, err := KNNGraph([][]float64{{}, {}}, 1, Euclidean); !errors.Is(err, ErrDimensionMismatch) {
+		t.Errorf("Expected ErrDimensionMismatch for empty points, got %v", err)
+	}
+	if _, err := KNNGraph([][]float64{{0}}, 0, Euclidean); err == nil {
+		t.Error("Expected an error for k = 0")
+	}
+	if g, err := KNNGraph(nil, 3, Euclidean); err != nil || g.VertexCount() != 0 {
+		t.Errorf("Expected an empty graph, got %d vertices, %v", g.VertexCount(), err)
+	}
+}
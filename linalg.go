@@ -0,0 +1,53 @@
+package mst
+
+import "math/big"
+
+// ==================== EXACT LINEAR ALGEBRA ====================
+
+// bigDeterminant computes the determinant of a square integer matrix exactly
+// using fraction-free Bareiss elimination, which keeps every intermediate
+// value an integer. The matrix is modified in place
+func bigDeterminant(m [][]*big.Int) *big.Int {
+	n := len(m)
+	if n == 0 {
+		return big.NewInt(1)
+	}
+
+	sign := 1
+	prev := big.NewInt(1)
+	tmp := new(big.Int)
+	for k := 0; k < n-1; k++ {
+		// Find a non-zero pivot, swapping rows if needed
+		if m[k][k].Sign() == 0 {
+			swap := -1
+			for i := k + 1; i < n; i++ {
+				if m[i][k].Sign() != 0 {
+					swap = i
+					break
+				}
+			}
+			if swap == -1 {
+				return big.NewInt(0)
+			}
+			m[k], m[swap] = m[swap], m[k]
+			sign = -sign
+		}
+
+		for i := k + 1; i < n; i++ {
+			for j := k + 1; j < n; j++ {
+				// m[i][j] = (m[i][j]*m[k][k] - m[i][k]*m[k][j]) / prev
+				m[i][j].Mul(m[i][j], m[k][k])
+				tmp.Mul(m[i][k], m[k][j])
+				m[i][j].Sub(m[i][j], tmp)
+				m[i][j].Quo(m[i][j], prev)
+			}
+		}
+		prev = m[k][k]
+	}
+
+	det := new(big.Int).Set(m[n-1][n-1])
+	if sign < 0 {
+		det.Neg(det)
+	}
+	return det
+}
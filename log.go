@@ -0,0 +1,80 @@
+package mst
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// ==================== STRUCTURED LOGGING ====================
+
+// WithLogger makes the algorithm log to l: the input size when it starts
+// (debug), the result when it finishes (info), and warnings for duplicate
+// edges and disconnected graphs
+func WithLogger(l *slog.Logger) Option {
+	return func(o *options) {
+		o.logger = l
+	}
+}
+
+// logStart logs the start of a run and warns about parallel edges
+// It returns the start time for logFinish
+func (o *options) logStart(algorithm string, g *Graph) time.Time {
+	if o.logger == nil {
+		return time.Time{}
+	}
+	ctx := context.Background()
+	o.logger.LogAttrs(ctx, slog.LevelDebug, "mst started",
+		slog.String("algorithm", algorithm),
+		slog.Int("vertices", g.VertexCount()),
+		slog.Int("edges", g.EdgeCount()),
+	)
+
+	if o.logger.Enabled(ctx, slog.LevelWarn) {
+		if n := g.duplicateEdgeCount(); n > 0 {
+			o.logger.LogAttrs(ctx, slog.LevelWarn, "graph has duplicate edges",
+				slog.String("algorithm", algorithm),
+				slog.Int("duplicates", n),
+			)
+		}
+	}
+	return time.Now()
+}
+
+// logFinish logs the result of a run and warns if it did not span the graph
+func (o *options) logFinish(algorithm string, g *Graph, tree []*Edge, weight int, disconnected bool, start time.Time) {
+	if o.logger == nil {
+		return
+	}
+	ctx := context.Background()
+	if disconnected {
+		o.logger.LogAttrs(ctx, slog.LevelWarn, "graph is disconnected",
+			slog.String("algorithm", algorithm),
+			slog.Int("vertices", g.VertexCount()),
+			slog.Int("tree_edges", len(tree)),
+		)
+	}
+	o.logger.LogAttrs(ctx, slog.LevelInfo, "mst finished",
+		slog.String("algorithm", algorithm),
+		slog.Int("tree_edges", len(tree)),
+		slog.Int("weight", weight),
+		slog.Duration("elapsed", time.Since(start)),
+	)
+}
+
+// duplicateEdgeCount counts edges that repeat an earlier edge's endpoints
+func (g *Graph) duplicateEdgeCount() int {
+	seen := make(map[arcKey]bool, len(g.Edges))
+	duplicates := 0
+	for _, e := range g.Edges {
+		key := arcKey{from: e.From.ID, to: e.To.ID}
+		if !g.Directed && key.from > key.to {
+			key.from, key.to = key.to, key.from
+		}
+		if seen[key] {
+			duplicates++
+		}
+		seen[key] = true
+	}
+	return duplicates
+}
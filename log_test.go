@@ -0,0 +1,42 @@
+package mst
+
+import (
+	"bytes"
+	"fmt"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+// TestWithLogger tests structured logs emitted by the algorithms
+func TestWithLogger(t *testing.T) {
+	fmt.Println("\n=== STRUCTURED LOGGING TEST ===")
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	g := NewGraph(false)
+	v := make([]Vertex, 4)
+	for i := range v {
+		v[i] = Vertex{ID: i}
+	}
+	g.AddEdge(Edge{From: &v[0], To: &v[1], Weight: 1})
+	g.AddEdge(Edge{From: &v[1], To: &v[0], Weight: 2}) // duplicate
+	g.AddEdge(Edge{From: &v[2], To: &v[3], Weight: 3}) // second component
+
+	g.Kruskal(WithLogger(logger))
+	g.Prim(0, WithLogger(logger))
+	fmt.Print(buf.String())
+
+	out := buf.String()
+	for _, want := range []string{
+		`"msg":"mst started","algorithm":"kruskal","vertices":4,"edges":3`,
+		`"msg":"graph has duplicate edges","algorithm":"kruskal","duplicates":1`,
+		`"msg":"graph is disconnected","algorithm":"prim"`,
+		`"msg":"mst finished","algorithm":"kruskal","tree_edges":2,"weight":4`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected log output to contain %s", want)
+		}
+	}
+}
@@ -0,0 +1,173 @@
+package mst
+
+import (
+	"errors"
+	"math"
+)
+
+// ==================== BIPARTITE MATCHING ====================
+
+// ErrNotBipartite is returned when the vertices cannot be split into two sides
+var ErrNotBipartite = errors.New("graph is not bipartite")
+
+// Bipartition splits the vertices into two sides with every edge between
+// them, by 2-coloring each component from its smallest vertex ID
+// ok is false if some cycle has odd length
+func (g *Graph) Bipartition() (left, right []int, ok bool) {
+	adj := make(map[int][]int, len(g.Vertices))
+	for _, e := range g.Edges {
+		adj[e.From.ID] = append(adj[e.From.ID], e.To.ID)
+		adj[e.To.ID] = append(adj[e.To.ID], e.From.ID)
+	}
+
+	side := make(map[int]int, len(g.Vertices))
+	for _, root := range g.SortedVertexIDs() {
+		if _, seen := side[root]; seen {
+			continue
+		}
+		side[root] = 0
+		queue := []int{root}
+		for len(queue) > 0 {
+			v := queue[0]
+			queue = queue[1:]
+			for _, w := range adj[v] {
+				if s, seen := side[w]; !seen {
+					side[w] = 1 - side[v]
+					queue = append(queue, w)
+				} else if s == side[v] {
+					return nil, nil, false
+				}
+			}
+		}
+	}
+
+	for _, id := range g.SortedVertexIDs() {
+		if side[id] == 0 {
+			left = append(left, id)
+		} else {
+			right = append(right, id)
+		}
+	}
+	return left, right, true
+}
+
+// MaxWeightMatching returns a set of edges with no shared endpoint whose
+// total weight is as large as possible, together with that weight
+// The graph must be bipartite; it is solved with the Hungarian algorithm in
+// O(V³). Edges with non-positive weight never improve the total and are not
+// used, and among parallel edges only the heaviest is considered
+// Matching on general graphs (Edmonds' blossom algorithm) is not supported
+func (g *Graph) MaxWeightMatching() ([]*Edge, int, error) {
+	left, right, ok := g.Bipartition()
+	if !ok {
+		return nil, 0, ErrNotBipartite
+	}
+
+	row := make(map[int]int, len(left))
+	for i, id := range left {
+		row[id] = i
+	}
+	col := make(map[int]int, len(right))
+	for j, id := range right {
+		col[id] = j
+	}
+
+	// Square profit matrix, padded with zero-profit dummy rows and columns
+	n := max(len(left), len(right))
+	best := make([][]*Edge, n)
+	for i := range best {
+		best[i] = make([]*Edge, n)
+	}
+	for _, e := range g.Edges {
+		if e.Weight <= 0 {
+			continue
+		}
+		u, v := e.From.ID, e.To.ID
+		if _, isLeft := row[u]; !isLeft {
+			u, v = v, u
+		}
+		i, j := row[u], col[v]
+		if best[i][j] == nil || e.Weight > best[i][j].Weight {
+			best[i][j] = e
+		}
+	}
+	cost := func(i, j int) int64 {
+		if e := best[i][j]; e != nil {
+			return -int64(e.Weight)
+		}
+		return 0
+	}
+
+	assignment := hungarian(n, cost)
+
+	matching := make([]*Edge, 0)
+	total := 0
+	for i, j := range assignment {
+		if e := best[i][j]; e != nil {
+			matching = append(matching, e)
+			total += e.Weight
+		}
+	}
+	return matching, total, nil
+}
+
+// hungarian solves the n×n minimum-cost assignment problem with potentials
+// and returns the column assigned to each row
+func hungarian(n int, cost func(i, j int) int64) []int {
+	const inf = math.MaxInt64 / 4
+	// 1-indexed: u, v are row and column potentials, p[j] is the row matched to column j
+	u := make([]int64, n+1)
+	v := make([]int64, n+1)
+	p := make([]int, n+1)
+	way := make([]int, n+1)
+
+	for i := 1; i <= n; i++ {
+		p[0] = i
+		j0 := 0
+		minv := make([]int64, n+1)
+		used := make([]bool, n+1)
+		for j := range minv {
+			minv[j] = inf
+		}
+		for {
+			used[j0] = true
+			i0, delta, j1 := p[j0], int64(inf), 0
+			for j := 1; j <= n; j++ {
+				if used[j] {
+					continue
+				}
+				if cur := cost(i0-1, j-1) - u[i0] - v[j]; cur < minv[j] {
+					minv[j] = cur
+					way[j] = j0
+				}
+				if minv[j] < delta {
+					delta = minv[j]
+					j1 = j
+				}
+			}
+			for j := 0; j <= n; j++ {
+				if used[j] {
+					u[p[j]] += delta
+					v[j] -= delta
+				} else {
+					minv[j] -= delta
+				}
+			}
+			j0 = j1
+			if p[j0] == 0 {
+				break
+			}
+		}
+		for j0 != 0 {
+			j1 := way[j0]
+			p[j0] = p[j1]
+			j0 = j1
+		}
+	}
+
+	assignment := make([]int, n)
+	for j := 1; j <= n; j++ {
+		assignment[p[j]-1] = j - 1
+	}
+	return assignment
+}
@@ -0,0 +1,54 @@
+package mst
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// TestMaxWeightMatching tests the Hungarian algorithm on an assignment problem
+func TestMaxWeightMatching(t *testing.T) {
+	fmt.Println("\n=== MAXIMUM WEIGHT MATCHING TEST ===")
+
+	// Workers 0-2, jobs 10-13; greedy would pair 0-10 (9) and strand worker 1
+	g := NewGraph(false)
+	profit := [][3]int{
+		{0, 10, 9}, {0, 11, 8},
+		{1, 10, 8},
+		{2, 11, 5}, {2, 12, 1},
+		{2, 13, -4},
+	}
+	for _, p := range profit {
+		from, to := Vertex{ID: p[0]}, Vertex{ID: p[1]}
+		g.AddEdge(Edge{From: &from, To: &to, Weight: p[2]})
+	}
+
+	matching, total, err := g.MaxWeightMatching()
+	if err != nil {
+		t.Fatalf("MaxWeightMatching failed: %v", err)
+	}
+	for _, e := range matching {
+		fmt.Printf("  %d - %d (%d)\n", e.From.ID, e.To.ID, e.Weight)
+	}
+	// 0-11 (8) + 1-10 (8) + 2-12 (1) beats 0-10 (9) + 2-11 (5) + ...
+	if total != 17 || len(matching) != 3 {
+		t.Errorf("Expected 3 edges of total 17, got %d edges of %d", len(matching), total)
+	}
+
+	used := make(map[int]bool)
+	for _, e := range matching {
+		if used[e.From.ID] || used[e.To.ID] {
+			t.Errorf("Vertex reused by %v", e)
+		}
+		used[e.From.ID], used[e.To.ID] = true, true
+	}
+
+	triangle := NewGraph(false)
+	v := []Vertex{{ID: 0}, {ID: 1}, {ID: 2}}
+	triangle.AddEdge(Edge{From: &v[0], To: &v[1], Weight: 1})
+	triangle.AddEdge(Edge{From: &v[1], To: &v[2], Weight: 1})
+	triangle.AddEdge(Edge{From: &v[2], To: &v[0], Weight: 1})
+	if _, _, err := triangle.MaxWeightMatching(); !errors.Is(err, ErrNotBipartite) {
+		t.Errorf("Expected ErrNotBipartite, got %v", err)
+	}
+}
@@ -0,0 +1,161 @@
+package mst
+
+import (
+	"fmt"
+	"time"
+)
+
+// ==================== METRICS ====================
+
+// MetricCounter identifies an operation counted while an algorithm runs
+type MetricCounter int
+
+const (
+	// CounterEdgesScanned counts edges examined by the algorithm
+	CounterEdgesScanned MetricCounter = iota
+	// CounterHeapPushes counts priority queue insertions and decrease-key operations
+	CounterHeapPushes
+	// CounterHeapPops counts priority queue removals
+	CounterHeapPops
+	// CounterUnions counts Union-Find union attempts
+	CounterUnions
+	// CounterCyclesRejected counts edges discarded because they would close a cycle
+	CounterCyclesRejected
+
+	counterCount
+)
+
+func (c MetricCounter) String() string {
+	switch c {
+	case CounterEdgesScanned:
+		return "edges_scanned"
+	case CounterHeapPushes:
+		return "heap_pushes"
+	case CounterHeapPops:
+		return "heap_pops"
+	case CounterUnions:
+		return "unions"
+	case CounterCyclesRejected:
+		return "cycles_rejected"
+	default:
+		return fmt.Sprintf("MetricCounter(%d)", int(c))
+	}
+}
+
+// Metrics receives operation counts and phase timings from algorithm runs
+// Counters are accumulated locally and reported once per run, so a sink adds
+// no per-edge overhead. Kruskal reports the phases "sort" and "scan"; the
+// Prim variants report "grow"
+type Metrics interface {
+	AddCount(algorithm string, counter MetricCounter, delta int)
+	ObservePhase(algorithm string, phase string, d time.Duration)
+}
+
+// WithMetrics sends counters and phase timings of the run to m
+func WithMetrics(m Metrics) Option {
+	return func(o *options) {
+		o.metrics = m
+	}
+}
+
+// AlgorithmMetrics holds the totals recorded for one algorithm
+type AlgorithmMetrics struct {
+	Counts map[MetricCounter]int
+	Phases map[string]time.Duration
+}
+
+// MetricsRecorder is a Metrics sink that sums everything in memory per algorithm
+type MetricsRecorder struct {
+	Algorithms map[string]*AlgorithmMetrics
+}
+
+// NewMetricsRecorder creates an empty MetricsRecorder
+func NewMetricsRecorder() *MetricsRecorder {
+	return &MetricsRecorder{Algorithms: make(map[string]*AlgorithmMetrics)}
+}
+
+func (r *MetricsRecorder) algorithm(name string) *AlgorithmMetrics {
+	m, exists := r.Algorithms[name]
+	if !exists {
+		m = &AlgorithmMetrics{
+			Counts: make(map[MetricCounter]int),
+			Phases: make(map[string]time.Duration),
+		}
+		r.Algorithms[name] = m
+	}
+	return m
+}
+
+// AddCount adds delta to a counter of an algorithm
+func (r *MetricsRecorder) AddCount(algorithm string, counter MetricCounter, delta int) {
+	r.algorithm(algorithm).Counts[counter] += delta
+}
+
+// ObservePhase adds d to the time spent in a phase of an algorithm
+func (r *MetricsRecorder) ObservePhase(algorithm string, phase string, d time.Duration) {
+	r.algorithm(algorithm).Phases[phase] += d
+}
+
+// Count returns the recorded total of a counter
+func (r *MetricsRecorder) Count(algorithm string, counter MetricCounter) int {
+	if m, exists := r.Algorithms[algorithm]; exists {
+		return m.Counts[counter]
+	}
+	return 0
+}
+
+// Reset discards all recorded metrics
+func (r *MetricsRecorder) Reset() {
+	clear(r.Algorithms)
+}
+
+// runMetrics accumulates the metrics of a single run
+// A nil *runMetrics ignores every call, so algorithms need no checks
+type runMetrics struct {
+	sink       Metrics
+	algorithm  string
+	counts     [counterCount]int
+	phase      string
+	phaseStart time.Time
+}
+
+// startMetrics begins a run; it returns nil when no sink is configured
+func (o *options) startMetrics(algorithm string) *runMetrics {
+	if o.metrics == nil {
+		return nil
+	}
+	return &runMetrics{sink: o.metrics, algorithm: algorithm}
+}
+
+// count increments a counter
+func (m *runMetrics) count(c MetricCounter) {
+	if m != nil {
+		m.counts[c]++
+	}
+}
+
+// startPhase ends the current phase, if any, and starts timing a new one
+func (m *runMetrics) startPhase(phase string) {
+	if m == nil {
+		return
+	}
+	now := time.Now()
+	if m.phase != "" {
+		m.sink.ObservePhase(m.algorithm, m.phase, now.Sub(m.phaseStart))
+	}
+	m.phase = phase
+	m.phaseStart = now
+}
+
+// finish ends the current phase and reports the non-zero counters
+func (m *runMetrics) finish() {
+	if m == nil {
+		return
+	}
+	m.startPhase("")
+	for c, n := range m.counts {
+		if n != 0 {
+			m.sink.AddCount(m.algorithm, MetricCounter(c), n)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package mst
+
+import (
+	"fmt"
+	"testing"
+)
+
+// TestMetrics tests counters and phase timings reported by the algorithms
+func TestMetrics(t *testing.T) {
+	fmt.Println("\n=== METRICS TEST ===")
+
+	g := NewGraph(false)
+	v := make([]Vertex, 4)
+	for i := range v {
+		v[i] = Vertex{ID: i}
+	}
+	g.AddEdge(Edge{From: &v[0], To: &v[1], Weight: 1})
+	g.AddEdge(Edge{From: &v[1], To: &v[2], Weight: 2})
+	g.AddEdge(Edge{From: &v[0], To: &v[2], Weight: 3})
+	g.AddEdge(Edge{From: &v[2], To: &v[3], Weight: 4})
+
+	rec := NewMetricsRecorder()
+	g.Kruskal(WithMetrics(rec))
+	g.Prim(0, WithMetrics(rec))
+	g.PrimEager(0, WithMetrics(rec))
+
+	for name, m := range rec.Algorithms {
+		fmt.Printf("%s: %v %v\n", name, m.Counts, m.Phases)
+	}
+
+	// Kruskal scans all four edges and rejects 0-2
+	if n := rec.Count("kruskal", CounterEdgesScanned); n != 4 {
+		t.Errorf("Expected kruskal to scan 4 edges, got %d", n)
+	}
+	if n := rec.Count("kruskal", CounterCyclesRejected); n != 1 {
+		t.Errorf("Expected kruskal to reject 1 edge, got %d", n)
+	}
+	if _, ok := rec.Algorithms["kruskal"].Phases["sort"]; !ok {
+		t.Error("Expected a sort phase for kruskal")
+	}
+
+	if pushes, pops := rec.Count("prim", CounterHeapPushes), rec.Count("prim", CounterHeapPops); pops == 0 || pops > pushes {
+		t.Errorf("Expected 0 < pops <= pushes, got %d pops and %d pushes", pops, pushes)
+	}
+	if n := rec.Count("prim_eager", CounterHeapPops); n != 3 {
+		t.Errorf("Expected prim_eager to pop 3 vertices, got %d", n)
+	}
+
+	rec.Reset()
+	g.Kruskal()
+	if len(rec.Algorithms) != 0 {
+		t.Error("Expected no metrics without WithMetrics")
+	}
+}
@@ -0,0 +1,103 @@
+package mst
+
+import (
+	"math"
+	"math/rand/v2"
+	"sort"
+)
+
+// ==================== MONTE CARLO MST ====================
+
+// WeightSampler draws a random weight for an edge
+type WeightSampler func(edge *Edge, rng *rand.Rand) float64
+
+// NormalSampler draws weights from a normal distribution centred on
+// Edge.Weight with a standard deviation of spread·Weight, clipped at zero
+func NormalSampler(spread float64) WeightSampler {
+	return func(edge *Edge, rng *rand.Rand) float64 {
+		w := float64(edge.Weight)
+		return math.Max(0, w+rng.NormFloat64()*spread*math.Abs(w))
+	}
+}
+
+// UniformSampler draws weights uniformly from [Weight·(1-spread), Weight·(1+spread)]
+func UniformSampler(spread float64) WeightSampler {
+	return func(edge *Edge, rng *rand.Rand) float64 {
+		w := float64(edge.Weight)
+		return w + (2*rng.Float64()-1)*spread*math.Abs(w)
+	}
+}
+
+// MonteCarloResult summarizes the MSTs of many sampled weight assignments
+type MonteCarloResult struct {
+	Samples   int
+	Inclusion map[*Edge]float64 // fraction of samples whose MST contains the edge
+	Weights   []float64         // MST weight of every sample, sorted ascending
+	Mean      float64
+	StdDev    float64
+}
+
+// MonteCarloMST computes the MST of samples independent weight assignments
+// drawn by sampler and reports how often each edge of g.Edges is in the tree
+// and how the tree weight is distributed. A nil rng uses a randomly seeded one
+func (g *Graph) MonteCarloMST(samples int, sampler WeightSampler, rng *rand.Rand) MonteCarloResult {
+	if rng == nil {
+		rng = rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64()))
+	}
+
+	res := MonteCarloResult{
+		Samples:   samples,
+		Inclusion: make(map[*Edge]float64, len(g.Edges)),
+		Weights:   make([]float64, 0, samples),
+	}
+	if samples <= 0 {
+		return res
+	}
+
+	index := make(map[*Edge]int, len(g.Edges))
+	for i, edge := range g.Edges {
+		index[edge] = i
+	}
+	drawn := make([]float64, len(g.Edges))
+	weight := func(edge *Edge) float64 {
+		return drawn[index[edge]]
+	}
+
+	counts := make([]int, len(g.Edges))
+	for s := 0; s < samples; s++ {
+		for i, edge := range g.Edges {
+			drawn[i] = sampler(edge, rng)
+		}
+		mst, total := g.KruskalFloat(weight)
+		for _, edge := range mst {
+			counts[index[edge]]++
+		}
+		res.Weights = append(res.Weights, total)
+	}
+
+	for i, edge := range g.Edges {
+		res.Inclusion[edge] = float64(counts[i]) / float64(samples)
+	}
+
+	sort.Float64s(res.Weights)
+	sum := 0.0
+	for _, w := range res.Weights {
+		sum += w
+	}
+	res.Mean = sum / float64(samples)
+	variance := 0.0
+	for _, w := range res.Weights {
+		variance += (w - res.Mean) * (w - res.Mean)
+	}
+	res.StdDev = math.Sqrt(variance / float64(samples))
+	return res
+}
+
+// Quantile returns the q-quantile (0 ≤ q ≤ 1) of the sampled MST weights
+func (r MonteCarloResult) Quantile(q float64) float64 {
+	if len(r.Weights) == 0 {
+		return math.NaN()
+	}
+	q = math.Min(1, math.Max(0, q))
+	return r.Weights[int(math.Round(q*float64(len(r.Weights)-1)))]
+}
@@ -0,0 +1,44 @@
+package mst
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+// TestMonteCarloMST tests edge inclusion probabilities under noisy weights
+func TestMonteCarloMST(t *testing.T) {
+	fmt.Println("\n=== MONTE CARLO MST TEST ===")
+
+	g := NewGraph(false)
+	v := make([]Vertex, 3)
+	for i := range v {
+		v[i] = Vertex{ID: i}
+	}
+	ab := g.AddEdge(Edge{From: &v[0], To: &v[1], Weight: 1})
+	bc := g.AddEdge(Edge{From: &v[1], To: &v[2], Weight: 100})
+	ac := g.AddEdge(Edge{From: &v[0], To: &v[2], Weight: 100})
+
+	rng := rand.New(rand.NewPCG(1, 2))
+	res := g.MonteCarloMST(2000, UniformSampler(0.1), rng)
+	fmt.Printf("mean %.1f, p5 %.1f, p95 %.1f\n", res.Mean, res.Quantile(0.05), res.Quantile(0.95))
+
+	if res.Inclusion[ab] != 1 {
+		t.Errorf("Expected the cheap edge in every tree, got %.3f", res.Inclusion[ab])
+	}
+	// The two equal-cost edges should each win about half the time
+	if p := res.Inclusion[bc]; math.Abs(p-0.5) > 0.05 {
+		t.Errorf("Expected about 0.5 for 1-2, got %.3f", p)
+	}
+	if sum := res.Inclusion[bc] + res.Inclusion[ac]; sum != 1 {
+		t.Errorf("Expected exactly one heavy edge per tree, got %.3f", sum)
+	}
+	if res.Quantile(0) < 90.9 || res.Quantile(1) > 111 || res.Mean > 101 {
+		t.Errorf("Unexpected weight distribution: min %.1f, max %.1f, mean %.1f",
+			res.Quantile(0), res.Quantile(1), res.Mean)
+	}
+	if len(res.Weights) != 2000 || res.StdDev <= 0 {
+		t.Errorf("Expected 2000 samples with positive spread, got %d and %.3f", len(res.Weights), res.StdDev)
+	}
+}
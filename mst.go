@@ -4,7 +4,10 @@ import (
 	"container/heap"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
 	"sort"
+	"time"
 )
 
 type Vertex struct {
@@ -12,6 +15,14 @@ type Vertex struct {
 	Name  string
 	Data  any
 	Edges []*Edge
+
+	// Attrs holds named attributes such as "label" or "capacity"
+	// AddVertex stores a copy of the caller's map
+	Attrs Attributes
+
+	// Weight is the cost of activating the vertex in node-weighted problems
+	// such as NodeWeightedSteinerTree; edge-weighted algorithms ignore it
+	Weight int
 }
 
 func (v *Vertex) String() string {
@@ -35,6 +46,17 @@ type Edge struct {
 	To     *Vertex
 	Weight int
 	Data   any
+
+	// Weights holds extra named criteria such as "latency" or "loss"
+	// AddEdge stores a copy of the caller's map, which is then shared with
+	// the reverse adjacency copy in undirected graphs
+	Weights map[string]int
+
+	// Attrs holds named attributes such as "label" or "capacity"
+	// Like Weights it is shared with the reverse adjacency copy
+	Attrs Attributes
+
+	twin *Edge // reverse adjacency copy in undirected graphs
 }
 
 func NewEdge(From *Vertex, To *Vertex, weight int, data any) (*Edge, error) {
@@ -51,10 +73,12 @@ func NewEdge(From *Vertex, To *Vertex, weight int, data any) (*Edge, error) {
 
 func (e *Edge) Reverse() *Edge {
 	return &Edge{
-		From:   e.To,
-		To:     e.From,
-		Weight: e.Weight,
-		Data:   e.Data,
+		From:    e.To,
+		To:      e.From,
+		Weight:  e.Weight,
+		Data:    e.Data,
+		Weights: e.Weights,
+		Attrs:   e.Attrs,
 	}
 }
 
@@ -76,6 +100,19 @@ type Graph struct {
 	Vertices map[int]Vertex
 	Edges    []*Edge
 	Directed bool
+	Unit     WeightUnit // how weights are displayed by Print and MSTResult
+
+	index *edgeIndex  // lazily built by GetEdge and HasEdge
+	names *nameIndex  // lazily built by GetVertexByName
+	dense *denseIndex // lazily built by EdgeAt and Adjacent
+	arena *EdgeArena  // source of new edges, nil for ordinary allocation
+
+	expiry   map[*Edge]time.Time // set by SetExpiry, keyed by both adjacency copies
+	versions *versionTracker     // started by the first Snapshot
+
+	transactions int // open transactions, which keep Clear from resetting the arena
+
+	observers []*mutationSubscription
 }
 
 func NewGraph(directed bool) Graph {
@@ -91,15 +128,28 @@ func (g *Graph) GetVertex(id int) (*Vertex, bool) {
 	return &v, exists
 }
 
+// AddVertex adds a vertex and returns the stored copy, or the vertex already
+// stored under its ID
+// While unique names are on, a new vertex whose name is taken is not added
+// and nil is returned
 func (g *Graph) AddVertex(vertex Vertex) *Vertex {
 	if v, exists := g.GetVertex(vertex.ID); exists {
 		return v
+	} else if _, taken := g.names.conflict(vertex); taken {
+		return nil
 	} else {
+		vertex.Attrs = maps.Clone(vertex.Attrs)
 		g.Vertices[vertex.ID] = vertex
+		g.names.add(vertex)
+		g.notify(Mutation{Kind: MutationVertexAdded, Vertex: &vertex})
 		return &vertex
 	}
 }
 
+// AddEdge adds an edge, and its reverse copy in undirected graphs, adding
+// endpoints that are not in the graph yet
+// While unique names are on, an edge with a new endpoint whose name is taken
+// is not added and nil is returned; an endpoint added before is kept
 func (g *Graph) AddEdge(edge Edge) *Edge {
 	from, fromExists := g.GetVertex(edge.From.ID)
 	to, toExists := g.GetVertex(edge.To.ID)
@@ -110,13 +160,24 @@ func (g *Graph) AddEdge(edge Edge) *Edge {
 	if !toExists {
 		to = g.AddVertex(*edge.To)
 	}
+	if from == nil || to == nil {
+		return nil
+	}
 
-	// Add edge to graph
-	newEdge := &Edge{
-		From:   from,
-		To:     to,
-		Weight: edge.Weight,
-		Data:   edge.Data,
+	// Add edge to graph, with room for the reverse copy if undirected
+	slots := 1
+	if !g.Directed {
+		slots = 2
+	}
+	alloc := g.allocEdges(slots)
+	newEdge := &alloc[0]
+	*newEdge = Edge{
+		From:    from,
+		To:      to,
+		Weight:  edge.Weight,
+		Data:    edge.Data,
+		Weights: maps.Clone(edge.Weights),
+		Attrs:   maps.Clone(edge.Attrs),
 	}
 	g.Edges = append(g.Edges, newEdge)
 
@@ -127,20 +188,192 @@ func (g *Graph) AddEdge(edge Edge) *Edge {
 
 	// If undirected graph, add reverse edge as well
 	if !g.Directed {
-		reverseEdge := newEdge.Reverse()
+		reverseEdge := &alloc[1]
+		*reverseEdge = Edge{
+			From:    newEdge.To,
+			To:      newEdge.From,
+			Weight:  newEdge.Weight,
+			Data:    newEdge.Data,
+			Weights: newEdge.Weights,
+			Attrs:   newEdge.Attrs,
+		}
+		newEdge.twin = reverseEdge
+		reverseEdge.twin = newEdge
 		toVertex := g.Vertices[to.ID]
 		toVertex.Edges = append(toVertex.Edges, reverseEdge)
 		g.Vertices[to.ID] = toVertex
 	}
 
+	g.notify(Mutation{Kind: MutationEdgeAdded, Edge: newEdge})
+
 	return newEdge
 }
 
+// AddEdges adds many edges at once and returns the new edges in input order
+// It behaves like calling AddEdge for each edge, but counts degrees first so
+// every adjacency list is allocated once with its final capacity, stores the
+// edges in contiguous slices, and writes each touched vertex back to the map
+// only once. Edges AddEdge would reject are left nil in the result
+func (g *Graph) AddEdges(edges []Edge) []*Edge {
+	if len(edges) == 0 {
+		return nil
+	}
+
+	// Resolve every touched vertex once and count its new adjacency entries
+	type pending struct {
+		vertex Vertex
+		extra  int
+	}
+	touched := make(map[int]*pending)
+	created := make([]int, 0) // new vertex IDs in order of first appearance
+	resolve := func(v *Vertex) *pending {
+		if p, exists := touched[v.ID]; exists {
+			return p
+		}
+		existing, exists := g.Vertices[v.ID]
+		if !exists {
+			if _, taken := g.names.conflict(*v); taken {
+				return nil
+			}
+			existing = *v
+			existing.Attrs = maps.Clone(v.Attrs)
+			g.names.add(existing)
+			created = append(created, v.ID)
+		}
+		p := &pending{vertex: existing}
+		touched[v.ID] = p
+		return p
+	}
+	rejected := make([]bool, len(edges))
+	for i := range edges {
+		from, to := resolve(edges[i].From), resolve(edges[i].To)
+		if from == nil || to == nil {
+			rejected[i] = true
+			continue
+		}
+		from.extra++
+		if !g.Directed {
+			to.extra++
+		}
+	}
+	for _, p := range touched {
+		adj := make([]*Edge, len(p.vertex.Edges), len(p.vertex.Edges)+p.extra)
+		copy(adj, p.vertex.Edges)
+		p.vertex.Edges = adj
+	}
+
+	if len(g.Vertices) == 0 {
+		g.Vertices = make(map[int]Vertex, len(touched))
+	}
+	g.Edges = slices.Grow(g.Edges, len(edges))
+
+	forward := g.allocEdges(len(edges))
+	var backward []Edge
+	if !g.Directed {
+		backward = g.allocEdges(len(edges))
+	}
+	added := make([]*Edge, len(edges))
+
+	for i := range edges {
+		if rejected[i] {
+			continue
+		}
+		from := touched[edges[i].From.ID]
+		to := touched[edges[i].To.ID]
+
+		newEdge := &forward[i]
+		*newEdge = Edge{
+			From:    &from.vertex,
+			To:      &to.vertex,
+			Weight:  edges[i].Weight,
+			Data:    edges[i].Data,
+			Weights: maps.Clone(edges[i].Weights),
+			Attrs:   maps.Clone(edges[i].Attrs),
+		}
+		added[i] = newEdge
+		g.Edges = append(g.Edges, newEdge)
+		from.vertex.Edges = append(from.vertex.Edges, newEdge)
+
+		if !g.Directed {
+			reverseEdge := &backward[i]
+			*reverseEdge = Edge{
+				From:    newEdge.To,
+				To:      newEdge.From,
+				Weight:  newEdge.Weight,
+				Data:    newEdge.Data,
+				Weights: newEdge.Weights,
+				Attrs:   newEdge.Attrs,
+				twin:    newEdge,
+			}
+			newEdge.twin = reverseEdge
+			to.vertex.Edges = append(to.vertex.Edges, reverseEdge)
+		}
+	}
+
+	for _, p := range touched {
+		g.Vertices[p.vertex.ID] = p.vertex
+	}
+
+	if len(g.observers) > 0 {
+		for _, id := range created {
+			v := g.Vertices[id]
+			g.notify(Mutation{Kind: MutationVertexAdded, Vertex: &v})
+		}
+		for _, edge := range added {
+			if edge != nil {
+				g.notify(Mutation{Kind: MutationEdgeAdded, Edge: edge})
+			}
+		}
+	}
+	return added
+}
+
+// SetEdgeWeight changes the weight of an edge of the graph
+// In undirected graphs the reverse adjacency copy is updated as well,
+// so every algorithm sees the new weight
+func (g *Graph) SetEdgeWeight(edge *Edge, weight int) {
+	old := edge.Weight
+	edge.Weight = weight
+	if edge.twin != nil {
+		edge.twin.Weight = weight
+	}
+	if old != weight {
+		g.notify(Mutation{Kind: MutationEdgeWeightChanged, Edge: edge, OldWeight: old})
+	}
+}
+
+// SetVertexData replaces the Data of a vertex of the graph
+// Data need not be comparable, so observers are notified even when the
+// new value equals the old one
+func (g *Graph) SetVertexData(id int, data any) error {
+	v, exists := g.Vertices[id]
+	if !exists {
+		return fmt.Errorf("set data of %d: %w", id, ErrVertexNotFound)
+	}
+	old := v.Data
+	v.Data = data
+	g.Vertices[id] = v
+	g.notify(Mutation{Kind: MutationVertexDataChanged, Vertex: &v, OldData: old})
+	return nil
+}
+
 // VertexCount returns the total number of vertices
 func (g *Graph) VertexCount() int {
 	return len(g.Vertices)
 }
 
+// SortedVertexIDs returns every vertex ID in ascending order
+// Iterating this instead of the Vertices map keeps output and results
+// identical between runs
+func (g *Graph) SortedVertexIDs() []int {
+	ids := make([]int, 0, len(g.Vertices))
+	for id := range g.Vertices {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	return ids
+}
+
 // EdgeCount returns the total number of edges
 func (g *Graph) EdgeCount() int {
 	return len(g.Edges)
@@ -159,7 +392,8 @@ func (g *Graph) Print() {
 		fmt.Println("Type: Undirected Graph")
 	}
 	fmt.Println("\nVertices and Edges:")
-	for id, vertex := range g.Vertices {
+	for _, id := range g.SortedVertexIDs() {
+		vertex := g.Vertices[id]
 		fmt.Printf("  [%d] %s -> ", id, vertex.Name)
 		if len(vertex.Edges) == 0 {
 			fmt.Println("(no edges)")
@@ -168,7 +402,7 @@ func (g *Graph) Print() {
 				if i > 0 {
 					fmt.Print(", ")
 				}
-				fmt.Printf("%s(w:%d)", edge.To.String(), edge.Weight)
+				fmt.Printf("%s(w:%s)", edge.To.String(), g.Unit.Format(int64(edge.Weight)))
 			}
 			fmt.Println()
 		}
@@ -178,100 +412,159 @@ func (g *Graph) Print() {
 // ==================== UNION-FIND DATA STRUCTURE ====================
 
 // UnionFind is a data structure used for cycle detection
-type UnionFind struct {
-	parent map[int]int
-	rank   map[int]int
-}
+// It is the integer-keyed instance of DisjointSet
+type UnionFind = DisjointSet[int]
 
 // NewUnionFind creates a new UnionFind structure
 func NewUnionFind() *UnionFind {
-	return &UnionFind{
-		parent: make(map[int]int),
-		rank:   make(map[int]int),
-	}
-}
-
-// MakeSet creates a new set for a vertex
-func (uf *UnionFind) MakeSet(x int) {
-	if _, exists := uf.parent[x]; !exists {
-		uf.parent[x] = x
-		uf.rank[x] = 0
-	}
-}
-
-// Find finds the root vertex of a vertex (with path compression)
-func (uf *UnionFind) Find(x int) int {
-	if uf.parent[x] != x {
-		uf.parent[x] = uf.Find(uf.parent[x]) // Path compression
-	}
-	return uf.parent[x]
-}
-
-// Union merges two sets (with union by rank)
-// If two vertices are in different sets, it merges them and returns true
-// If they are in the same set (cycle would be formed), it returns false
-func (uf *UnionFind) Union(x, y int) bool {
-	rootX := uf.Find(x)
-	rootY := uf.Find(y)
-
-	if rootX == rootY {
-		return false // Already in the same set, cycle would be formed
-	}
-
-	// Union by rank
-	if uf.rank[rootX] < uf.rank[rootY] {
-		uf.parent[rootX] = rootY
-	} else if uf.rank[rootX] > uf.rank[rootY] {
-		uf.parent[rootY] = rootX
-	} else {
-		uf.parent[rootY] = rootX
-		uf.rank[rootX]++
-	}
-	return true
+	return NewDisjointSet[int]()
 }
 
 // ==================== KRUSKAL ALGORITHM ====================
 
 // Kruskal finds MST using Kruskal's algorithm
 // Sorts edges by weight and adds them without forming cycles
-func (g *Graph) Kruskal() ([]*Edge, int) {
+// Infeasible edge constraints are applied best-effort; use KruskalStrict to detect them
+func (g *Graph) Kruskal(opts ...Option) ([]*Edge, int) {
 	if g.Directed {
 		panic("Kruskal algorithm only works for undirected graphs")
 	}
 
+	o := newOptions(opts)
+	o.prepareConstraints(g)
+	return g.kruskal(o)
+}
+
+// kruskal runs Kruskal's algorithm with already prepared options
+func (g *Graph) kruskal(o *options) ([]*Edge, int) {
+	m := o.startMetrics("kruskal")
+	defer m.finish()
+	began := o.logStart("kruskal", g)
+
 	mst := make([]*Edge, 0)
 	totalWeight := 0
 
-	// Sort edges by weight
-	edges := make([]*Edge, len(g.Edges))
-	copy(edges, g.Edges)
-	sort.Slice(edges, func(i, j int) bool {
-		return edges[i].Weight < edges[j].Weight
-	})
+	m.startPhase("sort")
+	edges := g.sortedEdges(o)
 
 	// Create Union-Find structure
+	m.startPhase("scan")
 	uf := NewUnionFind()
 	for id := range g.Vertices {
 		uf.MakeSet(id)
 	}
 
+	// Track cluster aggregates only when someone is watching
+	var clusters *AggregateDisjointSet[int, ClusterStats]
+	if o.onMerge != nil {
+		clusters = newClusterTracker(g)
+	}
+
 	// Check each edge
 	for _, edge := range edges {
+		o.traceEdge("kruskal", EventEdgeConsidered, edge)
+		m.count(CounterEdgesScanned)
+		m.count(CounterUnions)
+
 		// If edge doesn't form a cycle, add it
 		if uf.Union(edge.From.ID, edge.To.ID) {
+			o.traceEdge("kruskal", EventEdgeAccepted, edge)
 			mst = append(mst, edge)
-			totalWeight += edge.Weight
+			totalWeight += o.weightOf(edge)
+
+			if clusters != nil {
+				o.onMerge(edge, joinClusters(clusters, edge))
+			}
 
 			// MST should have V-1 edges
 			if len(mst) == g.VertexCount()-1 {
 				break
 			}
+		} else {
+			o.traceEdge("kruskal", EventEdgeRejected, edge)
+			m.count(CounterCyclesRejected)
 		}
 	}
 
+	o.logFinish("kruskal", g, mst, totalWeight, len(mst) < g.VertexCount()-1, began)
 	return mst, totalWeight
 }
 
+// sortedEdges returns the edges in the order Kruskal scans them: by weight,
+// or with constraints or a criterion, forbidden edges dropped, required
+// ones first, and the rest by the criterion and tie breakers
+func (g *Graph) sortedEdges(o *options) []*Edge {
+	if c := o.constraints; c != nil || o.weight != nil || len(o.tieBreakers) > 0 {
+		edges := make([]*Edge, 0, len(g.Edges))
+		for _, edge := range g.Edges {
+			if c.allowed(edge) {
+				edges = append(edges, edge)
+			}
+		}
+		o.sortEdges(edges, o.less)
+		return edges
+	}
+	edges := make([]*Edge, len(g.Edges))
+	copy(edges, g.Edges)
+	o.sortEdges(edges, nil)
+	return edges
+}
+
+// ErrDisconnected is returned when a spanning tree cannot cover every vertex
+var ErrDisconnected = errors.New("graph is disconnected")
+
+// ErrVertexNotFound is returned when a vertex ID is not in the graph
+var ErrVertexNotFound = errors.New("vertex not found")
+
+// DisconnectedError describes why a spanning tree could not cover the graph
+// It matches ErrDisconnected with errors.Is
+type DisconnectedError struct {
+	Components int   // number of connected components, 0 if not computed
+	Unreached  []int // vertices the tree could not reach, sorted by ID
+}
+
+func (e *DisconnectedError) Error() string {
+	if len(e.Unreached) > 0 {
+		return fmt.Sprintf("%s: %d vertices unreached", ErrDisconnected, len(e.Unreached))
+	}
+	return fmt.Sprintf("%s: %d components", ErrDisconnected, e.Components)
+}
+
+// Unwrap makes errors.Is(err, ErrDisconnected) succeed
+func (e *DisconnectedError) Unwrap() error {
+	return ErrDisconnected
+}
+
+// KruskalStrict finds MST using Kruskal's algorithm, but first checks
+// that the graph is connected and returns ErrDisconnected if it is not,
+// instead of silently returning a partial spanning forest
+// It also returns ErrInfeasibleConstraints if edge constraints cannot be met
+func (g *Graph) KruskalStrict(opts ...Option) ([]*Edge, int, error) {
+	if g.Directed {
+		panic("Kruskal algorithm only works for undirected graphs")
+	}
+
+	// Cheap connectivity pass before paying for the sort
+	uf := NewUnionFind()
+	for id := range g.Vertices {
+		uf.MakeSet(id)
+	}
+	for _, edge := range g.Edges {
+		uf.Union(edge.From.ID, edge.To.ID)
+	}
+	if uf.Count() > 1 {
+		return nil, 0, &DisconnectedError{Components: uf.Count()}
+	}
+
+	o := newOptions(opts)
+	if err := o.prepareConstraints(g); err != nil {
+		return nil, 0, err
+	}
+
+	mst, totalWeight := g.kruskal(o)
+	return mst, totalWeight, nil
+}
+
 // ==================== PRIORITY QUEUE (FOR PRIM) ====================
 
 // PriorityQueue is a min-heap priority queue for edges
@@ -299,77 +592,183 @@ func (pq *PriorityQueue) Pop() any {
 	return item
 }
 
+// orderedQueue is a min-heap of edges in the order of a less function, used
+// by Prim when a criterion or tie breakers decide between edges
+type orderedQueue struct {
+	edges []*Edge
+	less  func(a, b *Edge) bool
+}
+
+func (q *orderedQueue) Len() int           { return len(q.edges) }
+func (q *orderedQueue) Less(i, j int) bool { return q.less(q.edges[i], q.edges[j]) }
+func (q *orderedQueue) Swap(i, j int)      { q.edges[i], q.edges[j] = q.edges[j], q.edges[i] }
+func (q *orderedQueue) Push(x any)         { q.edges = append(q.edges, x.(*Edge)) }
+
+func (q *orderedQueue) Pop() any {
+	last := q.edges[len(q.edges)-1]
+	q.edges = q.edges[:len(q.edges)-1]
+	return last
+}
+
+// newEdgeQueue returns an empty edge heap ordered by weight, or by o.less
+// when a criterion or tie breakers are set
+func newEdgeQueue(o *options) heap.Interface {
+	if o.ordered() {
+		return &orderedQueue{less: o.less}
+	}
+	return &PriorityQueue{}
+}
+
 // ==================== PRIM ALGORITHM ====================
 
 // Prim finds MST using Prim's algorithm
 // Starting from a vertex, at each step it adds the nearest vertex to the current tree
-func (g *Graph) Prim(startID int) ([]*Edge, int) {
+// Required edges are kept in a separate queue that is always drained first
+func (g *Graph) Prim(startID int, opts ...Option) ([]*Edge, int) {
 	if g.Directed {
 		panic("Prim algorithm only works for undirected graphs")
 	}
 
-	start, exists := g.Vertices[startID]
-	if !exists {
+	if _, exists := g.Vertices[startID]; !exists {
 		return nil, 0
 	}
 
+	o := newOptions(opts)
+	o.prepareConstraints(g)
+	return g.prim(startID, o)
+}
+
+// PrimStrict finds MST using Prim's algorithm, but returns an error instead
+// of silently handing back a partial tree: ErrVertexNotFound for a missing
+// start vertex, a *DisconnectedError listing the unreached vertices (along
+// with the partial tree) when the graph is disconnected, or
+// ErrInfeasibleConstraints when edge constraints cannot be met
+func (g *Graph) PrimStrict(startID int, opts ...Option) ([]*Edge, int, error) {
+	if g.Directed {
+		panic("Prim algorithm only works for undirected graphs")
+	}
+	if _, exists := g.Vertices[startID]; !exists {
+		return nil, 0, fmt.Errorf("%w: %d", ErrVertexNotFound, startID)
+	}
+
+	o := newOptions(opts)
+	if err := o.prepareConstraints(g); err != nil {
+		return nil, 0, err
+	}
+
+	mst, totalWeight := g.prim(startID, o)
+	if len(mst) < g.VertexCount()-1 {
+		reached := map[int]bool{startID: true}
+		for _, edge := range mst {
+			reached[edge.From.ID] = true
+			reached[edge.To.ID] = true
+		}
+		unreached := make([]int, 0, g.VertexCount()-len(reached))
+		for id := range g.Vertices {
+			if !reached[id] {
+				unreached = append(unreached, id)
+			}
+		}
+		sort.Ints(unreached)
+		return mst, totalWeight, &DisconnectedError{Unreached: unreached}
+	}
+	return mst, totalWeight, nil
+}
+
+// prim runs lazy Prim from an existing start vertex with already prepared options
+func (g *Graph) prim(startID int, o *options) ([]*Edge, int) {
+	start := g.Vertices[startID]
+	c := o.constraints
+
+	m := o.startMetrics("prim")
+	defer m.finish()
+	m.startPhase("grow")
+	began := o.logStart("prim", g)
+
 	mst := make([]*Edge, 0)
 	totalWeight := 0
 	visited := make(map[int]bool)
 
-	// Create priority queue
-	pq := &PriorityQueue{}
-	heap.Init(pq)
+	// Create priority queues
+	pq := newEdgeQueue(o)
+	requiredPQ := newEdgeQueue(o)
+
+	push := func(edge *Edge) {
+		m.count(CounterEdgesScanned)
+		if !c.allowed(edge) {
+			return
+		}
+		m.count(CounterHeapPushes)
+		if c.isRequired(edge) {
+			heap.Push(requiredPQ, edge)
+		} else {
+			heap.Push(pq, edge)
+		}
+	}
 
 	// Mark starting vertex
 	visited[start.ID] = true
+	o.traceVertex("prim", start.ID)
 
 	// Add edges from starting vertex
 	for _, edge := range start.Edges {
-		heap.Push(pq, edge)
+		push(edge)
 	}
 
 	// Build MST
-	for pq.Len() > 0 && len(mst) < g.VertexCount()-1 {
-		edge := heap.Pop(pq).(*Edge)
+	for pq.Len()+requiredPQ.Len() > 0 && len(mst) < g.VertexCount()-1 {
+		var edge *Edge
+		if requiredPQ.Len() > 0 {
+			edge = heap.Pop(requiredPQ).(*Edge)
+		} else {
+			edge = heap.Pop(pq).(*Edge)
+		}
+		m.count(CounterHeapPops)
+		o.traceEdge("prim", EventEdgeConsidered, edge)
 
 		// Skip if target vertex is already visited
 		if visited[edge.To.ID] {
+			o.traceEdge("prim", EventEdgeRejected, edge)
+			m.count(CounterCyclesRejected)
 			continue
 		}
 
 		// Add edge to MST
+		o.traceEdge("prim", EventEdgeAccepted, edge)
 		mst = append(mst, edge)
 		totalWeight += edge.Weight
 		visited[edge.To.ID] = true
+		o.traceVertex("prim", edge.To.ID)
 
 		// Add edges from the new vertex
 		toVertex := g.Vertices[edge.To.ID]
 		for _, nextEdge := range toVertex.Edges {
 			if !visited[nextEdge.To.ID] {
-				heap.Push(pq, nextEdge)
+				push(nextEdge)
 			}
 		}
 	}
 
+	o.logFinish("prim", g, mst, totalWeight, len(mst) < g.VertexCount()-1, began)
 	return mst, totalWeight
 }
 
 // ==================== HELPER FUNCTIONS ====================
 
 // IsConnected checks if the graph is connected (using DFS)
+// For directed graphs it reports weak connectivity, see IsWeaklyConnected
+// and IsStronglyConnected
 func (g *Graph) IsConnected() bool {
 	if g.VertexCount() == 0 {
 		return true
 	}
-
-	// Start from the first vertex
-	var startID int
-	for id := range g.Vertices {
-		startID = id
-		break
+	if g.Directed {
+		return g.IsWeaklyConnected()
 	}
 
+	// Start from the smallest vertex ID
+	startID := g.SortedVertexIDs()[0]
+
 	visited := make(map[int]bool)
 	g.dfs(startID, visited)
 
@@ -399,18 +798,23 @@ func GetMSTWeight(mst []*Edge) int {
 
 // PrintMST prints the MST in a formatted way
 func PrintMST(mst []*Edge, totalWeight int, algorithmName string) {
+	PrintMSTIn(mst, totalWeight, algorithmName, WeightUnit{})
+}
+
+// printMST prints the MST box with weights formatted in unit
+func printMST(mst []*Edge, totalWeight string, algorithmName string, unit WeightUnit) {
 	fmt.Println("\n╔════════════════════════════════════════════════╗")
 	fmt.Printf("║    MINIMUM SPANNING TREE - %-19s ║\n", algorithmName)
 	fmt.Println("╚════════════════════════════════════════════════╝")
 	fmt.Printf("\nEdge Count: %d\n", len(mst))
 	fmt.Println("\nMST Edges:")
 	for i, edge := range mst {
-		fmt.Printf("  %2d. [%d:%s] --%d--> [%d:%s]\n",
+		fmt.Printf("  %2d. [%d:%s] --%s--> [%d:%s]\n",
 			i+1,
 			edge.From.ID, edge.From.Name,
-			edge.Weight,
+			unit.Format(int64(edge.Weight)),
 			edge.To.ID, edge.To.Name)
 	}
-	fmt.Printf("\n✓ Total Weight: %d\n", totalWeight)
+	fmt.Printf("\n✓ Total Weight: %s\n", totalWeight)
 	fmt.Println("════════════════════════════════════════════════")
 }
@@ -1,6 +1,7 @@
 package mst
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 )
@@ -33,6 +34,58 @@ func TestBasicGraph(t *testing.T) {
 	}
 }
 
+// TestAddEdges tests that bulk loading matches repeated AddEdge calls
+func TestAddEdges(t *testing.T) {
+	fmt.Println("\n=== BULK ADD EDGES TEST ===")
+
+	vertices := make([]*Vertex, 6)
+	for i := 0; i < 6; i++ {
+		vertices[i] = &Vertex{ID: i, Name: fmt.Sprintf("V%d", i), Edges: make([]*Edge, 0)}
+	}
+	batch := []Edge{
+		{From: vertices[0], To: vertices[1], Weight: 4},
+		{From: vertices[0], To: vertices[2], Weight: 2},
+		{From: vertices[1], To: vertices[2], Weight: 1},
+		{From: vertices[1], To: vertices[3], Weight: 5},
+		{From: vertices[3], To: vertices[4], Weight: 2},
+		{From: vertices[4], To: vertices[5], Weight: 3},
+	}
+
+	g := NewGraph(false)
+	g.AddEdge(Edge{From: vertices[2], To: vertices[4], Weight: 10})
+	added := g.AddEdges(batch)
+
+	if len(added) != len(batch) || g.EdgeCount() != 7 || g.VertexCount() != 6 {
+		t.Fatalf("Expected 7 edges and 6 vertices, got %d and %d", g.EdgeCount(), g.VertexCount())
+	}
+	if v, _ := g.GetVertex(2); len(v.Edges) != 3 {
+		t.Errorf("Expected vertex 2 to have 3 adjacency entries, got %d", len(v.Edges))
+	}
+	if _, w := g.Prim(5); w != 13 {
+		t.Errorf("Expected Prim weight 13 over bulk-loaded edges, got %d", w)
+	}
+
+	// Weight changes must reach the reverse copies too
+	g.SetEdgeWeight(added[2], 100)
+	if _, w := g.PrimEager(5); w != 16 {
+		t.Errorf("Expected weight 16 after SetEdgeWeight, got %d", w)
+	}
+}
+
+// TestSortedVertexIDs tests deterministic vertex ordering
+func TestSortedVertexIDs(t *testing.T) {
+	g := NewGraph(false)
+	for _, id := range []int{42, 7, 19, 3} {
+		g.AddVertex(Vertex{ID: id})
+	}
+	if got := fmt.Sprint(g.SortedVertexIDs()); got != "[3 7 19 42]" {
+		t.Errorf("Expected [3 7 19 42], got %s", got)
+	}
+	if g.IsConnected() {
+		t.Error("Expected isolated vertices to be disconnected")
+	}
+}
+
 // TestKruskal tests Kruskal's algorithm
 func TestKruskal(t *testing.T) {
 	fmt.Println("\n=== KRUSKAL ALGORITHM TEST ===")
@@ -295,6 +348,71 @@ func TestIsConnected(t *testing.T) {
 	fmt.Println("✓ Graph 2 is disconnected (2 components)")
 }
 
+// TestKruskalStrict tests that disconnected graphs are reported
+func TestKruskalStrict(t *testing.T) {
+	fmt.Println("\n=== STRICT KRUSKAL TEST ===")
+
+	g := NewGraph(false)
+	v0 := &Vertex{ID: 0, Name: "A", Edges: make([]*Edge, 0)}
+	v1 := &Vertex{ID: 1, Name: "B", Edges: make([]*Edge, 0)}
+	v2 := &Vertex{ID: 2, Name: "C", Edges: make([]*Edge, 0)}
+	v3 := &Vertex{ID: 3, Name: "D", Edges: make([]*Edge, 0)}
+
+	g.AddEdge(Edge{From: v0, To: v1, Weight: 1})
+	g.AddEdge(Edge{From: v2, To: v3, Weight: 1})
+
+	_, _, err := g.KruskalStrict()
+	if !errors.Is(err, ErrDisconnected) {
+		t.Errorf("Expected ErrDisconnected, got %v", err)
+	}
+	fmt.Println("✓", err)
+
+	g.AddEdge(Edge{From: v1, To: v2, Weight: 5})
+	mst, totalWeight, err := g.KruskalStrict()
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if len(mst) != 3 || totalWeight != 7 {
+		t.Errorf("Expected 3 edges with weight 7, got %d edges with weight %d", len(mst), totalWeight)
+	}
+}
+
+// TestPrimStrict tests that Prim reports unreached vertices
+func TestPrimStrict(t *testing.T) {
+	fmt.Println("\n=== STRICT PRIM TEST ===")
+
+	g := NewGraph(false)
+	v := make([]*Vertex, 5)
+	for i := range v {
+		v[i] = &Vertex{ID: i, Name: fmt.Sprintf("V%d", i), Edges: make([]*Edge, 0)}
+	}
+	g.AddEdge(Edge{From: v[0], To: v[1], Weight: 1})
+	g.AddEdge(Edge{From: v[1], To: v[2], Weight: 2})
+	g.AddEdge(Edge{From: v[3], To: v[4], Weight: 3})
+
+	mst, totalWeight, err := g.PrimStrict(0)
+	if !errors.Is(err, ErrDisconnected) {
+		t.Fatalf("Expected ErrDisconnected, got %v", err)
+	}
+	var de *DisconnectedError
+	if !errors.As(err, &de) || len(de.Unreached) != 2 || de.Unreached[0] != 3 || de.Unreached[1] != 4 {
+		t.Errorf("Expected unreached vertices [3 4], got %v", de)
+	}
+	if len(mst) != 2 || totalWeight != 3 {
+		t.Errorf("Expected the partial tree to be returned, got %d edges with weight %d", len(mst), totalWeight)
+	}
+	fmt.Println("✓", err)
+
+	if _, _, err := g.PrimStrict(99); !errors.Is(err, ErrVertexNotFound) {
+		t.Errorf("Expected ErrVertexNotFound, got %v", err)
+	}
+
+	g.AddEdge(Edge{From: v[2], To: v[3], Weight: 4})
+	if _, _, err := g.PrimStrict(0); err != nil {
+		t.Errorf("Expected no error once connected, got %v", err)
+	}
+}
+
 // BenchmarkKruskal benchmarks Kruskal's algorithm
 func BenchmarkKruskal(b *testing.B) {
 	g := NewGraph(false)
@@ -322,6 +440,43 @@ func BenchmarkKruskal(b *testing.B) {
 	}
 }
 
+// benchmarkEdgeBatch creates a batch of edges over a ring of n vertices
+func benchmarkEdgeBatch(n int) []Edge {
+	vertices := make([]*Vertex, n)
+	for i := range vertices {
+		vertices[i] = &Vertex{ID: i, Name: fmt.Sprintf("V%d", i)}
+	}
+	edges := make([]Edge, 0, 4*n)
+	for i := 0; i < n; i++ {
+		for _, step := range []int{1, 7, 31, 127} {
+			edges = append(edges, Edge{From: vertices[i], To: vertices[(i+step)%n], Weight: (i * step) % 1000})
+		}
+	}
+	return edges
+}
+
+// BenchmarkAddEdge benchmarks loading edges one at a time
+func BenchmarkAddEdge(b *testing.B) {
+	edges := benchmarkEdgeBatch(25000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		g := NewGraph(false)
+		for _, e := range edges {
+			g.AddEdge(e)
+		}
+	}
+}
+
+// BenchmarkAddEdges benchmarks loading edges in one batch
+func BenchmarkAddEdges(b *testing.B) {
+	edges := benchmarkEdgeBatch(25000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		g := NewGraph(false)
+		g.AddEdges(edges)
+	}
+}
+
 // BenchmarkPrim benchmarks Prim's algorithm
 func BenchmarkPrim(b *testing.B) {
 	g := NewGraph(false)
@@ -0,0 +1,175 @@
+package mst
+
+import "slices"
+
+// ==================== MUTATION HOOKS ====================
+
+// MutationKind identifies how the graph changed
+type MutationKind string
+
+const (
+	// MutationVertexAdded is fired when a new vertex joins the graph
+	MutationVertexAdded MutationKind = "vertex_added"
+	// MutationVertexRemoved is fired after a vertex and its edges have been removed
+	MutationVertexRemoved MutationKind = "vertex_removed"
+	// MutationEdgeAdded is fired when a new edge joins the graph
+	MutationEdgeAdded MutationKind = "edge_added"
+	// MutationEdgeRemoved is fired when an edge leaves the graph
+	MutationEdgeRemoved MutationKind = "edge_removed"
+	// MutationEdgeWeightChanged is fired when SetEdgeWeight changes a weight
+	MutationEdgeWeightChanged MutationKind = "edge_weight_changed"
+	// MutationEdgeCriterionChanged is fired when SetEdgeWeightBy changes a named criterion
+	MutationEdgeCriterionChanged MutationKind = "edge_criterion_changed"
+	// MutationVertexWeightChanged is fired when SetVertexWeight changes a weight
+	MutationVertexWeightChanged MutationKind = "vertex_weight_changed"
+	// MutationVertexDataChanged is fired when SetVertexData replaces a vertex's Data
+	MutationVertexDataChanged MutationKind = "vertex_data_changed"
+	// MutationVertexNameChanged is fired when SetVertexName renames a vertex
+	MutationVertexNameChanged MutationKind = "vertex_name_changed"
+	// MutationVertexAttrChanged is fired when SetVertexAttr sets a vertex attribute
+	MutationVertexAttrChanged MutationKind = "vertex_attr_changed"
+	// MutationEdgeAttrChanged is fired when SetEdgeAttr sets an edge attribute
+	MutationEdgeAttrChanged MutationKind = "edge_attr_changed"
+	// MutationGraphCleared is fired by Clear just before every vertex and edge is dropped
+	MutationGraphCleared MutationKind = "graph_cleared"
+)
+
+// Mutation describes a single change to a graph
+// Vertex events carry Vertex; edge events carry Edge as stored in g.Edges,
+// and weight changes of either also carry the previous weight in OldWeight.
+// Criterion changes name the criterion in Criterion and report in OldPresent
+// whether the edge carried it before. Data changes carry the previous Data in OldData
+// and name changes carry the previous name in OldValue.
+// Attribute changes name the attribute in Attr and carry its previous value
+// in OldValue, with OldPresent reporting whether it was set
+type Mutation struct {
+	Kind       MutationKind
+	Vertex     *Vertex
+	Edge       *Edge
+	Criterion  string
+	Attr       string
+	OldWeight  int
+	OldPresent bool
+	OldData    any
+	OldValue   any
+
+	cleared *clearedContents // saved by a Transaction to undo MutationGraphCleared
+}
+
+// MutationObserver receives graph changes as they happen
+type MutationObserver interface {
+	OnMutation(m Mutation)
+}
+
+// MutationFunc adapts an ordinary function to the MutationObserver interface
+type MutationFunc func(m Mutation)
+
+// OnMutation calls f(m)
+func (f MutationFunc) OnMutation(m Mutation) {
+	f(m)
+}
+
+// mutationSubscription gives each registration its own identity so it can be cancelled
+type mutationSubscription struct {
+	observer MutationObserver
+}
+
+// Observe registers an observer for every later change made through the
+// Graph methods and returns a function that unregisters it
+// Changes made by writing g.Vertices or g.Edges directly are not observed
+func (g *Graph) Observe(observer MutationObserver) (cancel func()) {
+	sub := &mutationSubscription{observer: observer}
+	g.observers = append(g.observers, sub)
+	return func() {
+		g.observers = slices.DeleteFunc(g.observers, func(s *mutationSubscription) bool {
+			return s == sub
+		})
+	}
+}
+
+// notify delivers a mutation to every observer in registration order
+func (g *Graph) notify(m Mutation) {
+	for _, sub := range g.observers {
+		sub.observer.OnMutation(m)
+	}
+}
+
+// RemoveEdge removes an edge, and its reverse copy in undirected graphs
+// Either the edge from g.Edges or its reverse adjacency copy may be passed
+// It reports whether the edge was part of the graph
+func (g *Graph) RemoveEdge(edge *Edge) bool {
+	if edge == nil {
+		return false
+	}
+	i := slices.Index(g.Edges, edge)
+	if i < 0 && edge.twin != nil {
+		edge = edge.twin
+		i = slices.Index(g.Edges, edge)
+	}
+	if i < 0 {
+		return false
+	}
+	g.Edges = slices.Delete(g.Edges, i, i+1)
+	g.index = nil
+	g.dense = nil
+	if g.expiry != nil {
+		delete(g.expiry, edge)
+		delete(g.expiry, edge.twin)
+	}
+
+	g.removeAdjacent(edge.From.ID, edge)
+	if !g.Directed {
+		reverse := edge.twin
+		if reverse == nil {
+			// Graphs assembled by hand may lack the twin link; match by shape
+			for _, e := range g.Vertices[edge.To.ID].Edges {
+				if e.To.ID == edge.From.ID && e.Weight == edge.Weight && e != edge {
+					reverse = e
+					break
+				}
+			}
+		}
+		g.removeAdjacent(edge.To.ID, reverse)
+	}
+
+	g.notify(Mutation{Kind: MutationEdgeRemoved, Edge: edge})
+	return true
+}
+
+// removeAdjacent drops edge from the adjacency list of vertex id
+func (g *Graph) removeAdjacent(id int, edge *Edge) {
+	v, exists := g.Vertices[id]
+	if !exists || edge == nil {
+		return
+	}
+	if i := slices.Index(v.Edges, edge); i >= 0 {
+		v.Edges = slices.Delete(v.Edges, i, i+1)
+		g.Vertices[id] = v
+	}
+}
+
+// RemoveVertex removes a vertex together with every edge touching it
+// Observers see one MutationEdgeRemoved per edge before MutationVertexRemoved
+// It reports whether the vertex was part of the graph
+func (g *Graph) RemoveVertex(id int) bool {
+	if _, exists := g.Vertices[id]; !exists {
+		return false
+	}
+
+	incident := make([]*Edge, 0)
+	for _, edge := range g.Edges {
+		if edge.From.ID == id || edge.To.ID == id {
+			incident = append(incident, edge)
+		}
+	}
+	for _, edge := range incident {
+		g.RemoveEdge(edge)
+	}
+
+	v := g.Vertices[id]
+	delete(g.Vertices, id)
+	g.dense = nil
+	g.names.remove(v)
+	g.notify(Mutation{Kind: MutationVertexRemoved, Vertex: &v})
+	return true
+}
@@ -0,0 +1,87 @@
+package mst
+
+import (
+	"fmt"
+	"testing"
+)
+
+// TestObserve tests that observers see every mutation in order
+func TestObserve(t *testing.T) {
+	fmt.Println("\n=== MUTATION HOOKS TEST ===")
+
+	g := NewGraph(false)
+	kinds := make([]MutationKind, 0)
+	cancel := g.Observe(MutationFunc(func(m Mutation) {
+		kinds = append(kinds, m.Kind)
+		if m.Kind == MutationEdgeWeightChanged && m.OldWeight != 4 {
+			t.Errorf("Expected old weight 4, got %d", m.OldWeight)
+		}
+	}))
+
+	v0 := Vertex{ID: 0, Name: "A"}
+	v1 := Vertex{ID: 1, Name: "B"}
+	v2 := Vertex{ID: 2, Name: "C"}
+	ab := g.AddEdge(Edge{From: &v0, To: &v1, Weight: 4})
+	g.AddEdges([]Edge{{From: &v1, To: &v2, Weight: 2}})
+	g.SetEdgeWeight(ab, 5)
+
+	want := []MutationKind{
+		MutationVertexAdded, MutationVertexAdded, MutationEdgeAdded,
+		MutationVertexAdded, MutationEdgeAdded,
+		MutationEdgeWeightChanged,
+	}
+	if fmt.Sprint(kinds) != fmt.Sprint(want) {
+		t.Errorf("Expected %v, got %v", want, kinds)
+	}
+
+	cancel()
+	g.AddVertex(Vertex{ID: 3})
+	if len(kinds) != len(want) {
+		t.Error("Expected no events after cancel")
+	}
+}
+
+// TestRemoveEdge tests removing edges and vertices
+func TestRemoveEdge(t *testing.T) {
+	g := NewGraph(false)
+	v := make([]Vertex, 3)
+	for i := range v {
+		v[i] = Vertex{ID: i}
+	}
+	ab := g.AddEdge(Edge{From: &v[0], To: &v[1], Weight: 1})
+	g.AddEdge(Edge{From: &v[1], To: &v[2], Weight: 2})
+	g.AddEdge(Edge{From: &v[0], To: &v[2], Weight: 3})
+
+	removed := 0
+	g.Observe(MutationFunc(func(m Mutation) {
+		if m.Kind == MutationEdgeRemoved {
+			removed++
+		}
+	}))
+
+	// Removing through the reverse copy removes both directions
+	back, _ := g.GetEdge(1, 0)
+	if !g.RemoveEdge(back) {
+		t.Fatal("Expected RemoveEdge to succeed")
+	}
+	if g.RemoveEdge(ab) {
+		t.Error("Expected second removal to fail")
+	}
+	if g.HasEdge(0, 1) || g.HasEdge(1, 0) || g.EdgeCount() != 2 {
+		t.Error("Expected edge 0-1 to be gone in both directions")
+	}
+	if _, w := g.Kruskal(); w != 5 {
+		t.Errorf("Expected MST weight 5, got %d", w)
+	}
+
+	if !g.RemoveVertex(2) {
+		t.Fatal("Expected RemoveVertex to succeed")
+	}
+	if g.VertexCount() != 2 || g.EdgeCount() != 0 || removed != 3 {
+		t.Errorf("Expected 2 vertices, 0 edges and 3 removals, got %d, %d and %d",
+			g.VertexCount(), g.EdgeCount(), removed)
+	}
+	if n, _ := g.GetVertex(0); len(n.Edges) != 0 {
+		t.Errorf("Expected vertex 0 to have no edges, got %d", len(n.Edges))
+	}
+}
@@ -0,0 +1,136 @@
+package mst
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+)
+
+// ==================== NAME INDEX ====================
+
+// ErrDuplicateName is returned when unique names are required but two vertices share one
+var ErrDuplicateName = errors.New("duplicate vertex name")
+
+// nameIndex maps vertex names to the IDs carrying them, in insertion order
+// Empty names are not indexed
+type nameIndex struct {
+	ids    map[string][]int
+	unique bool
+}
+
+// nameIndex returns the name index, building it from g.Vertices on first use
+// Vertices written directly into g.Vertices afterwards are not tracked
+func (g *Graph) nameIndex() *nameIndex {
+	if g.names != nil {
+		return g.names
+	}
+	ids := g.SortedVertexIDs()
+
+	g.names = &nameIndex{ids: make(map[string][]int, len(ids))}
+	for _, id := range ids {
+		g.names.add(g.Vertices[id])
+	}
+	return g.names
+}
+
+// add indexes a vertex under its name
+func (n *nameIndex) add(v Vertex) {
+	if n == nil || v.Name == "" {
+		return
+	}
+	n.ids[v.Name] = append(n.ids[v.Name], v.ID)
+}
+
+// conflict returns the ID already holding v's name when names must be unique
+func (n *nameIndex) conflict(v Vertex) (int, bool) {
+	if n == nil || !n.unique || v.Name == "" {
+		return 0, false
+	}
+	for _, id := range n.ids[v.Name] {
+		if id != v.ID {
+			return id, true
+		}
+	}
+	return 0, false
+}
+
+// SetUniqueNames turns the unique-name constraint on or off
+// While it is on, AddVertex, AddEdge and AddEdges reject a new vertex whose
+// name is already taken, SetVertexName fails with ErrDuplicateName, and a
+// Builder made with WithUniqueNames reports the conflict from Build
+// Turning it on fails with ErrDuplicateName if names already collide
+func (g *Graph) SetUniqueNames(unique bool) error {
+	n := g.nameIndex()
+	if unique {
+		names := make([]string, 0)
+		for name, ids := range n.ids {
+			if len(ids) > 1 {
+				names = append(names, name)
+			}
+		}
+		if len(names) > 0 {
+			sort.Strings(names)
+			return fmt.Errorf("%w: %q", ErrDuplicateName, names)
+		}
+	}
+	n.unique = unique
+	return nil
+}
+
+// SetVertexName renames a vertex of the graph and keeps the name index in step
+// While unique names are on, a name held by another vertex fails with
+// ErrDuplicateName. Observers are notified with MutationVertexNameChanged
+func (g *Graph) SetVertexName(id int, name string) error {
+	v, exists := g.Vertices[id]
+	if !exists {
+		return fmt.Errorf("rename %d: %w", id, ErrVertexNotFound)
+	}
+	old := v.Name
+	if old == name {
+		return nil
+	}
+	if other, taken := g.names.conflict(Vertex{ID: id, Name: name}); taken {
+		return fmt.Errorf("rename %d: %w %q (also vertex %d)", id, ErrDuplicateName, name, other)
+	}
+	g.names.remove(v)
+	v.Name = name
+	g.Vertices[id] = v
+	g.names.add(v)
+	g.notify(Mutation{Kind: MutationVertexNameChanged, Vertex: &v, OldValue: old})
+	return nil
+}
+
+// GetVertexByName returns the vertex with the given name
+// If several vertices share the name, the one added first is returned
+func (g *Graph) GetVertexByName(name string) (*Vertex, bool) {
+	ids := g.nameIndex().ids[name]
+	if len(ids) == 0 {
+		return nil, false
+	}
+	return g.GetVertex(ids[0])
+}
+
+// GetVerticesByName returns every vertex with the given name in insertion order
+func (g *Graph) GetVerticesByName(name string) []*Vertex {
+	ids := g.nameIndex().ids[name]
+	vertices := make([]*Vertex, 0, len(ids))
+	for _, id := range ids {
+		v, _ := g.GetVertex(id)
+		vertices = append(vertices, v)
+	}
+	return vertices
+}
+
+// remove drops a vertex from the index
+func (n *nameIndex) remove(v Vertex) {
+	if n == nil || v.Name == "" {
+		return
+	}
+	n.ids[v.Name] = slices.DeleteFunc(n.ids[v.Name], func(id int) bool {
+		return id == v.ID
+	})
+	if len(n.ids[v.Name]) == 0 {
+		delete(n.ids, v.Name)
+	}
+}
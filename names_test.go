@@ -0,0 +1,114 @@
+package mst
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// TestGetVertexByName tests name lookups and the unique-name constraint
+func TestGetVertexByName(t *testing.T) {
+	fmt.Println("\n=== VERTEX NAME INDEX TEST ===")
+
+	g := NewGraph(false)
+	istanbul := Vertex{ID: 0, Name: "Istanbul"}
+	ankara := Vertex{ID: 1, Name: "Ankara"}
+	g.AddEdge(Edge{From: &istanbul, To: &ankara, Weight: 450})
+
+	v, ok := g.GetVertexByName("Ankara")
+	if !ok || v.ID != 1 {
+		t.Fatalf("Expected Ankara to be vertex 1, got %v (ok=%v)", v, ok)
+	}
+	if _, ok := g.GetVertexByName("Izmir"); ok {
+		t.Error("Expected Izmir to be missing")
+	}
+
+	// Duplicates are allowed until the constraint is switched on
+	g.AddVertex(Vertex{ID: 2, Name: "Ankara"})
+	if n := len(g.GetVerticesByName("Ankara")); n != 2 {
+		t.Errorf("Expected 2 vertices named Ankara, got %d", n)
+	}
+	if err := g.SetUniqueNames(true); !errors.Is(err, ErrDuplicateName) {
+		t.Errorf("Expected ErrDuplicateName, got %v", err)
+	}
+
+	u := NewGraph(false)
+	if err := u.SetUniqueNames(true); err != nil {
+		t.Fatalf("SetUniqueNames failed: %v", err)
+	}
+	izmir := Vertex{ID: 2, Name: "Izmir"}
+	u.AddEdge(Edge{From: &istanbul, To: &izmir, Weight: 330})
+
+	// A new ID with a taken name is rejected along with its edges
+	alias := Vertex{ID: 7, Name: "Izmir"}
+	if e := u.AddEdge(Edge{From: &ankara, To: &alias, Weight: 580}); e != nil {
+		t.Errorf("Expected AddEdge to reject a duplicate name, got %v", e)
+	}
+	added := u.AddEdges([]Edge{
+		{From: &Vertex{ID: 8, Name: "Istanbul"}, To: &ankara, Weight: 450},
+		{From: &izmir, To: &ankara, Weight: 580},
+	})
+	if added[0] != nil || added[1] == nil {
+		t.Errorf("Expected AddEdges to skip only the duplicate, got %v", added)
+	}
+	if v := u.AddVertex(Vertex{ID: 9, Name: "Ankara"}); v != nil {
+		t.Errorf("Expected AddVertex to reject a duplicate name, got %v", v)
+	}
+	if v := u.AddVertex(Vertex{ID: 1, Name: "Ankara"}); v == nil || v.ID != 1 {
+		t.Errorf("Expected an existing vertex to be returned, got %v", v)
+	}
+
+	if u.VertexCount() != 3 || u.EdgeCount() != 2 {
+		t.Errorf("Expected 3 vertices and 2 edges, got %d and %d", u.VertexCount(), u.EdgeCount())
+	}
+	for _, id := range []int{7, 8, 9} {
+		if _, exists := u.Vertices[id]; exists {
+			t.Errorf("Expected vertex %d not to be added", id)
+		}
+	}
+	if _, w := u.Kruskal(); w != 910 {
+		t.Errorf("Expected MST weight 910, got %d", w)
+	}
+
+	_, err := NewBuilder(WithUniqueNames()).
+		AddVertex(0, "Istanbul", nil).
+		AddVertex(1, "Istanbul", nil).
+		Build()
+	if !errors.Is(err, ErrDuplicateName) {
+		t.Errorf("Expected Build to report ErrDuplicateName, got %v", err)
+	}
+}
+
+// TestSetVertexName tests renaming through the graph, which keeps the index,
+// the unique-name constraint and transactions in step
+func TestSetVertexName(t *testing.T) {
+	fmt.Println("\n=== SET VERTEX NAME TEST ===")
+
+	g := NewGraph(false)
+	g.AddEdge(Edge{From: &Vertex{ID: 0, Name: "Istanbul"}, To: &Vertex{ID: 1, Name: "Ankara"}, Weight: 450})
+	if err := g.SetUniqueNames(true); err != nil {
+		t.Fatalf("SetUniqueNames failed: %v", err)
+	}
+
+	tx := g.Begin()
+	if err := g.SetVertexName(1, "Izmir"); err != nil {
+		t.Fatalf("SetVertexName failed: %v", err)
+	}
+	if _, ok := g.GetVertexByName("Ankara"); ok {
+		t.Error("Expected the old name to leave the index")
+	}
+	if v, ok := g.GetVertexByName("Izmir"); !ok || v.ID != 1 {
+		t.Errorf("Expected Izmir to be vertex 1, got %v (ok=%v)", v, ok)
+	}
+	if err := g.SetVertexName(0, "Izmir"); !errors.Is(err, ErrDuplicateName) {
+		t.Errorf("Expected ErrDuplicateName, got %v", err)
+	}
+	if err := g.SetVertexName(5, "Bursa"); !errors.Is(err, ErrVertexNotFound) {
+		t.Errorf("Expected ErrVertexNotFound, got %v", err)
+	}
+
+	tx.Rollback()
+	if v, ok := g.GetVertexByName("Ankara"); !ok || v.ID != 1 || g.Vertices[1].Name != "Ankara" {
+		t.Errorf("Expected the rollback to restore Ankara, got %v (ok=%v)", v, ok)
+	}
+}
@@ -0,0 +1,329 @@
+package mst
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+)
+
+// ==================== NODE-LINK JSON ====================
+
+// NodeLink is the node-link JSON format of NetworkX and D3
+//
+//	{"directed": false, "multigraph": false, "graph": {},
+//	 "nodes": [{"id": 0, "name": "A"}], "links": [{"source": 0, "target": 1, "weight": 4}]}
+//
+// Edge.Weights is written as an object of integers under "weights", and a
+// non-zero Vertex.Weight under the weight key of its node. Every other node
+// or link key maps to Attrs. Vertex Data and Edge Data are not
+// written, since they can hold values JSON cannot represent
+// The zero value uses the NetworkX default keys
+type NodeLink struct {
+	WeightKey  string // link key holding Edge.Weight, "weight" by default
+	WeightsKey string // link key holding Edge.Weights, "weights" by default
+	NameKey    string // node key holding Vertex.Name, "name" by default
+	LinksKey   string // key of the link list when writing, "links" by default
+}
+
+// WriteNodeLink writes the graph as NetworkX node-link JSON with the default keys
+func (g *Graph) WriteNodeLink(w io.Writer) error {
+	return NodeLink{}.Encode(w, g)
+}
+
+// ReadNodeLink reads a graph from NetworkX node-link JSON with the default keys
+func ReadNodeLink(r io.Reader) (Graph, error) {
+	return NodeLink{}.Decode(r)
+}
+
+func (f NodeLink) keys() (weight, weights, name, links string) {
+	weight, weights, name, links = f.WeightKey, f.WeightsKey, f.NameKey, f.LinksKey
+	if weight == "" {
+		weight = "weight"
+	}
+	if weights == "" {
+		weights = "weights"
+	}
+	if name == "" {
+		name = "name"
+	}
+	if links == "" {
+		links = "links"
+	}
+	return weight, weights, name, links
+}
+
+// Encode writes g as node-link JSON
+// Parallel edges set "multigraph" and get a per-pair "key" like in NetworkX
+// Attributes are written first, so Edge.Weight, Edge.Weights, and the link
+// endpoints replace attributes stored under their keys. Only the exact value
+// of a fractional weight read by Decode, a number that rounds to Edge.Weight,
+// is written in place of Edge.Weight
+func (f NodeLink) Encode(w io.Writer, g *Graph) error {
+	weightKey, weightsKey, nameKey, linksKey := f.keys()
+	multigraph := g.duplicateEdgeCount() > 0
+
+	nodes := make([]map[string]any, 0, len(g.Vertices))
+	for _, id := range g.SortedVertexIDs() {
+		v := g.Vertices[id]
+		node := make(map[string]any, len(v.Attrs)+2)
+		for k, value := range v.Attrs {
+			node[k] = value
+		}
+		if v.Weight != 0 {
+			node[weightKey] = v.Weight
+		}
+		if v.Name != "" {
+			node[nameKey] = v.Name
+		}
+		node["id"] = id
+		nodes = append(nodes, node)
+	}
+
+	keys := make(map[arcKey]int)
+	links := make([]map[string]any, 0, len(g.Edges))
+	for _, e := range g.Edges {
+		link := make(map[string]any, len(e.Attrs)+5)
+		for k, value := range e.Attrs {
+			link[k] = value
+		}
+		if weight, _, ok := weightOf(jsonNumber(link[weightKey])); !ok || weight != e.Weight {
+			link[weightKey] = e.Weight
+		}
+		if len(e.Weights) > 0 {
+			link[weightsKey] = e.Weights
+		} else {
+			delete(link, weightsKey)
+		}
+		link["source"], link["target"] = e.From.ID, e.To.ID
+		if multigraph {
+			pair := arcKey{from: e.From.ID, to: e.To.ID}
+			if !g.Directed && pair.from > pair.to {
+				pair.from, pair.to = pair.to, pair.from
+			}
+			link["key"] = keys[pair]
+			keys[pair]++
+		}
+		links = append(links, link)
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(map[string]any{
+		"directed":   g.Directed,
+		"multigraph": multigraph,
+		"graph":      map[string]any{},
+		"nodes":      nodes,
+		linksKey:     links,
+	})
+}
+
+// Decode reads a node-link JSON graph
+// Links are read from "links" or, as written by newer NetworkX, "edges"
+// Integer node ids become vertex IDs; if any id is not an integer, vertices
+// are numbered in node order and a string id becomes the Name when no name
+// key is present. Fractional weights are rounded to the nearest integer and
+// the exact value is kept in Attrs under the weight key; a missing weight is 1.
+// An object of integers under the weights key becomes Edge.Weights, and an
+// integer under the weight key of a node becomes Vertex.Weight
+func (f NodeLink) Decode(r io.Reader) (Graph, error) {
+	weightKey, weightsKey, nameKey, _ := f.keys()
+
+	var doc struct {
+		Directed bool             `json:"directed"`
+		Nodes    []map[string]any `json:"nodes"`
+		Links    []map[string]any `json:"links"`
+		Edges    []map[string]any `json:"edges"`
+	}
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+	if err := dec.Decode(&doc); err != nil {
+		return Graph{}, fmt.Errorf("node-link: %w", err)
+	}
+	links := doc.Links
+	if links == nil {
+		links = doc.Edges
+	}
+
+	g := NewGraph(doc.Directed)
+
+	// Decide how node ids map to vertex IDs
+	numeric := true
+	for _, node := range doc.Nodes {
+		if _, ok := integerOf(node["id"]); !ok {
+			numeric = false
+			break
+		}
+	}
+	ids := make(map[string]int, len(doc.Nodes))
+	vertices := make(map[int]*Vertex, len(doc.Nodes))
+	for i, node := range doc.Nodes {
+		raw, present := node["id"]
+		if !present {
+			return Graph{}, fmt.Errorf("node-link: node %d has no id", i)
+		}
+		id := i
+		if numeric {
+			id, _ = integerOf(raw)
+		}
+		key := fmt.Sprint(raw)
+		if _, dup := ids[key]; dup {
+			return Graph{}, fmt.Errorf("node-link: duplicate node id %v", raw)
+		}
+		ids[key] = id
+
+		v := &Vertex{ID: id}
+		for k, value := range node {
+			switch {
+			case k == "id":
+				if s, isString := value.(string); isString && !numeric {
+					if _, named := node[nameKey]; !named {
+						v.Name = s
+					}
+				}
+			case k == nameKey:
+				if s, isString := value.(string); isString {
+					v.Name = s
+					continue
+				}
+				v.SetAttr(k, jsonValue(value))
+			case k == weightKey:
+				if w, isInt := integerOf(value); isInt {
+					v.Weight = w
+					continue
+				}
+				v.SetAttr(k, jsonValue(value))
+			default:
+				v.SetAttr(k, jsonValue(value))
+			}
+		}
+		vertices[id] = v
+		g.AddVertex(*v)
+	}
+
+	edges := make([]Edge, 0, len(links))
+	for i, link := range links {
+		endpoint := func(key string) (*Vertex, error) {
+			id, known := ids[fmt.Sprint(link[key])]
+			if !known {
+				return nil, fmt.Errorf("node-link: link %d has unknown %s %v", i, key, link[key])
+			}
+			return vertices[id], nil
+		}
+		from, err := endpoint("source")
+		if err != nil {
+			return Graph{}, err
+		}
+		to, err := endpoint("target")
+		if err != nil {
+			return Graph{}, err
+		}
+
+		edge := Edge{From: from, To: to, Weight: 1}
+		for k, value := range link {
+			switch k {
+			case "source", "target", "key":
+			case weightKey:
+				w, exact, ok := weightOf(value)
+				if !ok {
+					return Graph{}, fmt.Errorf("node-link: link %d has non-numeric %s %v", i, k, value)
+				}
+				edge.Weight = w
+				if !exact {
+					edge.SetAttr(k, jsonValue(value))
+				}
+			case weightsKey:
+				weights, ok := criteriaOf(value)
+				if !ok {
+					edge.SetAttr(k, jsonValue(value))
+					continue
+				}
+				edge.Weights = weights
+			default:
+				edge.SetAttr(k, jsonValue(value))
+			}
+		}
+		edges = append(edges, edge)
+	}
+	g.AddEdges(edges)
+	return g, nil
+}
+
+// integerOf returns a JSON number as an int if it is a whole number
+func integerOf(v any) (int, bool) {
+	n, ok := v.(json.Number)
+	if !ok {
+		return 0, false
+	}
+	i, err := n.Int64()
+	return int(i), err == nil
+}
+
+// weightOf converts a JSON number to a weight, rounding fractional values
+func weightOf(v any) (weight int, exact, ok bool) {
+	if i, isInt := integerOf(v); isInt {
+		return i, true, true
+	}
+	n, isNumber := v.(json.Number)
+	if !isNumber {
+		return 0, false, false
+	}
+	f, err := n.Float64()
+	if err != nil {
+		return 0, false, false
+	}
+	return int(math.Round(f)), f == math.Round(f), true
+}
+
+// criteriaOf converts a JSON object of whole numbers to named criteria
+func criteriaOf(v any) (map[string]int, bool) {
+	object, isObject := v.(map[string]any)
+	if !isObject {
+		return nil, false
+	}
+	weights := make(map[string]int, len(object))
+	for k, value := range object {
+		w, isInt := integerOf(value)
+		if !isInt {
+			return nil, false
+		}
+		weights[k] = w
+	}
+	return weights, true
+}
+
+// jsonNumber turns a Go number, as found in Attrs, into the json.Number
+// Decode would have read for it; other values are returned unchanged
+func jsonNumber(v any) any {
+	switch n := v.(type) {
+	case int:
+		return json.Number(strconv.Itoa(n))
+	case float64:
+		return json.Number(strconv.FormatFloat(n, 'g', -1, 64))
+	}
+	return v
+}
+
+// jsonValue replaces json.Number with int or float64 throughout a decoded value
+func jsonValue(v any) any {
+	switch v := v.(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return int(i)
+		}
+		f, _ := v.Float64()
+		return f
+	case []any:
+		for i := range v {
+			v[i] = jsonValue(v[i])
+		}
+		return v
+	case map[string]any:
+		for k := range v {
+			v[k] = jsonValue(v[k])
+		}
+		return v
+	}
+	return v
+}
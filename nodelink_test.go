@@ -0,0 +1,141 @@
+package mst
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// TestNodeLinkRoundTrip tests writing and reading node-link JSON
+func TestNodeLinkRoundTrip(t *testing.T) {
+	fmt.Println("\n=== NODE-LINK ROUND TRIP TEST ===")
+
+	g := NewGraph(false)
+	a := Vertex{ID: 0, Name: "A"}
+	a.SetAttr("city", "Baku")
+	b := Vertex{ID: 1, Name: "B"}
+	c := Vertex{ID: 7}
+	g.AddEdge(Edge{From: &a, To: &b, Weight: 4}).SetAttr("cable", "fiber")
+	g.AddEdge(Edge{From: &b, To: &c, Weight: 2})
+	g.AddEdge(Edge{From: &c, To: &b, Weight: 5})
+
+	var buf bytes.Buffer
+	if err := g.WriteNodeLink(&buf); err != nil {
+		t.Fatalf("WriteNodeLink failed: %v", err)
+	}
+	fmt.Print(buf.String())
+	if !strings.Contains(buf.String(), `"multigraph": true`) {
+		t.Error("Parallel edges should mark the graph as a multigraph")
+	}
+
+	back, err := ReadNodeLink(&buf)
+	if err != nil {
+		t.Fatalf("ReadNodeLink failed: %v", err)
+	}
+	if back.Hash() != g.Hash() {
+		t.Error("Round trip changed the graph structure")
+	}
+	if back.Vertices[0].Name != "A" || back.Vertices[7].Name != "" {
+		t.Errorf("Names not preserved: %q %q", back.Vertices[0].Name, back.Vertices[7].Name)
+	}
+	if city, _ := back.Vertices[0].Attrs.GetString("city"); city != "Baku" {
+		t.Errorf("Vertex attribute lost, got %q", city)
+	}
+	if e, _ := back.GetEdge(0, 1); e == nil || e.Attrs["cable"] != "fiber" {
+		t.Error("Edge attribute lost")
+	}
+	if _, has := back.Vertices[0].Attrs["id"]; has {
+		t.Error("The id key must not become an attribute")
+	}
+}
+
+// TestNodeLinkNetworkX tests reading a NetworkX document with string ids
+func TestNodeLinkNetworkX(t *testing.T) {
+	fmt.Println("\n=== NODE-LINK NETWORKX TEST ===")
+
+	doc := `{"directed": true, "multigraph": false, "graph": {},
+		"nodes": [{"id": "lhr", "size": 3}, {"id": "jfk"}, {"id": "sfo"}],
+		"edges": [{"source": "lhr", "target": "jfk", "cost": 5.5},
+		          {"source": "jfk", "target": "sfo", "cost": 3, "key": 0}]}`
+
+	g, err := NodeLink{WeightKey: "cost"}.Decode(strings.NewReader(doc))
+	if err != nil {
+		t.Fatalf("Decode failed: %v", err)
+	}
+	g.Print()
+	if !g.Directed || g.VertexCount() != 3 || g.EdgeCount() != 2 {
+		t.Fatalf("Expected a directed graph with 3 vertices and 2 arcs")
+	}
+	if lhr, _ := g.GetVertexByName("lhr"); lhr == nil || lhr.ID != 0 {
+		t.Error("String ids should become names, numbered in node order")
+	}
+	if size, _ := g.Vertices[0].Attrs.GetInt("size"); size != 3 {
+		t.Errorf("Expected size 3, got %d", size)
+	}
+	e, _ := g.GetEdge(0, 1)
+	if f, _ := e.GetFloat("cost"); e.Weight != 6 || f != 5.5 {
+		t.Errorf("Expected weight 6 with exact cost 5.5, got %d and %v", e.Weight, f)
+	}
+	if _, has := e.Attrs["key"]; has {
+		t.Error("The multigraph key must not become an attribute")
+	}
+
+	var buf bytes.Buffer
+	if err := (NodeLink{WeightKey: "cost"}).Encode(&buf, &g); err != nil {
+		t.Fatalf("Encode failed: %v", err)
+	}
+	if !strings.Contains(buf.String(), `"cost": 5.5`) {
+		t.Error("Exact fractional weight should be written back")
+	}
+
+	for _, bad := range []string{
+		`{"nodes": [{"id": 0}], "links": [{"source": 0, "target": 9}]}`,
+		`{"nodes": [{"id": 0}, {"id": 0}]}`,
+		`{"nodes": [{"id": 0}], "links": [{"source": 0, "target": 0, "weight": "x"}]}`,
+		`{"nodes": [`,
+	} {
+		if _, err := ReadNodeLink(strings.NewReader(bad)); err == nil {
+			t.Errorf("Expected an error for %s", bad)
+		}
+	}
+}
+
+// TestNodeLinkWeightKeys tests that Edge.Weight wins over an attribute of
+// the same name and that Edge.Weights survives a round trip
+func TestNodeLinkWeightKeys(t *testing.T) {
+	g := NewGraph(false)
+	g.AddEdge(Edge{
+		From:    &Vertex{ID: 0},
+		To:      &Vertex{ID: 1},
+		Weight:  4,
+		Weights: map[string]int{"latency": 12, "loss": 0},
+		Attrs:   Attributes{"weight": "heavy", "weights": "many"},
+	})
+
+	var buf bytes.Buffer
+	if err := g.WriteNodeLink(&buf); err != nil {
+		t.Fatalf("WriteNodeLink failed: %v", err)
+	}
+	back, err := ReadNodeLink(&buf)
+	if err != nil {
+		t.Fatalf("ReadNodeLink failed: %v", err)
+	}
+	e := back.Edges[0]
+	if e.Weight != 4 {
+		t.Errorf("Expected weight 4 over the attribute, got %d", e.Weight)
+	}
+	if len(e.Weights) != 2 || e.Weights["latency"] != 12 || e.Weights["loss"] != 0 {
+		t.Errorf("Expected latency 12 and loss 0, got %v", e.Weights)
+	}
+
+	// An object that is not all integers stays an attribute
+	doc := `{"nodes": [{"id": 0}], "links": [{"source": 0, "target": 0, "weights": {"a": "x"}}]}`
+	g, err = ReadNodeLink(strings.NewReader(doc))
+	if err != nil {
+		t.Fatalf("ReadNodeLink failed: %v", err)
+	}
+	if e := g.Edges[0]; e.Weights != nil || e.Attrs["weights"] == nil {
+		t.Errorf("Expected a non-integer weights object to stay an attribute, got %v and %v", e.Weights, e.Attrs)
+	}
+}
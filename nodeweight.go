@@ -0,0 +1,203 @@
+package mst
+
+import (
+	"fmt"
+	"slices"
+)
+
+// ==================== NODE-WEIGHTED PROBLEMS ====================
+
+// SetVertexWeight sets the activation cost of a vertex
+// Edges keep their own copies of their endpoints, so node-weighted code
+// reads weights from g.Vertices rather than from Edge.From or Edge.To
+func (g *Graph) SetVertexWeight(id, weight int) error {
+	v, exists := g.Vertices[id]
+	if !exists {
+		return fmt.Errorf("set weight of %d: %w", id, ErrVertexNotFound)
+	}
+	old := v.Weight
+	v.Weight = weight
+	g.Vertices[id] = v
+	if old != weight {
+		g.notify(Mutation{Kind: MutationVertexWeightChanged, Vertex: &v, OldWeight: old})
+	}
+	return nil
+}
+
+// ActivationCost returns the weight of the edges plus the weight of every
+// vertex they touch, each vertex counted once
+// This is the objective of node-weighted design, where a site costs money
+// as soon as any link uses it
+func (g *Graph) ActivationCost(edges []*Edge) int {
+	active := make(map[int]bool, len(edges)+1)
+	total := 0
+	for _, e := range edges {
+		total += e.Weight
+		for _, id := range [2]int{e.From.ID, e.To.ID} {
+			if !active[id] {
+				active[id] = true
+				total += g.Vertices[id].Weight
+			}
+		}
+	}
+	return total
+}
+
+// NodeWeightedSteinerTree connects the terminal vertices with a tree that
+// keeps the cost of its edges and of the vertices it activates low, and
+// returns the tree and that cost as reported by ActivationCost
+// Terminals are always paid for; other vertices are used only when they
+// are cheaper than going around them. Starting from one terminal, the tree
+// repeatedly grows along the cheapest path to the nearest unconnected
+// terminal, where entering a vertex costs its weight; the chosen vertices
+// are then respanned by an MST and non-terminal leaves are pruned. This is
+// a heuristic: node-weighted Steiner tree cannot be approximated within
+// better than a logarithmic factor, and this one makes no such guarantee.
+// Weights must be non-negative. Unknown terminals return an error wrapping
+// ErrVertexNotFound, and terminals in different components one wrapping
+// ErrDisconnected
+func (g *Graph) NodeWeightedSteinerTree(terminals []int) ([]*Edge, int, error) {
+	if g.Directed {
+		panic("Steiner tree only works for undirected graphs")
+	}
+
+	terminals = slices.Clone(terminals)
+	slices.Sort(terminals)
+	terminals = slices.Compact(terminals)
+	isTerminal := make(map[int]bool, len(terminals))
+	for _, id := range terminals {
+		if _, exists := g.Vertices[id]; !exists {
+			return nil, 0, fmt.Errorf("steiner terminal %d: %w", id, ErrVertexNotFound)
+		}
+		isTerminal[id] = true
+	}
+	if len(terminals) == 0 {
+		return []*Edge{}, 0, nil
+	}
+
+	inTree := map[int]bool{terminals[0]: true}
+	pending := len(terminals) - 1
+	for pending > 0 {
+		reached, pred := g.nearestVertex(inTree, func(id int) bool {
+			return isTerminal[id] && !inTree[id]
+		})
+		if pred == nil {
+			return nil, 0, fmt.Errorf("steiner terminals %d and %d: %w", terminals[0], firstMissing(terminals, inTree), ErrDisconnected)
+		}
+		for id := reached; !inTree[id]; {
+			inTree[id] = true
+			id = pred[id].From.ID
+		}
+		pending--
+	}
+
+	tree := g.spanVertices(inTree)
+	tree = pruneSteinerLeaves(tree, isTerminal)
+	total := g.ActivationCost(tree)
+	if len(tree) == 0 {
+		total = g.Vertices[terminals[0]].Weight
+	}
+	return tree, total, nil
+}
+
+// nearestVertex runs Dijkstra from every vertex in sources at once, where
+// entering vertex v along edge e costs e.Weight plus v's weight, until it
+// settles a vertex target accepts
+// It returns that vertex and the edge each settled vertex was reached by,
+// or a nil map when no such vertex is reachable
+func (g *Graph) nearestVertex(sources map[int]bool, target func(id int) bool) (int, map[int]*Edge) {
+	heap := NewDAryHeap(4, len(g.Vertices))
+	for id := range sources {
+		heap.Push(id, 0, nil)
+	}
+	settled := make(map[int]bool, len(g.Vertices))
+	pred := make(map[int]*Edge)
+	for heap.Len() > 0 {
+		id, dist, via := heap.PopMin()
+		settled[id] = true
+		if via != nil {
+			pred[id] = via
+		}
+		if target(id) {
+			return id, pred
+		}
+		for _, e := range g.Vertices[id].Edges {
+			next := e.To.ID
+			if settled[next] || sources[next] {
+				continue
+			}
+			heap.Push(next, dist+e.Weight+g.Vertices[next].Weight, e)
+		}
+	}
+	return 0, nil
+}
+
+// spanVertices returns a minimum spanning forest of the subgraph induced
+// by a vertex set
+func (g *Graph) spanVertices(vertices map[int]bool) []*Edge {
+	edges := make([]*Edge, 0)
+	for _, e := range g.Edges {
+		if vertices[e.From.ID] && vertices[e.To.ID] {
+			edges = append(edges, e)
+		}
+	}
+	sortEdgesByWeight(edges)
+
+	uf := NewUnionFind()
+	for id := range vertices {
+		uf.MakeSet(id)
+	}
+	tree := make([]*Edge, 0, len(vertices))
+	for _, e := range edges {
+		if uf.Union(e.From.ID, e.To.ID) {
+			tree = append(tree, e)
+		}
+	}
+	return tree
+}
+
+// pruneSteinerLeaves repeatedly removes tree edges that end in a
+// non-terminal leaf, which with non-negative weights never raises the cost
+func pruneSteinerLeaves(tree []*Edge, isTerminal map[int]bool) []*Edge {
+	degree := make(map[int]int)
+	for _, e := range tree {
+		degree[e.From.ID]++
+		degree[e.To.ID]++
+	}
+	removed := make([]bool, len(tree))
+	for changed := true; changed; {
+		changed = false
+		for i, e := range tree {
+			if removed[i] {
+				continue
+			}
+			for _, id := range [2]int{e.From.ID, e.To.ID} {
+				if degree[id] == 1 && !isTerminal[id] {
+					removed[i] = true
+					degree[e.From.ID]--
+					degree[e.To.ID]--
+					changed = true
+					break
+				}
+			}
+		}
+	}
+
+	kept := make([]*Edge, 0, len(tree))
+	for i, e := range tree {
+		if !removed[i] {
+			kept = append(kept, e)
+		}
+	}
+	return kept
+}
+
+// firstMissing returns the first terminal not yet in the tree
+func firstMissing(terminals []int, inTree map[int]bool) int {
+	for _, id := range terminals {
+		if !inTree[id] {
+			return id
+		}
+	}
+	return terminals[0]
+}
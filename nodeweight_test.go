@@ -0,0 +1,112 @@
+package mst
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// buildSiteGraph returns three terminal sites joined either through an
+// expensive hub (3) with cheap links or along a chain of free relays (4, 5)
+func buildSiteGraph() Graph {
+	g := NewGraph(false)
+	for id := range 6 {
+		g.AddVertex(Vertex{ID: id, Weight: 10})
+	}
+	g.SetVertexWeight(3, 100)
+	g.SetVertexWeight(4, 0)
+	g.SetVertexWeight(5, 0)
+	v := func(id int) *Vertex { return &Vertex{ID: id} }
+	g.AddEdges([]Edge{
+		{From: v(0), To: v(3), Weight: 1},
+		{From: v(1), To: v(3), Weight: 1},
+		{From: v(2), To: v(3), Weight: 1},
+		{From: v(0), To: v(4), Weight: 5},
+		{From: v(4), To: v(1), Weight: 5},
+		{From: v(1), To: v(5), Weight: 5},
+		{From: v(5), To: v(2), Weight: 5},
+	})
+	return g
+}
+
+// TestNodeWeightedSteinerTree tests that vertex costs steer the tree away from expensive sites
+func TestNodeWeightedSteinerTree(t *testing.T) {
+	fmt.Println("\n=== NODE-WEIGHTED STEINER TREE TEST ===")
+
+	g := buildSiteGraph()
+	tree, total, err := g.NodeWeightedSteinerTree([]int{0, 1, 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	PrintMST(tree, total, "NODE-WEIGHTED STEINER TREE")
+
+	// Four relay links and three terminal sites beat three hub links plus the hub
+	if total != 50 || len(tree) != 4 {
+		t.Errorf("Expected 4 edges with cost 50, got %d edges with cost %d", len(tree), total)
+	}
+	for _, e := range tree {
+		if e.From.ID == 3 || e.To.ID == 3 {
+			t.Errorf("Expected the expensive hub to stay inactive, got edge %d-%d", e.From.ID, e.To.ID)
+		}
+	}
+	if cost := g.ActivationCost(tree); cost != total {
+		t.Errorf("Expected ActivationCost %d, got %d", total, cost)
+	}
+
+	// A cheap hub wins again, and unused relays are pruned
+	g.SetVertexWeight(3, 1)
+	tree, total, _ = g.NodeWeightedSteinerTree([]int{2, 0, 1, 0})
+	if total != 34 || len(tree) != 3 {
+		t.Errorf("Expected the hub tree with cost 34, got %d edges with cost %d", len(tree), total)
+	}
+
+	if _, total, _ := g.NodeWeightedSteinerTree([]int{4}); total != 0 {
+		t.Errorf("Expected a lone free terminal to cost 0, got %d", total)
+	}
+	if _, _, err := g.NodeWeightedSteinerTree([]int{0, 9}); !errors.Is(err, ErrVertexNotFound) {
+		t.Errorf("Expected ErrVertexNotFound, got %v", err)
+	}
+	g.AddVertex(Vertex{ID: 9})
+	if _, _, err := g.NodeWeightedSteinerTree([]int{0, 9}); !errors.Is(err, ErrDisconnected) {
+		t.Errorf("Expected ErrDisconnected, got %v", err)
+	}
+}
+
+// TestVertexWeights tests that vertex weights are observed, rolled back, and persisted
+func TestVertexWeights(t *testing.T) {
+	fmt.Println("\n=== VERTEX WEIGHT TEST ===")
+
+	g := buildSiteGraph()
+	if err := g.SetVertexWeight(42, 1); !errors.Is(err, ErrVertexNotFound) {
+		t.Errorf("Expected ErrVertexNotFound, got %v", err)
+	}
+
+	tx := g.Begin()
+	g.SetVertexWeight(3, 7)
+	tx.Rollback()
+	if w := g.Vertices[3].Weight; w != 100 {
+		t.Errorf("Expected rollback to restore weight 100, got %d", w)
+	}
+
+	before := g.Snapshot()
+	g.SetVertexWeight(3, 7)
+	after := g.Snapshot()
+	old, _ := before.Vertex(3)
+	current, _ := after.Vertex(3)
+	if old.Weight != 100 || current.Weight != 7 {
+		t.Errorf("Expected versions to record weights 100 and 7, got %d and %d", old.Weight, current.Weight)
+	}
+
+	var buf bytes.Buffer
+	if err := g.WriteSnapshot(&buf); err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := ReadSnapshot(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if w := decoded.Vertices[3].Weight; w != 7 {
+		t.Errorf("Expected the snapshot to keep weight 7, got %d", w)
+	}
+}
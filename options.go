@@ -0,0 +1,71 @@
+package mst
+
+import "log/slog"
+
+// ==================== ALGORITHM OPTIONS ====================
+
+// Option configures the behavior of an MST algorithm
+type Option func(*options)
+
+// options holds the settings collected from a list of Option values
+type options struct {
+	heap    HeapKind
+	arity   int
+	onMerge func(edge *Edge, cluster ClusterStats)
+	tracer  Tracer
+	metrics Metrics
+	logger  *slog.Logger
+	epsilon float64         // tie tolerance for float weights
+	weight  func(*Edge) int // criterion minimized by Kruskal, nil for Edge.Weight
+
+	tieBreakers []EdgeComparator
+	step        int // last trace step number emitted
+	sortWorkers int // parallel sort workers for Kruskal, 0 for automatic
+
+	required    []*Edge
+	forbidden   []*Edge
+	constraints *edgeConstraints // resolved from required and forbidden
+}
+
+// newOptions applies opts over the default settings
+func newOptions(opts []Option) *options {
+	o := &options{
+		heap: HeapBinary,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// WithHeap selects the priority queue implementation used by PrimEager
+func WithHeap(kind HeapKind) Option {
+	return func(o *options) {
+		o.heap = kind
+	}
+}
+
+// WithDAryHeap makes PrimEager use a d-ary heap with the given branching factor
+// A value of 0 or less picks the arity from the graph's average degree
+func WithDAryHeap(d int) Option {
+	return func(o *options) {
+		o.heap = HeapDAry
+		o.arity = d
+	}
+}
+
+// WithMergeObserver registers a callback that Kruskal invokes after every
+// accepted edge with the statistics of the cluster that edge just formed
+// This allows streaming single-linkage clustering without post-processing
+func WithMergeObserver(fn func(edge *Edge, cluster ClusterStats)) Option {
+	return func(o *options) {
+		o.onMerge = fn
+	}
+}
+
+// WithTracer sends a TraceEvent to t for every step of the algorithm
+func WithTracer(t Tracer) Option {
+	return func(o *options) {
+		o.tracer = t
+	}
+}
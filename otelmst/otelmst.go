@@ -0,0 +1,137 @@
+// Package otelmst adds OpenTelemetry tracing to the mst package
+// It lives in its own module so the core package stays dependency-free
+// Each call runs the matching mst algorithm inside a span carrying the input
+// size, the result, and the algorithm's operation counters as attributes
+package otelmst
+
+import (
+	"context"
+	"io"
+
+	"github.com/l00pss/mst"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ScopeName is the instrumentation scope used for every span
+const ScopeName = "github.com/l00pss/mst/otelmst"
+
+// Instrumentation creates spans around mst computations
+type Instrumentation struct {
+	tracer trace.Tracer
+}
+
+// New creates an Instrumentation that uses tp, or the global provider if tp is nil
+func New(tp trace.TracerProvider) *Instrumentation {
+	if tp == nil {
+		tp = otel.GetTracerProvider()
+	}
+	return &Instrumentation{tracer: tp.Tracer(ScopeName)}
+}
+
+// Kruskal runs g.Kruskal inside a "mst.kruskal" span
+func (in *Instrumentation) Kruskal(ctx context.Context, g *mst.Graph, opts ...mst.Option) ([]*mst.Edge, int) {
+	span, rec, opts := in.start(ctx, "mst.kruskal", "kruskal", g, opts)
+	tree, weight := g.Kruskal(opts...)
+	in.finish(span, rec, "kruskal", tree, weight, nil)
+	return tree, weight
+}
+
+// KruskalStrict runs g.KruskalStrict inside a "mst.kruskal" span, recording any error
+func (in *Instrumentation) KruskalStrict(ctx context.Context, g *mst.Graph, opts ...mst.Option) ([]*mst.Edge, int, error) {
+	span, rec, opts := in.start(ctx, "mst.kruskal", "kruskal", g, opts)
+	tree, weight, err := g.KruskalStrict(opts...)
+	in.finish(span, rec, "kruskal", tree, weight, err)
+	return tree, weight, err
+}
+
+// Prim runs g.Prim inside a "mst.prim" span
+func (in *Instrumentation) Prim(ctx context.Context, g *mst.Graph, startID int, opts ...mst.Option) ([]*mst.Edge, int) {
+	span, rec, opts := in.start(ctx, "mst.prim", "prim", g, opts, attribute.Int("mst.start", startID))
+	tree, weight := g.Prim(startID, opts...)
+	in.finish(span, rec, "prim", tree, weight, nil)
+	return tree, weight
+}
+
+// PrimStrict runs g.PrimStrict inside a "mst.prim" span, recording any error
+func (in *Instrumentation) PrimStrict(ctx context.Context, g *mst.Graph, startID int, opts ...mst.Option) ([]*mst.Edge, int, error) {
+	span, rec, opts := in.start(ctx, "mst.prim", "prim", g, opts, attribute.Int("mst.start", startID))
+	tree, weight, err := g.PrimStrict(startID, opts...)
+	in.finish(span, rec, "prim", tree, weight, err)
+	return tree, weight, err
+}
+
+// PrimEager runs g.PrimEager inside a "mst.prim_eager" span
+func (in *Instrumentation) PrimEager(ctx context.Context, g *mst.Graph, startID int, opts ...mst.Option) ([]*mst.Edge, int) {
+	span, rec, opts := in.start(ctx, "mst.prim_eager", "prim_eager", g, opts, attribute.Int("mst.start", startID))
+	tree, weight := g.PrimEager(startID, opts...)
+	in.finish(span, rec, "prim_eager", tree, weight, nil)
+	return tree, weight
+}
+
+// WriteDOT runs g.WriteDOT inside a "mst.write_dot" span
+func (in *Instrumentation) WriteDOT(ctx context.Context, w io.Writer, g *mst.Graph) error {
+	_, span := in.tracer.Start(ctx, "mst.write_dot", trace.WithAttributes(sizeAttributes(g)...))
+	defer span.End()
+
+	err := g.WriteDOT(w)
+	recordError(span, err)
+	return err
+}
+
+// WriteTraceJSON runs r.WriteJSON inside a "mst.write_trace_json" span
+func (in *Instrumentation) WriteTraceJSON(ctx context.Context, w io.Writer, r *mst.TraceRecorder) error {
+	_, span := in.tracer.Start(ctx, "mst.write_trace_json",
+		trace.WithAttributes(attribute.Int("mst.events", len(r.Events))))
+	defer span.End()
+
+	err := r.WriteJSON(w)
+	recordError(span, err)
+	return err
+}
+
+// start opens a span and adds a metrics recorder to the options
+func (in *Instrumentation) start(ctx context.Context, name, algorithm string, g *mst.Graph, opts []mst.Option, extra ...attribute.KeyValue) (trace.Span, *mst.MetricsRecorder, []mst.Option) {
+	attrs := append(sizeAttributes(g), attribute.String("mst.algorithm", algorithm))
+	attrs = append(attrs, extra...)
+	_, span := in.tracer.Start(ctx, name, trace.WithAttributes(attrs...))
+
+	rec := mst.NewMetricsRecorder()
+	opts = append(opts[:len(opts):len(opts)], mst.WithMetrics(rec))
+	return span, rec, opts
+}
+
+// finish records the result and counters on the span and ends it
+func (in *Instrumentation) finish(span trace.Span, rec *mst.MetricsRecorder, algorithm string, tree []*mst.Edge, weight int, err error) {
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("mst.tree_edges", len(tree)),
+		attribute.Int("mst.weight", weight),
+	)
+	if m, exists := rec.Algorithms[algorithm]; exists {
+		for counter, n := range m.Counts {
+			span.SetAttributes(attribute.Int("mst."+counter.String(), n))
+		}
+		for phase, d := range m.Phases {
+			span.SetAttributes(attribute.Int64("mst.phase."+phase+"_ns", d.Nanoseconds()))
+		}
+	}
+	recordError(span, err)
+}
+
+func sizeAttributes(g *mst.Graph) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.Int("mst.vertices", g.VertexCount()),
+		attribute.Int("mst.edges", g.EdgeCount()),
+	}
+}
+
+func recordError(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
@@ -0,0 +1,77 @@
+package otelmst
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/l00pss/mst"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func newGraph() mst.Graph {
+	g := mst.NewGraph(false)
+	v := make([]mst.Vertex, 4)
+	for i := range v {
+		v[i] = mst.Vertex{ID: i}
+	}
+	g.AddEdge(mst.Edge{From: &v[0], To: &v[1], Weight: 1})
+	g.AddEdge(mst.Edge{From: &v[1], To: &v[2], Weight: 2})
+	g.AddEdge(mst.Edge{From: &v[0], To: &v[2], Weight: 3})
+	g.AddVertex(v[3]) // isolated
+	return g
+}
+
+// TestInstrumentation tests that spans carry sizes, results and counters
+func TestInstrumentation(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	in := New(tp)
+	ctx := context.Background()
+	g := newGraph()
+
+	if _, w := in.Kruskal(ctx, &g); w != 3 {
+		t.Errorf("Expected weight 3, got %d", w)
+	}
+	if _, _, err := in.PrimStrict(ctx, &g, 0); !errors.Is(err, mst.ErrDisconnected) {
+		t.Errorf("Expected ErrDisconnected, got %v", err)
+	}
+	if err := in.WriteDOT(ctx, &bytes.Buffer{}, &g); err != nil {
+		t.Fatalf("WriteDOT failed: %v", err)
+	}
+
+	spans := exporter.GetSpans()
+	if len(spans) != 3 {
+		t.Fatalf("Expected 3 spans, got %d", len(spans))
+	}
+
+	attrs := make(map[string]int64)
+	for _, kv := range spans[0].Attributes {
+		attrs[string(kv.Key)] = kv.Value.AsInt64()
+	}
+	want := map[string]int64{
+		"mst.vertices":        4,
+		"mst.edges":           3,
+		"mst.tree_edges":      2,
+		"mst.weight":          3,
+		"mst.edges_scanned":   3,
+		"mst.cycles_rejected": 1,
+	}
+	if spans[0].Name != "mst.kruskal" {
+		t.Errorf("Expected span mst.kruskal, got %s", spans[0].Name)
+	}
+	for key, value := range want {
+		if attrs[key] != value {
+			t.Errorf("Expected %s=%d, got %d", key, value, attrs[key])
+		}
+	}
+
+	if spans[1].Status.Code.String() != "Error" || len(spans[1].Events) == 0 {
+		t.Errorf("Expected the disconnected Prim span to record an error, got %v", spans[1].Status)
+	}
+	if spans[2].Name != "mst.write_dot" {
+		t.Errorf("Expected span mst.write_dot, got %s", spans[2].Name)
+	}
+}
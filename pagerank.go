@@ -0,0 +1,57 @@
+package mst
+
+import "math"
+
+// ==================== PAGERANK ====================
+
+// PageRank ranks vertices by link structure using the power iteration method
+// damping is the probability of following an outgoing edge (typically 0.85)
+// Vertices without outgoing edges distribute their rank evenly to all vertices
+// Undirected graphs are treated as having edges in both directions
+func (g *Graph) PageRank(damping float64, iters int) map[int]float64 {
+	n := g.VertexCount()
+	ranks := make(map[int]float64, n)
+	if n == 0 {
+		return ranks
+	}
+
+	// Sort IDs so that floating point sums are deterministic
+	ids := g.SortedVertexIDs()
+
+	for _, id := range ids {
+		ranks[id] = 1.0 / float64(n)
+	}
+
+	for i := 0; i < iters; i++ {
+		next := make(map[int]float64, n)
+		dangling := 0.0
+
+		for _, id := range ids {
+			vertex := g.Vertices[id]
+			if len(vertex.Edges) == 0 {
+				dangling += ranks[id]
+				continue
+			}
+			share := ranks[id] / float64(len(vertex.Edges))
+			for _, edge := range vertex.Edges {
+				next[edge.To.ID] += share
+			}
+		}
+
+		base := (1-damping)/float64(n) + damping*dangling/float64(n)
+		delta := 0.0
+		for _, id := range ids {
+			rank := base + damping*next[id]
+			delta += math.Abs(rank - ranks[id])
+			next[id] = rank
+		}
+		ranks = next
+
+		// Stop early once the ranks have converged
+		if delta < 1e-12 {
+			break
+		}
+	}
+
+	return ranks
+}
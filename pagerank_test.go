@@ -0,0 +1,48 @@
+package mst
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+// TestPageRank tests PageRank on a small directed graph
+func TestPageRank(t *testing.T) {
+	fmt.Println("\n=== PAGERANK TEST ===")
+
+	g := NewGraph(true)
+
+	vertices := make([]*Vertex, 4)
+	for i := 0; i < 4; i++ {
+		vertices[i] = &Vertex{ID: i, Name: fmt.Sprintf("P%d", i), Edges: make([]*Edge, 0)}
+	}
+
+	// Every page links to page 0, page 0 links to page 1
+	links := []struct{ from, to int }{
+		{1, 0}, {2, 0}, {3, 0}, {0, 1},
+	}
+	for _, l := range links {
+		g.AddEdge(Edge{From: vertices[l.from], To: vertices[l.to], Weight: 1})
+	}
+
+	ranks := g.PageRank(0.85, 100)
+	for id := 0; id < 4; id++ {
+		fmt.Printf("  [%d] %.4f\n", id, ranks[id])
+	}
+
+	sum := 0.0
+	for _, r := range ranks {
+		sum += r
+	}
+	if math.Abs(sum-1) > 1e-9 {
+		t.Errorf("Expected ranks to sum to 1, got %f", sum)
+	}
+
+	if ranks[0] <= ranks[1] || ranks[1] <= ranks[2] {
+		t.Errorf("Expected rank order 0 > 1 > 2, got %v", ranks)
+	}
+
+	if math.Abs(ranks[2]-ranks[3]) > 1e-9 {
+		t.Errorf("Expected symmetric pages 2 and 3 to have equal rank, got %f and %f", ranks[2], ranks[3])
+	}
+}
@@ -0,0 +1,92 @@
+package mst
+
+import (
+	"runtime"
+	"sort"
+	"sync"
+)
+
+// ==================== PARALLEL EDGE SORTING ====================
+
+// parallelSortThreshold is the edge count from which Kruskal sorts in parallel by default
+const parallelSortThreshold = 1 << 18
+
+// WithParallelSort makes Kruskal sort edges with a parallel merge sort on the
+// given number of workers; 0 or less uses GOMAXPROCS and 1 disables it
+// Without this option the parallel sort is used automatically from 262,144
+// edges when more than one CPU is available
+func WithParallelSort(workers int) Option {
+	return func(o *options) {
+		if workers <= 0 {
+			workers = runtime.GOMAXPROCS(0)
+		}
+		o.sortWorkers = workers
+	}
+}
+
+// sortEdges orders edges for Kruskal, by weight when less is nil
+// Each worker sorts one chunk with the sequential sort, and sorted chunks are
+// then merged pairwise in parallel rounds
+func (o *options) sortEdges(edges []*Edge, less func(a, b *Edge) bool) {
+	sequential := func(s []*Edge) {
+		if less == nil {
+			sortEdgesByWeight(s)
+			return
+		}
+		sort.Slice(s, func(i, j int) bool {
+			return less(s[i], s[j])
+		})
+	}
+
+	workers := o.sortWorkers
+	if workers == 0 && len(edges) >= parallelSortThreshold {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	workers = min(workers, len(edges)/2)
+	if workers <= 1 {
+		sequential(edges)
+		return
+	}
+	if less == nil {
+		less = func(a, b *Edge) bool { return a.Weight < b.Weight }
+	}
+
+	n := len(edges)
+	width := (n + workers - 1) / workers
+	var wg sync.WaitGroup
+	for lo := 0; lo < n; lo += width {
+		chunk := edges[lo:min(lo+width, n)]
+		wg.Go(func() { sequential(chunk) })
+	}
+	wg.Wait()
+
+	src, dst := edges, make([]*Edge, n)
+	for ; width < n; width *= 2 {
+		for lo := 0; lo < n; lo += 2 * width {
+			mid, hi := min(lo+width, n), min(lo+2*width, n)
+			wg.Go(func() { mergeEdges(dst[lo:hi], src[lo:mid], src[mid:hi], less) })
+		}
+		wg.Wait()
+		src, dst = dst, src
+	}
+	if &src[0] != &edges[0] {
+		copy(edges, src)
+	}
+}
+
+// mergeEdges merges two sorted runs into dst, taking from a on ties
+func mergeEdges(dst, a, b []*Edge, less func(a, b *Edge) bool) {
+	i, j, k := 0, 0, 0
+	for i < len(a) && j < len(b) {
+		if less(b[j], a[i]) {
+			dst[k] = b[j]
+			j++
+		} else {
+			dst[k] = a[i]
+			i++
+		}
+		k++
+	}
+	k += copy(dst[k:], a[i:])
+	copy(dst[k:], b[j:])
+}
@@ -0,0 +1,69 @@
+package mst
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+)
+
+// TestParallelSort tests the parallel merge sort for both weight and comparator ordering
+func TestParallelSort(t *testing.T) {
+	fmt.Println("\n=== PARALLEL SORT TEST ===")
+
+	rng := rand.New(rand.NewPCG(5, 6))
+	for _, n := range []int{1, 3, 1000, 4099} {
+		for _, workers := range []int{2, 3, 8} {
+			edges := make([]*Edge, n)
+			for i := range edges {
+				edges[i] = &Edge{
+					From:   &Vertex{ID: rng.IntN(50)},
+					To:     &Vertex{ID: rng.IntN(50)},
+					Weight: rng.IntN(100),
+				}
+			}
+			o := newOptions([]Option{WithParallelSort(workers), WithTieBreakers(ByEndpoints)})
+
+			byWeight := append([]*Edge(nil), edges...)
+			o.sortEdges(byWeight, nil)
+			byLess := append([]*Edge(nil), edges...)
+			o.sortEdges(byLess, o.less)
+			for i := 1; i < n; i++ {
+				if byWeight[i-1].Weight > byWeight[i].Weight {
+					t.Fatalf("n=%d workers=%d: weights out of order at %d", n, workers, i)
+				}
+				if o.less(byLess[i], byLess[i-1]) {
+					t.Fatalf("n=%d workers=%d: comparator order violated at %d", n, workers, i)
+				}
+			}
+		}
+	}
+
+	g := buildCompleteGraph(60)
+	_, want := g.Kruskal(WithParallelSort(1))
+	if _, total := g.Kruskal(WithParallelSort(0)); total != want {
+		t.Errorf("Parallel Kruskal total %d differs from sequential %d", total, want)
+	}
+}
+
+// BenchmarkKruskalParallelSort benchmarks Kruskal with the parallel sort on 1M edges
+func BenchmarkKruskalParallelSort(b *testing.B) {
+	rng := rand.New(rand.NewPCG(7, 8))
+	g := NewGraph(false)
+	vertices := make([]Vertex, 100000)
+	for i := range vertices {
+		vertices[i] = Vertex{ID: i}
+	}
+	edges := make([]Edge, 1000000)
+	for i := range edges {
+		edges[i] = Edge{From: &vertices[rng.IntN(len(vertices))], To: &vertices[rng.IntN(len(vertices))], Weight: rng.IntN(1 << 30)}
+	}
+	g.AddEdges(edges)
+
+	for _, workers := range []int{1, 0} {
+		b.Run(fmt.Sprintf("workers=%d", workers), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				g.Kruskal(WithParallelSort(workers))
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package mst
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+)
+
+// ==================== BALANCED PARTITIONING ====================
+
+// ErrUnbalancedPartition is returned when some part misses the balance tolerance
+var ErrUnbalancedPartition = errors.New("partition exceeds balance tolerance")
+
+// Partition cuts the MST into k connected parts of roughly equal vertex count
+// See PartitionBy for the meaning of balanceTolerance
+func (g *Graph) Partition(k int, balanceTolerance float64) ([][]int, error) {
+	return g.PartitionBy(k, balanceTolerance, func(Vertex) float64 { return 1 })
+}
+
+// PartitionBy cuts the MST into k connected parts of roughly equal total size,
+// where size gives the weight of each vertex (for example a population in Data)
+// Each of the k-1 cuts removes the tree edge that carves off a piece closest
+// to the ideal size total/k. Groups are sorted like CutHeaviest's. If a part
+// deviates from the ideal by more than balanceTolerance·(total/k), the
+// partition is still returned together with an error wrapping ErrUnbalancedPartition
+func (g *Graph) PartitionBy(k int, balanceTolerance float64, size func(v Vertex) float64) ([][]int, error) {
+	if k < 1 {
+		k = 1
+	}
+
+	mst, _ := g.Kruskal()
+	kept := slices.Clone(mst)
+
+	sizes := make(map[int]float64, len(g.Vertices))
+	total := 0.0
+	for id, v := range g.Vertices {
+		sizes[id] = size(v)
+		total += sizes[id]
+	}
+	target := total / float64(k)
+
+	// A disconnected graph already comes in several pieces
+	components := g.VertexCount() - len(mst)
+	for cuts := components; cuts < k && len(kept) > 0; cuts++ {
+		rf := rootForest(kept, math.MinInt)
+
+		// Subtree sizes, children before parents
+		sub := make(map[int]float64, len(rf.order))
+		for i := len(rf.order) - 1; i >= 0; i-- {
+			v := rf.order[i]
+			sub[v] += sizes[v]
+			if p := rf.parent[v]; p != v {
+				sub[p] += sub[v]
+			}
+		}
+
+		// Total size of the tree holding each vertex, copied down from its root
+		tree := make(map[int]float64, len(rf.order))
+		for _, v := range rf.order {
+			if p := rf.parent[v]; p == v {
+				tree[v] = sub[v]
+			} else {
+				tree[v] = tree[p]
+			}
+		}
+
+		var cut *Edge
+		bestScore := math.Inf(1)
+		for _, v := range rf.order {
+			if rf.parent[v] == v {
+				continue
+			}
+			carved := sub[v]
+			rest := tree[v] - carved
+			score := math.Min(math.Abs(carved-target), math.Abs(rest-target))
+			if score < bestScore {
+				bestScore = score
+				cut = rf.parentEdge[v]
+			}
+		}
+		kept = slices.DeleteFunc(kept, func(e *Edge) bool { return e == cut })
+	}
+
+	uf := NewUnionFind()
+	for id := range g.Vertices {
+		uf.MakeSet(id)
+	}
+	for _, e := range kept {
+		uf.Union(e.From.ID, e.To.ID)
+	}
+	groups := groupsOf(uf)
+
+	for _, group := range groups {
+		part := 0.0
+		for _, id := range group {
+			part += sizes[id]
+		}
+		if math.Abs(part-target) > balanceTolerance*target {
+			return groups, fmt.Errorf("%w: part starting at vertex %d has size %g, ideal %g",
+				ErrUnbalancedPartition, group[0], part, target)
+		}
+	}
+	return groups, nil
+}
@@ -0,0 +1,54 @@
+package mst
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// newPathGraph builds the path 0-1-...-(n-1) with the given edge weights
+func newPathGraph(weights ...int) Graph {
+	g := NewGraph(false)
+	for i, w := range weights {
+		from, to := Vertex{ID: i}, Vertex{ID: i + 1}
+		g.AddEdge(Edge{From: &from, To: &to, Weight: w})
+	}
+	return g
+}
+
+// TestPartition tests balanced MST cuts
+func TestPartition(t *testing.T) {
+	fmt.Println("\n=== BALANCED PARTITION TEST ===")
+
+	// Single linkage would cut the heavy edge 0-1 and strand vertex 0
+	g := newPathGraph(9, 1, 1, 1, 1, 1, 1, 1, 1)
+	groups, err := g.Partition(2, 0.2)
+	fmt.Println(groups)
+	if err != nil {
+		t.Fatalf("Partition failed: %v", err)
+	}
+	if len(groups) != 2 || len(groups[0]) != 5 || len(groups[1]) != 5 {
+		t.Errorf("Expected two parts of 5, got %v", groups)
+	}
+
+	thirds, err := g.Partition(3, 0.35)
+	if err != nil || len(thirds) != 3 {
+		t.Errorf("Expected 3 balanced parts, got %v (%v)", thirds, err)
+	}
+
+	// Weighted sizes: vertex 0 is as large as all the others together
+	weighted, err := g.PartitionBy(2, 0.01, func(v Vertex) float64 {
+		if v.ID == 0 {
+			return 9
+		}
+		return 1
+	})
+	if err != nil || len(weighted[0]) != 1 {
+		t.Errorf("Expected vertex 0 alone, got %v (%v)", weighted, err)
+	}
+
+	short := newPathGraph(1, 1)
+	if _, err := short.Partition(2, 0.1); !errors.Is(err, ErrUnbalancedPartition) {
+		t.Errorf("Expected ErrUnbalancedPartition for 3 vertices in 2 parts, got %v", err)
+	}
+}
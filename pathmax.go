@@ -0,0 +1,137 @@
+package mst
+
+// ==================== PATH-MAX QUERIES (BINARY LIFTING) ====================
+
+// PathMaxIndex answers lowest-common-ancestor and heaviest-edge queries
+// over the tree paths of an MST (or spanning forest) in O(log V)
+// Building it takes O(V log V) time and memory
+type PathMaxIndex struct {
+	index map[int]int // vertex ID -> dense index
+	ids   []int       // dense index -> vertex ID
+	depth []int
+	root  []int     // dense index of each vertex's tree root
+	up    [][]int   // up[k][i] is the 2^k-th ancestor of i
+	max   [][]*Edge // max[k][i] is the heaviest edge on that jump
+}
+
+// NewPathMaxIndex builds the index for the given tree edges
+func NewPathMaxIndex(mst []*Edge) *PathMaxIndex {
+	rf := rootForest(mst, 0)
+	n := len(rf.order)
+
+	p := &PathMaxIndex{
+		index: make(map[int]int, n),
+		ids:   make([]int, n),
+		depth: make([]int, n),
+		root:  make([]int, n),
+	}
+	for i, id := range rf.order {
+		p.index[id] = i
+		p.ids[i] = id
+	}
+
+	levels := 1
+	for (1 << levels) < n {
+		levels++
+	}
+	p.up = make([][]int, levels)
+	p.max = make([][]*Edge, levels)
+	for k := range p.up {
+		p.up[k] = make([]int, n)
+		p.max[k] = make([]*Edge, n)
+	}
+
+	// rf.order lists parents before children, so roots are resolved first
+	for i, id := range rf.order {
+		parent := p.index[rf.parent[id]]
+		p.depth[i] = rf.depth[id]
+		p.up[0][i] = parent
+		p.max[0][i] = rf.parentEdge[id]
+		if parent == i {
+			p.root[i] = i
+		} else {
+			p.root[i] = p.root[parent]
+		}
+	}
+	for k := 1; k < levels; k++ {
+		for i := 0; i < n; i++ {
+			mid := p.up[k-1][i]
+			p.up[k][i] = p.up[k-1][mid]
+			p.max[k][i] = heavier(p.max[k-1][i], p.max[k-1][mid])
+		}
+	}
+	return p
+}
+
+// heavier returns the heavier of two edges, treating nil as lighter than anything
+func heavier(a, b *Edge) *Edge {
+	if a == nil {
+		return b
+	}
+	if b == nil || a.Weight >= b.Weight {
+		return a
+	}
+	return b
+}
+
+// Depth returns the number of edges between a vertex and its tree root
+func (p *PathMaxIndex) Depth(id int) (int, bool) {
+	i, exists := p.index[id]
+	if !exists {
+		return 0, false
+	}
+	return p.depth[i], true
+}
+
+// LCA returns the lowest common ancestor of two vertices
+// It returns false if either vertex is missing or they are in different trees
+func (p *PathMaxIndex) LCA(u, v int) (int, bool) {
+	lca, _, ok := p.query(u, v)
+	if !ok {
+		return 0, false
+	}
+	return p.ids[lca], true
+}
+
+// MaxEdgeOnPath returns the heaviest edge on the tree path between two vertices
+// It returns false if either vertex is missing, they are in different trees, or u == v
+func (p *PathMaxIndex) MaxEdgeOnPath(u, v int) (*Edge, bool) {
+	_, heaviest, ok := p.query(u, v)
+	if !ok || heaviest == nil {
+		return nil, false
+	}
+	return heaviest, true
+}
+
+// query lifts both vertices to their LCA, collecting the heaviest edge on the way
+func (p *PathMaxIndex) query(u, v int) (int, *Edge, bool) {
+	a, okA := p.index[u]
+	b, okB := p.index[v]
+	if !okA || !okB || p.root[a] != p.root[b] {
+		return 0, nil, false
+	}
+
+	var heaviest *Edge
+	if p.depth[a] < p.depth[b] {
+		a, b = b, a
+	}
+	for k, diff := 0, p.depth[a]-p.depth[b]; diff > 0; k, diff = k+1, diff>>1 {
+		if diff&1 == 1 {
+			heaviest = heavier(heaviest, p.max[k][a])
+			a = p.up[k][a]
+		}
+	}
+	if a == b {
+		return a, heaviest, true
+	}
+
+	for k := len(p.up) - 1; k >= 0; k-- {
+		if p.up[k][a] != p.up[k][b] {
+			heaviest = heavier(heaviest, heavier(p.max[k][a], p.max[k][b]))
+			a = p.up[k][a]
+			b = p.up[k][b]
+		}
+	}
+	heaviest = heavier(heaviest, heavier(p.max[0][a], p.max[0][b]))
+	return p.up[0][a], heaviest, true
+}
@@ -0,0 +1,64 @@
+package mst
+
+import (
+	"fmt"
+	"testing"
+)
+
+// TestPathMaxIndex tests LCA and path-max queries against a naive walk
+func TestPathMaxIndex(t *testing.T) {
+	fmt.Println("\n=== PATH-MAX INDEX TEST ===")
+
+	g := buildCompleteGraph(25)
+	mst, _ := g.Kruskal()
+	p := NewPathMaxIndex(mst)
+	rf := rootForest(mst, 0)
+
+	for u := 0; u < 25; u++ {
+		for v := 0; v < 25; v++ {
+			got, ok := p.MaxEdgeOnPath(u, v)
+			if u == v {
+				if ok {
+					t.Errorf("Expected no edge on the empty path %d-%d", u, v)
+				}
+				continue
+			}
+			want := rf.maxEdgeOnPath(u, v)
+			if !ok || got.Weight != want.Weight {
+				t.Errorf("Path %d-%d: expected max weight %d, got %v", u, v, want.Weight, got)
+			}
+		}
+	}
+
+	if lca, ok := p.LCA(0, 0); !ok || lca != 0 {
+		t.Errorf("Expected LCA(0, 0) = 0, got %d", lca)
+	}
+}
+
+// TestPathMaxIndexForest tests queries across separate trees
+func TestPathMaxIndexForest(t *testing.T) {
+	v := make([]*Vertex, 5)
+	for i := range v {
+		v[i] = &Vertex{ID: i}
+	}
+	// Two trees: 0-1-2 rooted at 0, and 3-4
+	forest := []*Edge{
+		{From: v[0], To: v[1], Weight: 3},
+		{From: v[1], To: v[2], Weight: 7},
+		{From: v[3], To: v[4], Weight: 1},
+	}
+	p := NewPathMaxIndex(forest)
+
+	if e, ok := p.MaxEdgeOnPath(0, 2); !ok || e.Weight != 7 {
+		t.Errorf("Expected max weight 7 on 0-2, got %v", e)
+	}
+	if lca, ok := p.LCA(1, 2); !ok || lca != 1 {
+		t.Errorf("Expected LCA(1, 2) = 1, got %d", lca)
+	}
+	if _, ok := p.MaxEdgeOnPath(2, 4); ok {
+		t.Error("Expected no path between separate trees")
+	}
+	if d, ok := p.Depth(2); !ok || d != 2 {
+		t.Errorf("Expected depth 2 for vertex 2, got %d", d)
+	}
+}
@@ -0,0 +1,472 @@
+package mst
+
+import (
+	"maps"
+	"slices"
+)
+
+// ==================== PLANARITY ====================
+
+// Embedding is a planar embedding of a graph: the neighbors of every
+// vertex in clockwise order around it
+// Only the cyclic order matters, so any neighbor may come first
+type Embedding map[int][]int
+
+// Faces returns the faces of the embedding, each as the vertices met
+// walking its boundary
+// A connected planar graph with V vertices and E edges has E - V + 2
+// faces, the outer one included; vertices without neighbors bound none
+func (emb Embedding) Faces() [][]int {
+	position := make(map[[2]int]int)
+	ids := make([]int, 0, len(emb))
+	for v, around := range emb {
+		ids = append(ids, v)
+		for i, w := range around {
+			position[[2]int{v, w}] = i
+		}
+	}
+	slices.Sort(ids)
+
+	faces := make([][]int, 0)
+	visited := make(map[[2]int]bool, len(position))
+	for _, v := range ids {
+		for _, w := range emb[v] {
+			if visited[[2]int{v, w}] {
+				continue
+			}
+			face := make([]int, 0)
+			for a, b := v, w; !visited[[2]int{a, b}]; {
+				visited[[2]int{a, b}] = true
+				face = append(face, a)
+				// Turn to the neighbor of b just before a
+				around := emb[b]
+				i := position[[2]int{b, a}]
+				a, b = b, around[(i+len(around)-1)%len(around)]
+			}
+			faces = append(faces, face)
+		}
+	}
+	return faces
+}
+
+// IsPlanar reports whether the graph can be drawn in the plane without
+// crossing edges, and returns such an embedding when it can
+// Direction, self-loops, and parallel edges are ignored. It uses the
+// left-right planarity test of de Fraysseix and Rosenstiehl as described
+// by Brandes, in O(V + E)
+func (g *Graph) IsPlanar() (bool, Embedding) {
+	ids := g.SortedVertexIDs()
+	sets := g.AdjacencySets()
+	index := make(map[int]int, len(ids))
+	for i, id := range ids {
+		index[id] = i
+	}
+
+	lr := &lrPlanarity{adj: make([][]lrAdj, len(ids))}
+	for u, id := range ids {
+		for _, nb := range slices.Sorted(maps.Keys(sets[id])) {
+			if w := index[nb]; u < w {
+				lr.addEdge(u, w)
+			}
+		}
+	}
+	if len(ids) > 2 && len(lr.from) > 3*len(ids)-6 {
+		return false, nil
+	}
+	rotation := lr.run()
+	if rotation == nil {
+		return false, nil
+	}
+
+	emb := make(Embedding, len(ids))
+	for v, around := range rotation {
+		emb[ids[v]] = make([]int, len(around))
+		for i, w := range around {
+			emb[ids[v]][i] = ids[w]
+		}
+	}
+	return true, emb
+}
+
+// lrAdj is one side of an undirected edge in the adjacency list
+type lrAdj struct {
+	to, edge int
+}
+
+// lrInterval is a run of return edges, from low to high, or -1 for none
+type lrInterval struct {
+	low, high int
+}
+
+func (i lrInterval) empty() bool {
+	return i.low == -1 && i.high == -1
+}
+
+// lrPair holds the return edges that must lie on the left and right side
+type lrPair struct {
+	left, right lrInterval
+}
+
+func newLRPair() *lrPair {
+	return &lrPair{left: lrInterval{-1, -1}, right: lrInterval{-1, -1}}
+}
+
+func (p *lrPair) swap() {
+	p.left, p.right = p.right, p.left
+}
+
+// lrPlanarity is the state of the left-right planarity test
+// Edges are numbered in input order and oriented by the first DFS, from
+// from[e] to to[e]; -1 stands for no edge and no stack entry
+type lrPlanarity struct {
+	adj      [][]lrAdj
+	from, to []int
+
+	height     []int
+	parentEdge []int
+	roots      []int
+	oriented   []bool
+	out        [][]int // oriented edges leaving each vertex, by nesting depth
+
+	lowpt, lowpt2, nesting []int
+	ref, side, lowptEdge   []int
+	stackBottom            []*lrPair
+	stack                  []*lrPair
+}
+
+func (lr *lrPlanarity) addEdge(u, w int) {
+	e := len(lr.from)
+	lr.from = append(lr.from, u)
+	lr.to = append(lr.to, w)
+	lr.adj[u] = append(lr.adj[u], lrAdj{w, e})
+	lr.adj[w] = append(lr.adj[w], lrAdj{u, e})
+}
+
+// run orients the graph, tests it, and returns the clockwise rotation of
+// every vertex, or nil if the graph is not planar
+func (lr *lrPlanarity) run() [][]int {
+	n, m := len(lr.adj), len(lr.from)
+	lr.height = slices.Repeat([]int{-1}, n)
+	lr.parentEdge = slices.Repeat([]int{-1}, n)
+	lr.out = make([][]int, n)
+	lr.oriented = make([]bool, m)
+	lr.lowpt, lr.lowpt2, lr.nesting = make([]int, m), make([]int, m), make([]int, m)
+	lr.ref = slices.Repeat([]int{-1}, m)
+	lr.side = slices.Repeat([]int{1}, m)
+	lr.lowptEdge = slices.Repeat([]int{-1}, m)
+	lr.stackBottom = make([]*lrPair, m)
+
+	for v := range n {
+		if lr.height[v] == -1 {
+			lr.height[v] = 0
+			lr.roots = append(lr.roots, v)
+			lr.orient(v)
+		}
+	}
+
+	byNesting := func(a, b int) int { return lr.nesting[a] - lr.nesting[b] }
+	for v := range n {
+		slices.SortStableFunc(lr.out[v], byNesting)
+	}
+	for _, v := range lr.roots {
+		if !lr.test(v) {
+			return nil
+		}
+	}
+
+	for e := range m {
+		lr.nesting[e] *= lr.sign(e)
+	}
+	r := newRotation(n)
+	for v := range n {
+		slices.SortStableFunc(lr.out[v], byNesting)
+		previous := -1
+		for _, e := range lr.out[v] {
+			r.addCW(v, lr.to[e], previous)
+			previous = lr.to[e]
+		}
+	}
+	leftRef, rightRef := make([]int, n), make([]int, n)
+	var embed func(v int)
+	embed = func(v int) {
+		for _, e := range lr.out[v] {
+			w := lr.to[e]
+			if e == lr.parentEdge[w] {
+				r.addFirst(w, v)
+				leftRef[v], rightRef[v] = w, w
+				embed(w)
+			} else if lr.side[e] == 1 {
+				r.addCW(w, v, rightRef[w])
+			} else {
+				r.addCCW(w, v, leftRef[w])
+				leftRef[w] = v
+			}
+		}
+	}
+	for _, v := range lr.roots {
+		embed(v)
+	}
+	return r.cycles()
+}
+
+// orient runs the first DFS from v, directing tree edges away from the
+// root and back edges towards it, and computes lowpoints and nesting depths
+func (lr *lrPlanarity) orient(v int) {
+	parent := lr.parentEdge[v]
+	for _, a := range lr.adj[v] {
+		e, w := a.edge, a.to
+		if lr.oriented[e] {
+			continue
+		}
+		lr.oriented[e] = true
+		lr.from[e], lr.to[e] = v, w
+		lr.out[v] = append(lr.out[v], e)
+		lr.lowpt[e], lr.lowpt2[e] = lr.height[v], lr.height[v]
+		if lr.height[w] == -1 {
+			lr.parentEdge[w] = e
+			lr.height[w] = lr.height[v] + 1
+			lr.orient(w)
+		} else {
+			lr.lowpt[e] = lr.height[w]
+		}
+
+		lr.nesting[e] = 2 * lr.lowpt[e]
+		if lr.lowpt2[e] < lr.height[v] {
+			lr.nesting[e]++
+		}
+		if parent != -1 {
+			switch {
+			case lr.lowpt[e] < lr.lowpt[parent]:
+				lr.lowpt2[parent] = min(lr.lowpt[parent], lr.lowpt2[e])
+				lr.lowpt[parent] = lr.lowpt[e]
+			case lr.lowpt[e] > lr.lowpt[parent]:
+				lr.lowpt2[parent] = min(lr.lowpt2[parent], lr.lowpt[e])
+			default:
+				lr.lowpt2[parent] = min(lr.lowpt2[parent], lr.lowpt2[e])
+			}
+		}
+	}
+}
+
+func (lr *lrPlanarity) top() *lrPair {
+	if len(lr.stack) == 0 {
+		return nil
+	}
+	return lr.stack[len(lr.stack)-1]
+}
+
+func (lr *lrPlanarity) pop() *lrPair {
+	p := lr.top()
+	lr.stack = lr.stack[:len(lr.stack)-1]
+	return p
+}
+
+// conflicting reports whether interval i holds a return edge above lowpt(e)
+func (lr *lrPlanarity) conflicting(i lrInterval, e int) bool {
+	return !i.empty() && lr.lowpt[i.high] > lr.lowpt[e]
+}
+
+// lowest returns the lowest lowpoint among the return edges of p
+func (lr *lrPlanarity) lowest(p *lrPair) int {
+	switch {
+	case p.left.empty():
+		return lr.lowpt[p.right.low]
+	case p.right.empty():
+		return lr.lowpt[p.left.low]
+	}
+	return min(lr.lowpt[p.left.low], lr.lowpt[p.right.low])
+}
+
+// test runs the second DFS from v, collecting the side constraints of the
+// return edges, and reports false on a contradiction
+func (lr *lrPlanarity) test(v int) bool {
+	parent := lr.parentEdge[v]
+	for i, e := range lr.out[v] {
+		w := lr.to[e]
+		lr.stackBottom[e] = lr.top()
+		if e == lr.parentEdge[w] {
+			if !lr.test(w) {
+				return false
+			}
+		} else {
+			lr.lowptEdge[e] = e
+			p := newLRPair()
+			p.right = lrInterval{e, e}
+			lr.stack = append(lr.stack, p)
+		}
+
+		if lr.lowpt[e] < lr.height[v] {
+			if i == 0 {
+				lr.lowptEdge[parent] = lr.lowptEdge[e]
+			} else if !lr.addConstraints(e, parent) {
+				return false
+			}
+		}
+	}
+	if parent != -1 {
+		lr.removeBackEdges(parent)
+	}
+	return true
+}
+
+// addConstraints merges the return edges of e, a later child edge of
+// parent, with those of its earlier siblings
+func (lr *lrPlanarity) addConstraints(e, parent int) bool {
+	p := newLRPair()
+	for {
+		q := lr.pop()
+		if !q.left.empty() {
+			q.swap()
+		}
+		if !q.left.empty() {
+			return false
+		}
+		if lr.lowpt[q.right.low] > lr.lowpt[parent] {
+			if p.right.empty() {
+				p.right = q.right
+			} else {
+				lr.ref[p.right.low] = q.right.high
+			}
+			p.right.low = q.right.low
+		} else {
+			lr.ref[q.right.low] = lr.lowptEdge[parent]
+		}
+		if lr.top() == lr.stackBottom[e] {
+			break
+		}
+	}
+
+	for t := lr.top(); t != nil && (lr.conflicting(t.left, e) || lr.conflicting(t.right, e)); t = lr.top() {
+		q := lr.pop()
+		if lr.conflicting(q.right, e) {
+			q.swap()
+		}
+		if lr.conflicting(q.right, e) {
+			return false
+		}
+		lr.ref[p.right.low] = q.right.high
+		if q.right.low != -1 {
+			p.right.low = q.right.low
+		}
+		if p.left.empty() {
+			p.left = q.left
+		} else {
+			lr.ref[p.left.low] = q.left.high
+		}
+		p.left.low = q.left.low
+	}
+	if !p.left.empty() || !p.right.empty() {
+		lr.stack = append(lr.stack, p)
+	}
+	return true
+}
+
+// removeBackEdges drops the return edges that end at the tail of e, now
+// that its subtree is done, and decides the side of e
+func (lr *lrPlanarity) removeBackEdges(e int) {
+	u := lr.from[e]
+	for len(lr.stack) > 0 && lr.lowest(lr.top()) == lr.height[u] {
+		if p := lr.pop(); p.left.low != -1 {
+			lr.side[p.left.low] = -1
+		}
+	}
+	if len(lr.stack) > 0 {
+		p := lr.top()
+		for p.left.high != -1 && lr.to[p.left.high] == u {
+			p.left.high = lr.ref[p.left.high]
+		}
+		if p.left.high == -1 && p.left.low != -1 {
+			lr.ref[p.left.low] = p.right.low
+			lr.side[p.left.low] = -1
+			p.left.low = -1
+		}
+		for p.right.high != -1 && lr.to[p.right.high] == u {
+			p.right.high = lr.ref[p.right.high]
+		}
+		if p.right.high == -1 && p.right.low != -1 {
+			lr.ref[p.right.low] = p.left.low
+			lr.side[p.right.low] = -1
+			p.right.low = -1
+		}
+	}
+
+	if lr.lowpt[e] < lr.height[u] {
+		hl, hr := lr.top().left.high, lr.top().right.high
+		if hl != -1 && (hr == -1 || lr.lowpt[hl] > lr.lowpt[hr]) {
+			lr.ref[e] = hl
+		} else {
+			lr.ref[e] = hr
+		}
+	}
+}
+
+// sign resolves the side of e relative to the edges it refers to
+func (lr *lrPlanarity) sign(e int) int {
+	if lr.ref[e] != -1 {
+		lr.side[e] *= lr.sign(lr.ref[e])
+		lr.ref[e] = -1
+	}
+	return lr.side[e]
+}
+
+// rotation keeps the neighbors of every vertex in a circular doubly linked
+// list, clockwise through cw, starting from first
+type rotation struct {
+	cw, ccw []map[int]int
+	first   []int
+}
+
+func newRotation(n int) *rotation {
+	r := &rotation{cw: make([]map[int]int, n), ccw: make([]map[int]int, n), first: slices.Repeat([]int{-1}, n)}
+	for v := range n {
+		r.cw[v], r.ccw[v] = make(map[int]int), make(map[int]int)
+	}
+	return r
+}
+
+// addCW inserts w clockwise after ref around v, or as the only neighbor
+// when ref is -1
+func (r *rotation) addCW(v, w, ref int) {
+	if ref == -1 {
+		r.cw[v][w], r.ccw[v][w], r.first[v] = w, w, w
+		return
+	}
+	next := r.cw[v][ref]
+	r.cw[v][ref], r.cw[v][w] = w, next
+	r.ccw[v][next], r.ccw[v][w] = w, ref
+}
+
+// addCCW inserts w counterclockwise before ref around v
+func (r *rotation) addCCW(v, w, ref int) {
+	if ref == -1 {
+		r.addCW(v, w, -1)
+		return
+	}
+	r.addCW(v, w, r.ccw[v][ref])
+	if ref == r.first[v] {
+		r.first[v] = w
+	}
+}
+
+// addFirst inserts w as the first neighbor of v
+func (r *rotation) addFirst(v, w int) {
+	r.addCCW(v, w, r.first[v])
+}
+
+// cycles lists every vertex's neighbors clockwise from first
+func (r *rotation) cycles() [][]int {
+	result := make([][]int, len(r.first))
+	for v, start := range r.first {
+		result[v] = make([]int, 0, len(r.cw[v]))
+		if start == -1 {
+			continue
+		}
+		for w := start; ; {
+			result[v] = append(result[v], w)
+			if w = r.cw[v][w]; w == start {
+				break
+			}
+		}
+	}
+	return result
+}
@@ -0,0 +1,160 @@
+package mst
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+// buildTriangulatedGrid returns an n×n grid with one diagonal per cell,
+// a maximal planar graph apart from its outer face
+func buildTriangulatedGrid(n int) Graph {
+	g := NewGraph(false)
+	for r := range n {
+		for c := range n {
+			id := n*r + c
+			g.AddVertex(Vertex{ID: id})
+			if c < n-1 {
+				g.AddEdge(Edge{From: &Vertex{ID: id}, To: &Vertex{ID: id + 1}, Weight: 1})
+			}
+			if r < n-1 {
+				g.AddEdge(Edge{From: &Vertex{ID: id}, To: &Vertex{ID: id + n}, Weight: 1})
+			}
+			if c < n-1 && r < n-1 {
+				g.AddEdge(Edge{From: &Vertex{ID: id}, To: &Vertex{ID: id + n + 1}, Weight: 1})
+			}
+		}
+	}
+	return g
+}
+
+// checkEmbedding verifies that emb lists each vertex's neighbors in g and
+// satisfies Euler's formula for every connected component
+func checkEmbedding(t *testing.T, g *Graph, emb Embedding) {
+	t.Helper()
+	sets := g.AdjacencySets()
+	edges := 0
+	for id, nbs := range sets {
+		around := slices.Clone(emb[id])
+		slices.Sort(around)
+		want := make([]int, 0, len(nbs))
+		for nb := range nbs {
+			want = append(want, nb)
+		}
+		slices.Sort(want)
+		if !slices.Equal(around, want) {
+			t.Fatalf("Vertex %d: expected neighbors %v, got %v", id, want, emb[id])
+		}
+		edges += len(nbs)
+	}
+	edges /= 2
+
+	components, isolated := 0, 0
+	for _, group := range g.componentGroups() {
+		if len(group) == 1 && len(sets[group[0]]) == 0 {
+			isolated++
+		} else {
+			components++
+		}
+	}
+	faces := len(emb.Faces())
+	if len(sets)-edges+faces != 2*components+isolated {
+		t.Fatalf("Euler's formula fails: %d vertices, %d edges, %d faces, %d components",
+			len(sets), edges, faces, components+isolated)
+	}
+}
+
+// TestIsPlanar tests planar graphs and Kuratowski graphs
+func TestIsPlanar(t *testing.T) {
+	fmt.Println("\n=== PLANARITY TEST ===")
+
+	grid := buildTriangulatedGrid(6)
+	ok, emb := grid.IsPlanar()
+	if !ok {
+		t.Fatal("Expected a triangulated grid to be planar")
+	}
+	checkEmbedding(t, &grid, emb)
+	fmt.Printf("Triangulated 6x6 grid: %d faces\n", len(emb.Faces()))
+
+	k5 := buildCompleteGraph(5)
+	if ok, emb := k5.IsPlanar(); ok || emb != nil {
+		t.Error("Expected K5 to be non-planar")
+	}
+	k4 := buildCompleteGraph(4)
+	if ok, emb := k4.IsPlanar(); !ok {
+		t.Error("Expected K4 to be planar")
+	} else {
+		checkEmbedding(t, &k4, emb)
+	}
+
+	// K3,3 with subdivided edges hidden in a planar grid is still
+	// non-planar, and passes the edge count bound
+	k33 := buildTriangulatedGrid(5)
+	next := 100
+	for _, a := range []int{0, 4, 20} {
+		for _, b := range []int{200, 201, 202} {
+			k33.AddEdge(Edge{From: &Vertex{ID: a}, To: &Vertex{ID: next}, Weight: 1})
+			k33.AddEdge(Edge{From: &Vertex{ID: next}, To: &Vertex{ID: b}, Weight: 1})
+			next++
+		}
+	}
+	if ok, _ := k33.IsPlanar(); ok {
+		t.Error("Expected a subdivided K3,3 to be non-planar")
+	}
+
+	// The Petersen graph: outer 5-cycle, inner pentagram, and spokes
+	petersen := NewGraph(false)
+	for i := range 5 {
+		petersen.AddEdge(Edge{From: &Vertex{ID: i}, To: &Vertex{ID: (i + 1) % 5}, Weight: 1})
+		petersen.AddEdge(Edge{From: &Vertex{ID: 5 + i}, To: &Vertex{ID: 5 + (i+2)%5}, Weight: 1})
+		petersen.AddEdge(Edge{From: &Vertex{ID: i}, To: &Vertex{ID: 5 + i}, Weight: 1})
+	}
+	if ok, _ := petersen.IsPlanar(); ok {
+		t.Error("Expected the Petersen graph to be non-planar")
+	}
+
+	// Direction, loops, parallel edges, and isolated vertices don't matter
+	dg := NewGraph(true)
+	dg.AddEdge(Edge{From: &Vertex{ID: 1}, To: &Vertex{ID: 2}, Weight: 1})
+	dg.AddEdge(Edge{From: &Vertex{ID: 2}, To: &Vertex{ID: 1}, Weight: 1})
+	dg.AddEdge(Edge{From: &Vertex{ID: 2}, To: &Vertex{ID: 2}, Weight: 1})
+	dg.AddVertex(Vertex{ID: 3})
+	if ok, emb := dg.IsPlanar(); !ok {
+		t.Error("Expected a small directed graph to be planar")
+	} else {
+		checkEmbedding(t, &dg, emb)
+	}
+}
+
+// TestIsPlanarRandom tests random subgraphs of a planar graph, and random
+// planar graphs with one extra edge
+func TestIsPlanarRandom(t *testing.T) {
+	fmt.Println("\n=== RANDOM PLANARITY TEST ===")
+
+	rng := rand.New(rand.NewPCG(3, 1))
+	full := buildTriangulatedGrid(8)
+	for trial := range 50 {
+		g := NewGraph(false)
+		for _, e := range full.Edges {
+			if rng.IntN(4) != 0 {
+				g.AddEdge(Edge{From: &Vertex{ID: e.From.ID}, To: &Vertex{ID: e.To.ID}, Weight: 1})
+			}
+		}
+		ok, emb := g.IsPlanar()
+		if !ok {
+			t.Fatalf("Trial %d: expected a subgraph of a planar graph to be planar", trial)
+		}
+		checkEmbedding(t, &g, emb)
+	}
+
+	// Joining opposite corners of a triangulated grid always crosses
+	for _, n := range []int{3, 4, 7} {
+		g := buildTriangulatedGrid(n)
+		g.AddEdge(Edge{From: &Vertex{ID: 0}, To: &Vertex{ID: n*n - 1}, Weight: 1})
+		g.AddEdge(Edge{From: &Vertex{ID: n - 1}, To: &Vertex{ID: n * (n - 1)}, Weight: 1})
+		if ok, _ := g.IsPlanar(); ok {
+			t.Errorf("%dx%d grid: expected both corner links to cross", n, n)
+		}
+	}
+}
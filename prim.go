@@ -0,0 +1,239 @@
+package mst
+
+import "container/heap"
+
+// ==================== EAGER PRIM ALGORITHM ====================
+
+// PrimEager finds MST using the eager variant of Prim's algorithm
+// It keeps one queue entry per vertex and lowers its key when a cheaper
+// edge is found, so memory stays O(V) instead of O(E) on dense graphs
+// The queue implementation can be chosen with WithHeap or WithDAryHeap
+func (g *Graph) PrimEager(startID int, opts ...Option) ([]*Edge, int) {
+	if g.Directed {
+		panic("Prim algorithm only works for undirected graphs")
+	}
+
+	start, exists := g.Vertices[startID]
+	if !exists {
+		return nil, 0
+	}
+
+	o := newOptions(opts)
+	o.prepareConstraints(g)
+	c := o.constraints
+
+	m := o.startMetrics("prim_eager")
+	defer m.finish()
+	m.startPhase("grow")
+	began := o.logStart("prim_eager", g)
+
+	mst := make([]*Edge, 0)
+	totalWeight := 0
+	visited := make(map[int]bool)
+
+	// A criterion or tie breakers key each edge by its rank in Kruskal's order
+	key := c.key
+	if o.ordered() {
+		ranks := o.edgeRanks(g)
+		key = func(e *Edge) int { return ranks[e] }
+	}
+
+	pq := newVertexHeap(o, g.VertexCount(), g.EdgeCount())
+	visited[start.ID] = true
+	o.traceVertex("prim_eager", start.ID)
+	for _, edge := range start.Edges {
+		m.count(CounterEdgesScanned)
+		if !visited[edge.To.ID] && c.allowed(edge) {
+			o.traceEdge("prim_eager", EventEdgeConsidered, edge)
+			m.count(CounterHeapPushes)
+			pq.Push(edge.To.ID, key(edge), edge)
+		}
+	}
+
+	for pq.Len() > 0 && len(mst) < g.VertexCount()-1 {
+		id, _, edge := pq.PopMin()
+		m.count(CounterHeapPops)
+
+		// Add edge to MST
+		o.traceEdge("prim_eager", EventEdgeAccepted, edge)
+		mst = append(mst, edge)
+		totalWeight += edge.Weight
+		visited[id] = true
+		o.traceVertex("prim_eager", id)
+
+		// Relax edges from the new vertex
+		for _, nextEdge := range g.Vertices[id].Edges {
+			m.count(CounterEdgesScanned)
+			if !visited[nextEdge.To.ID] && c.allowed(nextEdge) {
+				o.traceEdge("prim_eager", EventEdgeConsidered, nextEdge)
+				m.count(CounterHeapPushes)
+				pq.Push(nextEdge.To.ID, key(nextEdge), nextEdge)
+			}
+		}
+	}
+
+	o.logFinish("prim_eager", g, mst, totalWeight, len(mst) < g.VertexCount()-1, began)
+	return mst, totalWeight
+}
+
+// ==================== DENSE PRIM ALGORITHM ====================
+
+// PrimDense finds MST using the array-based O(V²) variant of Prim's algorithm
+// Instead of a heap it scans every vertex for the cheapest connection at each
+// step, which beats the heap-based variants on near-complete graphs
+func (g *Graph) PrimDense(startID int, opts ...Option) ([]*Edge, int) {
+	if g.Directed {
+		panic("Prim algorithm only works for undirected graphs")
+	}
+
+	if _, exists := g.Vertices[startID]; !exists {
+		return nil, 0
+	}
+
+	// Map vertex IDs to dense indices
+	n := g.VertexCount()
+	ids := g.SortedVertexIDs()
+	index := make(map[int]int, n)
+	for i, id := range ids {
+		index[id] = i
+	}
+
+	o := newOptions(opts)
+	o.prepareConstraints(g)
+	c := o.constraints
+
+	m := o.startMetrics("prim_dense")
+	defer m.finish()
+	m.startPhase("grow")
+	began := o.logStart("prim_dense", g)
+
+	less := c.less
+	if o.ordered() {
+		less = o.less
+	}
+
+	best := make([]*Edge, n)
+	inTree := make([]bool, n)
+
+	mst := make([]*Edge, 0, n-1)
+	totalWeight := 0
+
+	current := index[startID]
+	for {
+		inTree[current] = true
+		o.traceVertex("prim_dense", ids[current])
+
+		// Relax edges from the newest tree vertex
+		for _, edge := range g.Vertices[ids[current]].Edges {
+			m.count(CounterEdgesScanned)
+			to := index[edge.To.ID]
+			if !inTree[to] && c.allowed(edge) {
+				o.traceEdge("prim_dense", EventEdgeConsidered, edge)
+				if best[to] == nil || less(edge, best[to]) {
+					best[to] = edge
+				}
+			}
+		}
+
+		// Scan for the cheapest vertex outside the tree
+		next := -1
+		for i := 0; i < n; i++ {
+			if !inTree[i] && best[i] != nil && (next == -1 || less(best[i], best[next])) {
+				next = i
+			}
+		}
+		if next == -1 {
+			break
+		}
+
+		o.traceEdge("prim_dense", EventEdgeAccepted, best[next])
+		mst = append(mst, best[next])
+		totalWeight += best[next].Weight
+		current = next
+	}
+
+	o.logFinish("prim_dense", g, mst, totalWeight, len(mst) < n-1, began)
+	return mst, totalWeight
+}
+
+// ==================== MULTI-SEED PRIM FOREST ====================
+
+// PrimForest grows one tree from each seed simultaneously, always adding the
+// cheapest edge leaving any tree, and returns the resulting spanning forest,
+// the seed that owns each reached vertex, and the total weight
+// This partitions the graph around the seeds like a weighted Voronoi diagram
+// Seeds missing from the graph are ignored, and vertices not connected to any
+// seed are absent from the owner map. As in Prim, required edges are taken
+// as soon as a tree reaches them, ahead of any lighter edge; a required edge
+// whose endpoints end up owned by different seeds cannot be kept
+func (g *Graph) PrimForest(seedIDs []int, opts ...Option) ([]*Edge, map[int]int, int) {
+	if g.Directed {
+		panic("Prim algorithm only works for undirected graphs")
+	}
+
+	o := newOptions(opts)
+	o.prepareConstraints(g)
+	c := o.constraints
+
+	m := o.startMetrics("prim_forest")
+	defer m.finish()
+	m.startPhase("grow")
+	began := o.logStart("prim_forest", g)
+
+	forest := make([]*Edge, 0)
+	owner := make(map[int]int)
+	totalWeight := 0
+
+	pq := newEdgeQueue(o)
+	requiredPQ := newEdgeQueue(o)
+	push := func(v Vertex) {
+		for _, edge := range v.Edges {
+			m.count(CounterEdgesScanned)
+			if _, reached := owner[edge.To.ID]; reached || !c.allowed(edge) {
+				continue
+			}
+			m.count(CounterHeapPushes)
+			if c.isRequired(edge) {
+				heap.Push(requiredPQ, edge)
+			} else {
+				heap.Push(pq, edge)
+			}
+		}
+	}
+
+	for _, id := range seedIDs {
+		seed, exists := g.Vertices[id]
+		if _, reached := owner[id]; !exists || reached {
+			continue
+		}
+		owner[id] = id
+		o.traceVertex("prim_forest", id)
+		push(seed)
+	}
+
+	for pq.Len()+requiredPQ.Len() > 0 {
+		var edge *Edge
+		if requiredPQ.Len() > 0 {
+			edge = heap.Pop(requiredPQ).(*Edge)
+		} else {
+			edge = heap.Pop(pq).(*Edge)
+		}
+		m.count(CounterHeapPops)
+		o.traceEdge("prim_forest", EventEdgeConsidered, edge)
+		if _, reached := owner[edge.To.ID]; reached {
+			o.traceEdge("prim_forest", EventEdgeRejected, edge)
+			m.count(CounterCyclesRejected)
+			continue
+		}
+
+		o.traceEdge("prim_forest", EventEdgeAccepted, edge)
+		forest = append(forest, edge)
+		totalWeight += edge.Weight
+		owner[edge.To.ID] = owner[edge.From.ID]
+		o.traceVertex("prim_forest", edge.To.ID)
+		push(g.Vertices[edge.To.ID])
+	}
+
+	o.logFinish("prim_forest", g, forest, totalWeight, len(owner) < g.VertexCount(), began)
+	return forest, owner, totalWeight
+}
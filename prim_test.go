@@ -0,0 +1,212 @@
+package mst
+
+import (
+	"fmt"
+	"testing"
+)
+
+// buildCompleteGraph creates a complete undirected graph with pseudo-random weights
+func buildCompleteGraph(n int) Graph {
+	g := NewGraph(false)
+	vertices := make([]*Vertex, n)
+	for i := 0; i < n; i++ {
+		vertices[i] = &Vertex{ID: i, Name: fmt.Sprintf("V%d", i), Edges: make([]*Edge, 0)}
+	}
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			g.AddEdge(Edge{From: vertices[i], To: vertices[j], Weight: (i*31+j*17)%97 + 1})
+		}
+	}
+	return g
+}
+
+// TestPrimEager tests that eager Prim matches Kruskal
+func TestPrimEager(t *testing.T) {
+	fmt.Println("\n=== EAGER PRIM TEST ===")
+
+	g := buildCompleteGraph(30)
+
+	_, weightKruskal := g.Kruskal()
+	mst, weightEager := g.PrimEager(0)
+	fmt.Printf("Kruskal: %d, Eager Prim: %d\n", weightKruskal, weightEager)
+
+	if len(mst) != g.VertexCount()-1 {
+		t.Errorf("Expected %d edges in MST, got %d", g.VertexCount()-1, len(mst))
+	}
+	if weightEager != weightKruskal {
+		t.Errorf("Expected MST weight %d, got %d", weightKruskal, weightEager)
+	}
+	if GetMSTWeight(mst) != weightEager {
+		t.Errorf("Expected edge weights to sum to %d, got %d", weightEager, GetMSTWeight(mst))
+	}
+}
+
+// TestPrimDense tests that dense Prim matches Kruskal
+func TestPrimDense(t *testing.T) {
+	fmt.Println("\n=== DENSE PRIM TEST ===")
+
+	g := buildCompleteGraph(30)
+
+	_, weightKruskal := g.Kruskal()
+	mst, weightDense := g.PrimDense(0)
+	fmt.Printf("Kruskal: %d, Dense Prim: %d\n", weightKruskal, weightDense)
+
+	if len(mst) != g.VertexCount()-1 {
+		t.Errorf("Expected %d edges in MST, got %d", g.VertexCount()-1, len(mst))
+	}
+	if weightDense != weightKruskal {
+		t.Errorf("Expected MST weight %d, got %d", weightKruskal, weightDense)
+	}
+
+	if mst, _ := g.PrimDense(999); mst != nil {
+		t.Error("Expected nil MST for a missing start vertex")
+	}
+}
+
+// TestPrimForest tests growing trees from several hub sites
+func TestPrimForest(t *testing.T) {
+	fmt.Println("\n=== PRIM FOREST TEST ===")
+
+	// A path 0-1-2-3-4-5 where the middle link is expensive
+	g := NewGraph(false)
+	v := make([]*Vertex, 6)
+	for i := range v {
+		v[i] = &Vertex{ID: i, Name: fmt.Sprintf("V%d", i), Edges: make([]*Edge, 0)}
+	}
+	weights := []int{1, 2, 9, 2, 1}
+	for i, w := range weights {
+		g.AddEdge(Edge{From: v[i], To: v[i+1], Weight: w})
+	}
+
+	forest, owner, totalWeight := g.PrimForest([]int{0, 5, 42})
+	fmt.Printf("  owners: %v, weight: %d\n", owner, totalWeight)
+
+	if len(forest) != 4 || totalWeight != 6 {
+		t.Errorf("Expected 4 edges with weight 6, got %d edges with weight %d", len(forest), totalWeight)
+	}
+	expected := map[int]int{0: 0, 1: 0, 2: 0, 3: 5, 4: 5, 5: 5}
+	for id, seed := range expected {
+		if owner[id] != seed {
+			t.Errorf("Vertex %d: expected owner %d, got %d", id, seed, owner[id])
+		}
+	}
+	if _, exists := owner[42]; exists {
+		t.Error("Expected missing seed to be ignored")
+	}
+
+	// A single seed is plain Prim
+	_, _, single := g.PrimForest([]int{0})
+	if _, w := g.Prim(0); single != w {
+		t.Errorf("Expected single-seed forest weight %d, got %d", w, single)
+	}
+
+	// Requiring the expensive middle link lets seed 0 claim vertex 3
+	middle, _ := g.GetEdge(2, 3)
+	forest, owner, totalWeight = g.PrimForest([]int{0, 5}, WithRequiredEdges(middle))
+	if !containsPair(forest, 2, 3) || owner[3] != 0 || totalWeight != 13 {
+		t.Errorf("Expected the required edge 2-3 with vertex 3 owned by 0 and weight 13, got %v, owner %d, weight %d",
+			forest, owner[3], totalWeight)
+	}
+	_, _, single = g.PrimForest([]int{0}, WithRequiredEdges(middle))
+	if _, w := g.Prim(0, WithRequiredEdges(middle)); single != w {
+		t.Errorf("Expected single-seed forest weight %d with the required edge, got %d", w, single)
+	}
+
+	// Forbidding the middle link keeps both trees apart
+	forest, owner, _ = g.PrimForest([]int{0}, WithForbiddenEdges(middle))
+	if containsPair(forest, 2, 3) || len(owner) != 3 {
+		t.Errorf("Expected the forbidden edge to stop the tree at vertex 2, got %v", owner)
+	}
+}
+
+// TestIndexedPriorityQueue tests decrease-key ordering
+func TestIndexedPriorityQueue(t *testing.T) {
+	pq := NewIndexedPriorityQueue(4)
+	pq.Push(1, 10, nil)
+	pq.Push(2, 5, nil)
+	pq.Push(3, 7, nil)
+
+	if !pq.DecreaseKey(1, 1, nil) {
+		t.Error("Expected DecreaseKey to succeed")
+	}
+	if pq.DecreaseKey(3, 9, nil) {
+		t.Error("Expected DecreaseKey with a larger key to fail")
+	}
+
+	expected := []int{1, 2, 3}
+	for _, want := range expected {
+		if id, _, _ := pq.PopMin(); id != want {
+			t.Errorf("Expected vertex %d, got %d", want, id)
+		}
+	}
+	if pq.Len() != 0 {
+		t.Errorf("Expected empty queue, got %d items", pq.Len())
+	}
+}
+
+// BenchmarkPrimDenseLazy benchmarks lazy Prim on a complete graph
+func BenchmarkPrimDenseLazy(b *testing.B) {
+	g := buildCompleteGraph(200)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		g.Prim(0)
+	}
+}
+
+// BenchmarkPrimDenseEager benchmarks eager Prim on a complete graph
+func BenchmarkPrimDenseEager(b *testing.B) {
+	g := buildCompleteGraph(200)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		g.PrimEager(0)
+	}
+}
+
+// BenchmarkPrimDenseArray benchmarks array-based Prim on a complete graph
+func BenchmarkPrimDenseArray(b *testing.B) {
+	g := buildCompleteGraph(200)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		g.PrimDense(0)
+	}
+}
+
+// TestPrimTieBreakers tests that every Prim variant follows tie breakers like Kruskal
+func TestPrimTieBreakers(t *testing.T) {
+	fmt.Println("\n=== PRIM TIE BREAKERS TEST ===")
+
+	// A cycle of equal-cost links where latency decides which one is left out
+	g := NewGraph(false)
+	v := []*Vertex{{ID: 0}, {ID: 1}, {ID: 2}, {ID: 3}}
+	for i, latency := range []int{9, 2, 5, 1} {
+		e := g.AddEdge(Edge{From: v[i], To: v[(i+1)%4], Weight: 1})
+		g.SetEdgeWeightBy(e, "latency", latency)
+	}
+	latencyOf := func(tree []*Edge) int {
+		total := 0
+		for _, e := range tree {
+			total += e.Weights["latency"]
+		}
+		return total
+	}
+
+	opt := WithSecondaryCriterion("latency")
+	kruskal, _ := g.Kruskal(opt)
+	want := latencyOf(kruskal)
+	for name, run := range map[string]func(int, ...Option) ([]*Edge, int){
+		"Prim":      g.Prim,
+		"PrimEager": g.PrimEager,
+		"PrimDense": g.PrimDense,
+	} {
+		for start := range 4 {
+			tree, total := run(start, opt)
+			if total != 3 || latencyOf(tree) != want {
+				t.Errorf("%s from %d: expected weight 3 and latency %d, got %d and %d", name, start, want, total, latencyOf(tree))
+			}
+		}
+	}
+	forest, _, _ := g.PrimForest([]int{0}, opt)
+	if latencyOf(forest) != want {
+		t.Errorf("PrimForest: expected latency %d, got %d", want, latencyOf(forest))
+	}
+}
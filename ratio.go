@@ -0,0 +1,76 @@
+package mst
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ==================== MINIMUM RATIO SPANNING TREE ====================
+
+// ErrNonPositiveBenefit is returned when a spanning tree has a total
+// benefit of zero or less, so its cost ratio is undefined
+var ErrNonPositiveBenefit = errors.New("spanning tree benefit is not positive")
+
+// MinRatioTree finds the spanning tree minimizing total cost divided by
+// total benefit, such as cost per unit of capacity, and returns it with
+// that ratio
+// Both are named criteria from Edge.Weights, or CriterionWeight for
+// Edge.Weight. Dinkelbach's parametric search starts from the cheapest
+// tree with ratio λ and repeatedly computes the MST under cost - λ·benefit;
+// while that is negative the new tree has a smaller ratio, and once it is
+// not the ratio is optimal. Keys are compared exactly in integers, so
+// |cost|·(total benefit) and |benefit|·(total cost) must fit in an int.
+// Missing criteria return an error wrapping ErrMissingCriterion, and a
+// tree with non-positive total benefit one wrapping ErrNonPositiveBenefit
+// Options such as WithRequiredEdges apply to every MST computed
+func (g *Graph) MinRatioTree(cost, benefit string, opts ...Option) ([]*Edge, float64, error) {
+	if g.Directed {
+		panic("MinRatioTree only works for undirected graphs")
+	}
+	costOf, err := g.criterion(cost)
+	if err != nil {
+		return nil, 0, err
+	}
+	benefitOf, err := g.criterion(benefit)
+	if err != nil {
+		return nil, 0, err
+	}
+	value := func(read func(*Edge) int, edge *Edge) int {
+		if read == nil {
+			return edge.Weight
+		}
+		return read(edge)
+	}
+	totals := func(tree []*Edge) (c, b int) {
+		for _, e := range tree {
+			c += value(costOf, e)
+			b += value(benefitOf, e)
+		}
+		return c, b
+	}
+
+	o := newOptions(opts)
+	o.prepareConstraints(g)
+	o.weight = costOf
+	tree, _ := g.kruskal(o)
+	if len(tree) == 0 {
+		return tree, 0, nil
+	}
+
+	c, b := totals(tree)
+	for {
+		if b <= 0 {
+			return nil, 0, fmt.Errorf("%w: cost %d, benefit %d", ErrNonPositiveBenefit, c, b)
+		}
+		// Minimize cost - (c/b)·benefit, scaled by b to stay in integers
+		o.weight = func(e *Edge) int {
+			return value(costOf, e)*b - c*value(benefitOf, e)
+		}
+		next, gap := g.kruskal(o)
+		if gap >= 0 {
+			return tree, float64(c) / float64(b), nil
+		}
+		tree = next
+		c, b = totals(tree)
+	}
+}
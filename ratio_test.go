@@ -0,0 +1,83 @@
+package mst
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+// TestMinRatioTree tests Dinkelbach's search against every spanning tree
+func TestMinRatioTree(t *testing.T) {
+	fmt.Println("\n=== MINIMUM RATIO TREE TEST ===")
+
+	rng := rand.New(rand.NewSource(7))
+	g := buildCompleteGraph(6)
+	for _, e := range g.Edges {
+		g.SetEdgeWeightBy(e, "capacity", 1+rng.Intn(40))
+	}
+
+	// Compare ratios as fractions so ties are exact
+	bestCost, bestCapacity := -1, 1
+	for tree := range g.AllSpanningTrees(0) {
+		c, b := 0, 0
+		for _, e := range tree {
+			c += e.Weight
+			b += e.Weights["capacity"]
+		}
+		if bestCost < 0 || c*bestCapacity < bestCost*b {
+			bestCost, bestCapacity = c, b
+		}
+	}
+	want := float64(bestCost) / float64(bestCapacity)
+
+	tree, ratio, err := g.MinRatioTree(CriterionWeight, "capacity")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fmt.Printf("Cost per unit of capacity: %.4f (exhaustive %.4f)\n", ratio, want)
+	if ratio != want || !validSpanningTree(g, tree) {
+		t.Errorf("Expected ratio %v, got %v", want, ratio)
+	}
+	if cheapest, cost := g.Kruskal(); ratio > float64(cost)/float64(capacityOf(cheapest)) {
+		t.Error("Expected the ratio tree to be no worse than the cheapest tree")
+	}
+
+	// An edge with zero cost and huge capacity must be used
+	e, _ := g.GetEdge(0, 5)
+	g.SetEdgeWeight(e, 0)
+	g.SetEdgeWeightBy(e, "capacity", 1000)
+	tree, _, _ = g.MinRatioTree(CriterionWeight, "capacity")
+	found := false
+	for _, te := range tree {
+		found = found || te == e || te == e.twin
+	}
+	if !found {
+		t.Error("Expected the free high-capacity edge in the tree")
+	}
+}
+
+// capacityOf sums the capacity criterion over a tree
+func capacityOf(tree []*Edge) int {
+	total := 0
+	for _, e := range tree {
+		total += e.Weights["capacity"]
+	}
+	return total
+}
+
+// TestMinRatioTreeErrors tests missing criteria and undefined ratios
+func TestMinRatioTreeErrors(t *testing.T) {
+	fmt.Println("\n=== MINIMUM RATIO TREE ERRORS TEST ===")
+
+	g := buildCompleteGraph(4)
+	if _, _, err := g.MinRatioTree(CriterionWeight, "capacity"); !errors.Is(err, ErrMissingCriterion) {
+		t.Errorf("Expected ErrMissingCriterion, got %v", err)
+	}
+	for _, e := range g.Edges {
+		g.SetEdgeWeightBy(e, "capacity", 0)
+	}
+	if _, _, err := g.MinRatioTree(CriterionWeight, "capacity"); !errors.Is(err, ErrNonPositiveBenefit) {
+		t.Errorf("Expected ErrNonPositiveBenefit, got %v", err)
+	}
+}
@@ -0,0 +1,188 @@
+package mst
+
+import "math/bits"
+
+// ==================== REACHABILITY ====================
+
+// Reachable reports whether toID can be reached from fromID along edge
+// directions. Every vertex reaches itself; missing vertices reach nothing
+func (g *Graph) Reachable(fromID, toID int) bool {
+	if _, exists := g.Vertices[fromID]; !exists {
+		return false
+	}
+	if _, exists := g.Vertices[toID]; !exists {
+		return false
+	}
+
+	visited := map[int]bool{fromID: true}
+	stack := []int{fromID}
+	for len(stack) > 0 {
+		v := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if v == toID {
+			return true
+		}
+		for _, e := range g.Vertices[v].Edges {
+			if !visited[e.To.ID] {
+				visited[e.To.ID] = true
+				stack = append(stack, e.To.ID)
+			}
+		}
+	}
+	return false
+}
+
+// Closure is the transitive closure of a graph, answering reachability in O(1)
+// Each vertex has a bitset row of the vertices it reaches, itself included;
+// vertices in the same strongly connected component share one row
+type Closure struct {
+	ids   []int // vertex IDs in ascending order, bit i is ids[i]
+	index map[int]int
+	rows  [][]uint64
+}
+
+// TransitiveClosure computes which vertices reach which
+// It condenses strongly connected components with Tarjan's algorithm and
+// ORs the bitsets of successor components in reverse topological order,
+// so the cost is O(V + E) component steps plus O(V·E/64) word operations
+func (g *Graph) TransitiveClosure() *Closure {
+	ids := g.SortedVertexIDs()
+	n := len(ids)
+	index := make(map[int]int, n)
+	for i, id := range ids {
+		index[id] = i
+	}
+	adj := make([][]int, n)
+	for i, id := range ids {
+		for _, e := range g.Vertices[id].Edges {
+			adj[i] = append(adj[i], index[e.To.ID])
+		}
+	}
+
+	comp, count := tarjanSCC(adj)
+
+	// Components are numbered sinks first, so successors are always done
+	words := (n + 63) / 64
+	members := make([][]int, count)
+	for v, c := range comp {
+		members[c] = append(members[c], v)
+	}
+	compRows := make([][]uint64, count)
+	for c := 0; c < count; c++ {
+		row := make([]uint64, words)
+		for _, v := range members[c] {
+			row[v/64] |= 1 << (v % 64)
+		}
+		for _, v := range members[c] {
+			for _, w := range adj[v] {
+				if d := comp[w]; d != c {
+					for i, word := range compRows[d] {
+						row[i] |= word
+					}
+				}
+			}
+		}
+		compRows[c] = row
+	}
+
+	rows := make([][]uint64, n)
+	for v := range rows {
+		rows[v] = compRows[comp[v]]
+	}
+	return &Closure{ids: ids, index: index, rows: rows}
+}
+
+// tarjanSCC labels every vertex of a dense adjacency list with its strongly
+// connected component, numbered in reverse topological order, without recursion
+func tarjanSCC(adj [][]int) (comp []int, count int) {
+	n := len(adj)
+	order := make([]int, n)
+	low := make([]int, n)
+	comp = make([]int, n)
+	onStack := make([]bool, n)
+	for i := range order {
+		order[i] = -1
+	}
+
+	type frame struct{ v, next int }
+	stack := make([]int, 0, n)
+	counter := 0
+	for s := 0; s < n; s++ {
+		if order[s] >= 0 {
+			continue
+		}
+		order[s], low[s] = counter, counter
+		counter++
+		stack = append(stack, s)
+		onStack[s] = true
+		calls := []frame{{v: s}}
+
+		for len(calls) > 0 {
+			f := &calls[len(calls)-1]
+			if f.next < len(adj[f.v]) {
+				w := adj[f.v][f.next]
+				f.next++
+				if order[w] < 0 {
+					order[w], low[w] = counter, counter
+					counter++
+					stack = append(stack, w)
+					onStack[w] = true
+					calls = append(calls, frame{v: w})
+				} else if onStack[w] {
+					low[f.v] = min(low[f.v], order[w])
+				}
+				continue
+			}
+
+			v := f.v
+			calls = calls[:len(calls)-1]
+			if len(calls) > 0 {
+				parent := calls[len(calls)-1].v
+				low[parent] = min(low[parent], low[v])
+			}
+			if low[v] == order[v] {
+				for {
+					w := stack[len(stack)-1]
+					stack = stack[:len(stack)-1]
+					onStack[w] = false
+					comp[w] = count
+					if w == v {
+						break
+					}
+				}
+				count++
+			}
+		}
+	}
+	return comp, count
+}
+
+// Reachable reports whether toID can be reached from fromID
+func (c *Closure) Reachable(fromID, toID int) bool {
+	from, ok := c.index[fromID]
+	if !ok {
+		return false
+	}
+	to, ok := c.index[toID]
+	if !ok {
+		return false
+	}
+	return c.rows[from][to/64]&(1<<(to%64)) != 0
+}
+
+// ReachableFrom returns every vertex reachable from id, itself included, in ascending order
+func (c *Closure) ReachableFrom(id int) []int {
+	from, ok := c.index[id]
+	if !ok {
+		return nil
+	}
+	reached := make([]int, 0)
+	for i, word := range c.rows[from] {
+		for word != 0 {
+			b := bits.TrailingZeros64(word)
+			reached = append(reached, c.ids[i*64+b])
+			word &= word - 1
+		}
+	}
+	return reached
+}
@@ -0,0 +1,52 @@
+package mst
+
+import (
+	"fmt"
+	"testing"
+)
+
+// TestTransitiveClosure tests reachability queries on a dependency graph
+func TestTransitiveClosure(t *testing.T) {
+	fmt.Println("\n=== REACHABILITY TEST ===")
+
+	// 0 -> 1 <-> 2 -> 3, and 4 -> 0, plus an isolated vertex 99
+	g := NewGraph(true)
+	v := make(map[int]*Vertex)
+	for _, id := range []int{0, 1, 2, 3, 4, 99} {
+		v[id] = &Vertex{ID: id}
+		g.AddVertex(*v[id])
+	}
+	for _, e := range [][2]int{{0, 1}, {1, 2}, {2, 1}, {2, 3}, {4, 0}} {
+		g.AddEdge(Edge{From: v[e[0]], To: v[e[1]], Weight: 1})
+	}
+
+	c := g.TransitiveClosure()
+	for from := range v {
+		for to := range v {
+			if got, want := c.Reachable(from, to), g.Reachable(from, to); got != want {
+				t.Errorf("Reachable(%d, %d): closure %v, search %v", from, to, got, want)
+			}
+		}
+	}
+
+	if got := fmt.Sprint(c.ReachableFrom(4)); got != "[0 1 2 3 4]" {
+		t.Errorf("Expected 4 to reach [0 1 2 3 4], got %s", got)
+	}
+	if got := fmt.Sprint(c.ReachableFrom(2)); got != "[1 2 3]" {
+		t.Errorf("Expected 2 to reach [1 2 3], got %s", got)
+	}
+	if g.Reachable(3, 0) || c.Reachable(0, 99) || c.Reachable(0, 1000) {
+		t.Error("Expected no path against edge direction or to missing vertices")
+	}
+
+	// A long chain spans several bitset words
+	chain := NewGraph(true)
+	for i := 0; i < 130; i++ {
+		from, to := Vertex{ID: i}, Vertex{ID: i + 1}
+		chain.AddEdge(Edge{From: &from, To: &to, Weight: 1})
+	}
+	cc := chain.TransitiveClosure()
+	if !cc.Reachable(0, 130) || cc.Reachable(130, 0) || len(cc.ReachableFrom(65)) != 66 {
+		t.Error("Expected chain reachability across bitset words")
+	}
+}
@@ -0,0 +1,97 @@
+package mst
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+)
+
+// ==================== ALGORITHM REGISTRY ====================
+
+// ErrUnknownAlgorithm is returned when no algorithm is registered under a name
+var ErrUnknownAlgorithm = errors.New("unknown MST algorithm")
+
+// AlgorithmPrim is the registry name of the lazy Prim implementation
+const AlgorithmPrim = "prim"
+
+// Algorithm is a spanning tree implementation that can be registered by name
+// Algorithms that grow from a vertex start from the smallest vertex ID
+type Algorithm func(g *Graph, opts ...Option) ([]*Edge, int)
+
+var (
+	registryMu sync.RWMutex
+	registry   = map[string]Algorithm{
+		AlgorithmKruskal:   (*Graph).Kruskal,
+		AlgorithmBoruvka:   (*Graph).Boruvka,
+		AlgorithmPrim:      fromSmallest((*Graph).Prim),
+		AlgorithmPrimEager: fromSmallest((*Graph).PrimEager),
+		AlgorithmPrimDense: fromSmallest((*Graph).PrimDense),
+	}
+)
+
+// fromSmallest adapts a Prim variant to the Algorithm signature
+func fromSmallest(prim func(g *Graph, startID int, opts ...Option) ([]*Edge, int)) Algorithm {
+	return func(g *Graph, opts ...Option) ([]*Edge, int) {
+		return prim(g, g.smallestVertexID(), opts...)
+	}
+}
+
+// smallestVertexID returns the smallest vertex ID, or math.MaxInt for an empty graph
+func (g *Graph) smallestVertexID() int {
+	start := math.MaxInt
+	for id := range g.Vertices {
+		start = min(start, id)
+	}
+	return start
+}
+
+// Register makes an algorithm available under name to Lookup and Run
+// The built-in algorithms are registered under the Algorithm* constants
+// Like database/sql.Register, it panics if the name is already taken or
+// the algorithm is nil, since both are programming errors
+func Register(name string, algorithm Algorithm) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	if algorithm == nil {
+		panic("mst: Register algorithm is nil")
+	}
+	if _, taken := registry[name]; taken {
+		panic("mst: Register called twice for algorithm " + name)
+	}
+	registry[name] = algorithm
+}
+
+// Lookup returns the algorithm registered under name
+func Lookup(name string) (Algorithm, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	algorithm, ok := registry[name]
+	return algorithm, ok
+}
+
+// Algorithms returns the registered algorithm names in sorted order
+func Algorithms() []string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Run computes a spanning tree with the algorithm registered under name,
+// which lets callers pick one from configuration or user input
+// The total is accumulated in int64 as in MSTResult; unknown names fail
+// with an error wrapping ErrUnknownAlgorithm
+func (g *Graph) Run(name string, opts ...Option) (MSTResult, error) {
+	algorithm, ok := Lookup(name)
+	if !ok {
+		return MSTResult{Algorithm: name, Unit: g.Unit}, fmt.Errorf("%w: %q", ErrUnknownAlgorithm, name)
+	}
+	tree, _ := algorithm(g, opts...)
+	return newMSTResult(name, tree, g.Unit)
+}
@@ -0,0 +1,47 @@
+package mst
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+)
+
+// TestRegistry tests running built-in and user algorithms by name
+func TestRegistry(t *testing.T) {
+	fmt.Println("\n=== REGISTRY TEST ===")
+
+	g := buildCompleteGraph(20)
+	_, want := g.Kruskal()
+	for _, name := range []string{AlgorithmKruskal, AlgorithmPrim, AlgorithmPrimEager, AlgorithmPrimDense, AlgorithmBoruvka} {
+		result, err := g.Run(name)
+		if err != nil || result.TotalWeight != int64(want) || result.Algorithm != name {
+			t.Errorf("%s: expected total %d, got %+v (%v)", name, want, result, err)
+		}
+	}
+
+	// A plugin registers once and is then listed and runnable
+	if _, ok := Lookup("test_bfs"); !ok {
+		Register("test_bfs", func(g *Graph, opts ...Option) ([]*Edge, int) {
+			return g.SpanningTreeBFS(g.smallestVertexID())
+		})
+	}
+	fmt.Println("Registered:", Algorithms())
+	if !slices.Contains(Algorithms(), "test_bfs") {
+		t.Error("Expected test_bfs to be listed")
+	}
+	if result, err := g.Run("test_bfs"); err != nil || len(result.Edges) != 19 {
+		t.Errorf("Expected a 19-edge tree from the plugin, got %d (%v)", len(result.Edges), err)
+	}
+
+	if _, err := g.Run("missing"); !errors.Is(err, ErrUnknownAlgorithm) {
+		t.Errorf("Expected ErrUnknownAlgorithm, got %v", err)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected a panic when registering a name twice")
+		}
+	}()
+	Register(AlgorithmKruskal, (*Graph).Kruskal)
+}
@@ -0,0 +1,145 @@
+package mst
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+)
+
+// ==================== NETWORK RELIABILITY ====================
+
+// MaxExactReliabilityEdges bounds the graphs ReliabilityExact accepts
+// The factoring algorithm it uses is exponential in the number of edges
+const MaxExactReliabilityEdges = 30
+
+// ErrGraphTooLarge is returned when an exact computation would take too long
+var ErrGraphTooLarge = errors.New("graph too large for exact computation")
+
+// FailureFunc returns the probability that an edge fails
+type FailureFunc func(edge *Edge) float64
+
+// ConstantFailure is a FailureFunc giving every edge the same failure probability
+func ConstantFailure(p float64) FailureFunc {
+	return func(*Edge) float64 { return p }
+}
+
+// FailureFromData is a FailureFunc reading a float64 probability from Edge.Data
+// Edges without one never fail
+func FailureFromData(edge *Edge) float64 {
+	p, _ := edge.Data.(float64)
+	return p
+}
+
+// TreeReliability returns the probability that every edge of a tree, and
+// therefore the tree as a whole, survives
+func TreeReliability(tree []*Edge, fail FailureFunc) float64 {
+	r := 1.0
+	for _, e := range tree {
+		r *= 1 - fail(e)
+	}
+	return r
+}
+
+// ReliabilityExact returns the probability that the graph stays connected
+// when edges fail independently (all-terminal reliability)
+// It uses the factoring recursion R(G) = (1-p)·R(G/e) + p·R(G-e), stopping as
+// soon as the survivors connect the graph or can no longer do so
+func (g *Graph) ReliabilityExact(fail FailureFunc) (float64, error) {
+	if g.Directed {
+		panic("Reliability only works for undirected graphs")
+	}
+	if len(g.Edges) > MaxExactReliabilityEdges {
+		return 0, fmt.Errorf("%w: %d edges, limit %d", ErrGraphTooLarge, len(g.Edges), MaxExactReliabilityEdges)
+	}
+	if g.VertexCount() <= 1 {
+		return 1, nil
+	}
+
+	uf := NewRollbackDisjointSet[int]()
+	for id := range g.Vertices {
+		uf.MakeSet(id)
+	}
+
+	edges := make([]*Edge, 0, len(g.Edges))
+	probs := make([]float64, 0, len(g.Edges))
+	for _, e := range g.Edges {
+		if e.From.ID != e.To.ID {
+			edges = append(edges, e)
+			probs = append(probs, fail(e))
+		}
+	}
+
+	var factor func(i int) float64
+	factor = func(i int) float64 {
+		if uf.Count() == 1 {
+			return 1
+		}
+		if uf.Count()-1 > len(edges)-i {
+			return 0
+		}
+		e, p := edges[i], probs[i]
+		if uf.Connected(e.From.ID, e.To.ID) {
+			return factor(i + 1)
+		}
+
+		survived := 0.0
+		if p < 1 {
+			snapshot := uf.Snapshot()
+			uf.Union(e.From.ID, e.To.ID)
+			survived = factor(i + 1)
+			uf.Rollback(snapshot)
+		}
+		failed := 0.0
+		if p > 0 {
+			failed = factor(i + 1)
+		}
+		return (1-p)*survived + p*failed
+	}
+	return factor(0), nil
+}
+
+// ReliabilityMonteCarlo estimates the probability that the graph stays
+// connected by sampling edge failures. A nil rng uses a randomly seeded one
+func (g *Graph) ReliabilityMonteCarlo(fail FailureFunc, samples int, rng *rand.Rand) float64 {
+	if g.Directed {
+		panic("Reliability only works for undirected graphs")
+	}
+	if samples <= 0 {
+		return 0
+	}
+	if rng == nil {
+		rng = rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64()))
+	}
+
+	index := make(map[int]int, len(g.Vertices))
+	for id := range g.Vertices {
+		index[id] = len(index)
+	}
+	probs := make([]float64, len(g.Edges))
+	for i, e := range g.Edges {
+		probs[i] = fail(e)
+	}
+
+	connected := 0
+	for s := 0; s < samples; s++ {
+		uf := NewDenseUnionFind(len(index))
+		for i, e := range g.Edges {
+			if rng.Float64() >= probs[i] {
+				uf.Union(index[e.From.ID], index[e.To.ID])
+			}
+		}
+		if uf.Count() <= 1 {
+			connected++
+		}
+	}
+	return float64(connected) / float64(samples)
+}
+
+// Reliability returns the exact connectivity probability for small graphs
+// and a Monte Carlo estimate from samples draws otherwise
+func (g *Graph) Reliability(fail FailureFunc, samples int, rng *rand.Rand) float64 {
+	if r, err := g.ReliabilityExact(fail); err == nil {
+		return r
+	}
+	return g.ReliabilityMonteCarlo(fail, samples, rng)
+}
@@ -0,0 +1,55 @@
+package mst
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+// TestReliability tests exact and sampled all-terminal reliability
+func TestReliability(t *testing.T) {
+	fmt.Println("\n=== NETWORK RELIABILITY TEST ===")
+
+	// Triangle: connected unless two or more edges fail
+	g := NewGraph(false)
+	v := make([]Vertex, 3)
+	for i := range v {
+		v[i] = Vertex{ID: i}
+	}
+	g.AddEdge(Edge{From: &v[0], To: &v[1], Weight: 1})
+	g.AddEdge(Edge{From: &v[1], To: &v[2], Weight: 2})
+	g.AddEdge(Edge{From: &v[0], To: &v[2], Weight: 3})
+
+	p := 0.1
+	want := math.Pow(1-p, 3) + 3*p*math.Pow(1-p, 2)
+	exact, err := g.ReliabilityExact(ConstantFailure(p))
+	if err != nil || math.Abs(exact-want) > 1e-12 {
+		t.Errorf("Expected %.6f, got %.6f (%v)", want, exact, err)
+	}
+
+	mst, _ := g.Kruskal()
+	if r := TreeReliability(mst, ConstantFailure(p)); math.Abs(r-0.81) > 1e-12 {
+		t.Errorf("Expected tree reliability 0.81, got %.6f", r)
+	}
+
+	estimate := g.ReliabilityMonteCarlo(ConstantFailure(p), 20000, rand.New(rand.NewPCG(3, 4)))
+	fmt.Printf("exact %.4f, estimate %.4f\n", exact, estimate)
+	if math.Abs(estimate-exact) > 0.01 {
+		t.Errorf("Expected estimate near %.4f, got %.4f", exact, estimate)
+	}
+
+	// Per-edge probabilities from Data: edges that never fail
+	for _, e := range g.Edges {
+		e.Data = 0.0
+	}
+	if r := g.Reliability(FailureFromData, 100, nil); r != 1 {
+		t.Errorf("Expected reliability 1 without failures, got %f", r)
+	}
+
+	big := buildCompleteGraph(10)
+	if _, err := big.ReliabilityExact(ConstantFailure(p)); !errors.Is(err, ErrGraphTooLarge) {
+		t.Errorf("Expected ErrGraphTooLarge, got %v", err)
+	}
+}
@@ -0,0 +1,97 @@
+package mst
+
+import "sort"
+
+// ==================== REPLACEMENT EDGE ANALYSIS ====================
+
+// Replacement describes what happens to the MST if a tree edge is lost
+type Replacement struct {
+	TreeEdge    *Edge // edge of the MST
+	Replacement *Edge // cheapest non-tree edge reconnecting the tree, nil for a bridge
+	Delta       int   // increase of the MST weight when swapping in the replacement
+}
+
+// IsBridge reports whether losing the tree edge disconnects the graph
+func (r Replacement) IsBridge() bool {
+	return r.Replacement == nil
+}
+
+// IsCritical reports whether the tree edge belongs to every MST of the graph
+// That is the case when it has no replacement or every replacement is strictly heavier
+func (r Replacement) IsCritical() bool {
+	return r.Replacement == nil || r.Delta > 0
+}
+
+// ReplacementEdges computes an MST and, for every MST edge, the cheapest
+// non-tree edge that could replace it together with the resulting weight delta
+// Non-tree edges are processed in ascending weight order and each one is
+// assigned to the still unassigned tree edges on its tree path, so the whole
+// analysis costs O(E log E)
+func (g *Graph) ReplacementEdges() []Replacement {
+	mst, _ := g.Kruskal()
+	return g.replacementsOf(mst)
+}
+
+// replacementsOf runs the replacement analysis against any spanning forest of g
+// When the forest is not minimal some deltas are negative, each marking a
+// swap that would lower the total weight
+func (g *Graph) replacementsOf(mst []*Edge) []Replacement {
+	inTree := make(map[*Edge]bool, len(mst))
+	for _, e := range mst {
+		inTree[e] = true
+	}
+
+	nonTree := make([]*Edge, 0, len(g.Edges)-len(mst))
+	for _, e := range g.Edges {
+		if !inTree[e] && e.From.ID != e.To.ID {
+			nonTree = append(nonTree, e)
+		}
+	}
+	sort.SliceStable(nonTree, func(i, j int) bool {
+		return nonTree[i].Weight < nonTree[j].Weight
+	})
+
+	rf := rootForest(mst, 0)
+
+	// jump[v] skips over vertices whose parent edge is already assigned
+	jump := make(map[int]int)
+	find := func(v int) int {
+		for {
+			next, exists := jump[v]
+			if !exists {
+				return v
+			}
+			if further, ok := jump[next]; ok {
+				jump[v] = further
+			}
+			v = next
+		}
+	}
+
+	best := make(map[int]*Edge) // child vertex of a tree edge -> replacement
+	for _, e := range nonTree {
+		u, v := find(e.From.ID), find(e.To.ID)
+		for u != v {
+			if rf.depth[u] < rf.depth[v] {
+				u, v = v, u
+			}
+			best[u] = e
+			jump[u] = rf.parent[u]
+			u = find(u)
+		}
+	}
+
+	result := make([]Replacement, 0, len(mst))
+	for _, e := range mst {
+		child := e.To.ID
+		if rf.parentEdge[child] != e {
+			child = e.From.ID
+		}
+		r := Replacement{TreeEdge: e, Replacement: best[child]}
+		if r.Replacement != nil {
+			r.Delta = r.Replacement.Weight - e.Weight
+		}
+		result = append(result, r)
+	}
+	return result
+}
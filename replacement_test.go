@@ -0,0 +1,68 @@
+package mst
+
+import (
+	"fmt"
+	"testing"
+)
+
+// TestReplacementEdges tests replacement edges against a brute-force recompute
+func TestReplacementEdges(t *testing.T) {
+	fmt.Println("\n=== REPLACEMENT EDGES TEST ===")
+
+	g := NewGraph(false)
+	vertices := make([]*Vertex, 6)
+	for i := 0; i < 6; i++ {
+		vertices[i] = &Vertex{ID: i, Name: fmt.Sprintf("V%d", i), Edges: make([]*Edge, 0)}
+	}
+	edges := []struct{ from, to, weight int }{
+		{0, 1, 4}, {0, 2, 2}, {1, 2, 1}, {1, 3, 5}, {2, 3, 8},
+		{2, 4, 10}, {3, 4, 2}, {3, 5, 6}, {4, 5, 3},
+	}
+	for _, e := range edges {
+		g.AddEdge(Edge{From: vertices[e.from], To: vertices[e.to], Weight: e.weight})
+	}
+	_, baseWeight := g.Kruskal()
+
+	for _, r := range g.ReplacementEdges() {
+		// Rebuild the graph without the tree edge and compare
+		h := NewGraph(false)
+		for _, e := range g.Edges {
+			if e != r.TreeEdge {
+				h.AddEdge(Edge{From: vertices[e.From.ID], To: vertices[e.To.ID], Weight: e.Weight})
+			}
+		}
+		mst, weight := h.Kruskal()
+		fmt.Printf("  %s -> replacement %v, delta %d\n", r.TreeEdge, r.Replacement, r.Delta)
+
+		if r.IsBridge() {
+			if len(mst) == 5 {
+				t.Errorf("Edge %s reported as bridge but graph stays connected", r.TreeEdge)
+			}
+			continue
+		}
+		if weight-baseWeight != r.Delta {
+			t.Errorf("Edge %s: expected delta %d, got %d", r.TreeEdge, weight-baseWeight, r.Delta)
+		}
+	}
+}
+
+// TestReplacementBridge tests that a pendant edge is reported as a bridge
+func TestReplacementBridge(t *testing.T) {
+	g := NewGraph(false)
+	v0 := &Vertex{ID: 0, Edges: make([]*Edge, 0)}
+	v1 := &Vertex{ID: 1, Edges: make([]*Edge, 0)}
+	v2 := &Vertex{ID: 2, Edges: make([]*Edge, 0)}
+	g.AddEdge(Edge{From: v0, To: v1, Weight: 1})
+	g.AddEdge(Edge{From: v1, To: v2, Weight: 1})
+	g.AddEdge(Edge{From: v0, To: v1, Weight: 1}) // parallel edge with equal weight
+
+	for _, r := range g.ReplacementEdges() {
+		isPendant := r.TreeEdge.From.ID == 2 || r.TreeEdge.To.ID == 2
+		if isPendant != r.IsBridge() {
+			t.Errorf("Edge %s: expected bridge=%v", r.TreeEdge, isPendant)
+		}
+		if !isPendant && r.IsCritical() {
+			t.Errorf("Edge %s has an equal-weight replacement and should not be critical", r.TreeEdge)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package mst
+
+import (
+	"errors"
+	"math"
+)
+
+// ==================== OVERFLOW-SAFE RESULTS ====================
+
+// ErrWeightOverflow is returned when a total weight does not fit in an int64
+var ErrWeightOverflow = errors.New("total weight overflows int64")
+
+// MSTResult is a spanning tree with its weight accumulated in int64
+// Unlike the int totals returned by Kruskal and Prim it cannot overflow
+// silently on 32-bit targets
+type MSTResult struct {
+	Algorithm   string
+	Edges       []*Edge
+	TotalWeight int64
+	Unit        WeightUnit // copied from Graph.Unit
+}
+
+// SumWeights adds up edge weights in int64 and fails with ErrWeightOverflow
+// instead of wrapping around
+func SumWeights(edges []*Edge) (int64, error) {
+	var total int64
+	for _, edge := range edges {
+		w := int64(edge.Weight)
+		if (w > 0 && total > math.MaxInt64-w) || (w < 0 && total < math.MinInt64-w) {
+			return 0, ErrWeightOverflow
+		}
+		total += w
+	}
+	return total, nil
+}
+
+// KruskalResult runs Kruskal and returns the tree with an overflow-checked total
+func (g *Graph) KruskalResult(opts ...Option) (MSTResult, error) {
+	mst, _ := g.Kruskal(opts...)
+	return newMSTResult("kruskal", mst, g.Unit)
+}
+
+// PrimResult runs Prim from startID and returns the tree with an overflow-checked total
+func (g *Graph) PrimResult(startID int, opts ...Option) (MSTResult, error) {
+	mst, _ := g.Prim(startID, opts...)
+	return newMSTResult("prim", mst, g.Unit)
+}
+
+func newMSTResult(algorithm string, mst []*Edge, unit WeightUnit) (MSTResult, error) {
+	total, err := SumWeights(mst)
+	if err != nil {
+		return MSTResult{Algorithm: algorithm, Edges: mst, Unit: unit}, err
+	}
+	return MSTResult{Algorithm: algorithm, Edges: mst, TotalWeight: total, Unit: unit}, nil
+}
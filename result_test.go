@@ -0,0 +1,43 @@
+package mst
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+)
+
+// TestSumWeights tests overflow-checked weight accumulation
+func TestSumWeights(t *testing.T) {
+	fmt.Println("\n=== OVERFLOW-SAFE RESULT TEST ===")
+
+	g := NewGraph(false)
+	v := make([]Vertex, 3)
+	for i := range v {
+		v[i] = Vertex{ID: i}
+	}
+	g.AddEdge(Edge{From: &v[0], To: &v[1], Weight: 4})
+	g.AddEdge(Edge{From: &v[1], To: &v[2], Weight: -2})
+
+	res, err := g.KruskalResult()
+	if err != nil || res.TotalWeight != 2 || len(res.Edges) != 2 {
+		t.Errorf("Expected total 2 over 2 edges, got %+v (%v)", res, err)
+	}
+	if res, _ := g.PrimResult(0); res.TotalWeight != 2 || res.Algorithm != "prim" {
+		t.Errorf("Expected prim total 2, got %+v", res)
+	}
+
+	if math.MaxInt == math.MaxInt64 {
+		big := []*Edge{{Weight: math.MaxInt}, {Weight: 1}}
+		if _, err := SumWeights(big); !errors.Is(err, ErrWeightOverflow) {
+			t.Errorf("Expected ErrWeightOverflow, got %v", err)
+		}
+		small := []*Edge{{Weight: math.MinInt}, {Weight: -1}}
+		if _, err := SumWeights(small); !errors.Is(err, ErrWeightOverflow) {
+			t.Errorf("Expected ErrWeightOverflow for underflow, got %v", err)
+		}
+		if total, err := SumWeights([]*Edge{{Weight: math.MaxInt}, {Weight: -1}, {Weight: 1}}); err != nil || total != math.MaxInt {
+			t.Errorf("Expected MaxInt64 without error, got %d (%v)", total, err)
+		}
+	}
+}
@@ -0,0 +1,247 @@
+package mst
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// ==================== HOP-CONSTRAINED ROOTED TREE ====================
+
+// ErrHopLimit is returned when a vertex is farther from the root than the
+// hop limit allows along any path
+var ErrHopLimit = errors.New("vertex beyond hop limit")
+
+// hopTree is a spanning tree hanging from a root, with the tree edge
+// leading into every other vertex
+type hopTree struct {
+	root   int
+	parent map[int]int
+	via    map[int]*Edge
+	depth  map[int]int
+}
+
+// RootedMST finds a low-weight spanning tree in which every vertex is at
+// most maxHops edges from the root, as in hub-and-spoke network design
+// where latency or failure domains grow with each hop
+// The tree is grown like Prim's algorithm from the root, never extending
+// a vertex that is already maxHops deep; if that strands a vertex, the
+// fewest-hops BFS tree is used instead. Either way, subtrees are then
+// moved to cheaper parents while the bound still holds, until no move
+// helps. Hop-constrained MST is NP-hard, so this is a heuristic; when the
+// MST from the root already meets the bound it is returned unchanged
+// An error wrapping ErrVertexNotFound is returned for an unknown root, one
+// wrapping ErrDisconnected when some vertex cannot be reached, and one
+// wrapping ErrHopLimit when some vertex is more than maxHops from the root
+func (g *Graph) RootedMST(rootID int, maxHops int) ([]*Edge, int, error) {
+	if g.Directed {
+		panic("RootedMST only works for undirected graphs")
+	}
+	if _, exists := g.Vertices[rootID]; !exists {
+		return nil, 0, fmt.Errorf("rooted MST root %d: %w", rootID, ErrVertexNotFound)
+	}
+
+	hops := g.hopDistances(rootID)
+	for _, id := range g.SortedVertexIDs() {
+		h, reached := hops[id]
+		if !reached {
+			return nil, 0, fmt.Errorf("rooted MST from %d to %d: %w", rootID, id, ErrDisconnected)
+		}
+		if h > maxHops {
+			return nil, 0, fmt.Errorf("rooted MST: %d is %d hops from %d, limit %d: %w", id, h, rootID, maxHops, ErrHopLimit)
+		}
+	}
+
+	t, ok := g.hopLimitedPrim(rootID, maxHops)
+	if !ok {
+		t = g.fewestHopsTree(rootID)
+	}
+	t.improve(g, maxHops)
+	return t.edges(), t.weight(), nil
+}
+
+// hopDistances returns the number of edges on a shortest path from root
+// to every reachable vertex
+func (g *Graph) hopDistances(root int) map[int]int {
+	hops := map[int]int{root: 0}
+	queue := []int{root}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		for _, e := range g.Vertices[id].Edges {
+			if _, seen := hops[e.To.ID]; !seen {
+				hops[e.To.ID] = hops[id] + 1
+				queue = append(queue, e.To.ID)
+			}
+		}
+	}
+	return hops
+}
+
+// hopLimitedPrim grows a Prim tree from root that never attaches below
+// depth maxHops, and reports whether it reached every vertex
+func (g *Graph) hopLimitedPrim(root, maxHops int) (*hopTree, bool) {
+	t := newHopTree(root)
+	pq := NewDAryHeap(4, len(g.Vertices))
+	offer := func(id int) {
+		if t.depth[id] >= maxHops {
+			return
+		}
+		for _, e := range g.Vertices[id].Edges {
+			if _, inTree := t.depth[e.To.ID]; !inTree {
+				pq.Push(e.To.ID, e.Weight, e)
+			}
+		}
+	}
+
+	offer(root)
+	for pq.Len() > 0 {
+		id, _, e := pq.PopMin()
+		t.attach(id, e)
+		offer(id)
+	}
+	return t, len(t.depth) == len(g.Vertices)
+}
+
+// fewestHopsTree returns a BFS tree from root, preferring the lightest
+// edge among equally short ones
+func (g *Graph) fewestHopsTree(root int) *hopTree {
+	t := newHopTree(root)
+	frontier := []int{root}
+	for len(frontier) > 0 {
+		best := make(map[int]*Edge)
+		for _, id := range frontier {
+			for _, e := range g.Vertices[id].Edges {
+				if _, inTree := t.depth[e.To.ID]; inTree {
+					continue
+				}
+				if cur, seen := best[e.To.ID]; !seen || e.Weight < cur.Weight {
+					best[e.To.ID] = e
+				}
+			}
+		}
+		frontier = frontier[:0]
+		for id, e := range best {
+			t.attach(id, e)
+			frontier = append(frontier, id)
+		}
+		slices.Sort(frontier)
+	}
+	return t
+}
+
+func newHopTree(root int) *hopTree {
+	return &hopTree{
+		root:   root,
+		parent: make(map[int]int),
+		via:    make(map[int]*Edge),
+		depth:  map[int]int{root: 0},
+	}
+}
+
+// attach hangs vertex id below the other endpoint of e
+func (t *hopTree) attach(id int, e *Edge) {
+	p := e.From.ID
+	if p == id {
+		p = e.To.ID
+	}
+	t.parent[id] = p
+	t.via[id] = e
+	t.depth[id] = t.depth[p] + 1
+}
+
+// improve repeatedly moves the subtree whose move saves the most weight to
+// a cheaper parent, as long as its deepest vertex stays within maxHops
+func (t *hopTree) improve(g *Graph, maxHops int) {
+	for {
+		children := t.children()
+		var bestID int
+		var bestEdge *Edge
+		saving := 0
+		for id, cur := range t.via {
+			subtree, height := t.subtree(id, children)
+			for _, e := range g.Vertices[id].Edges {
+				p := e.To.ID
+				if subtree[p] || t.depth[p]+1+height > maxHops {
+					continue
+				}
+				if s := cur.Weight - e.Weight; s > saving || (s == saving && s > 0 && id < bestID) {
+					bestID, bestEdge, saving = id, e, s
+				}
+			}
+		}
+		if bestEdge == nil {
+			return
+		}
+		t.reattach(bestID, bestEdge, children)
+	}
+}
+
+// children lists the children of every vertex in ascending ID order
+func (t *hopTree) children() map[int][]int {
+	children := make(map[int][]int, len(t.depth))
+	for id, p := range t.parent {
+		children[p] = append(children[p], id)
+	}
+	for _, ids := range children {
+		slices.Sort(ids)
+	}
+	return children
+}
+
+// subtree returns the vertices below and including id, and how many levels
+// lie beneath id
+func (t *hopTree) subtree(id int, children map[int][]int) (map[int]bool, int) {
+	members := map[int]bool{id: true}
+	height := 0
+	stack := []int{id}
+	for len(stack) > 0 {
+		v := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		height = max(height, t.depth[v]-t.depth[id])
+		for _, c := range children[v] {
+			members[c] = true
+			stack = append(stack, c)
+		}
+	}
+	return members, height
+}
+
+// reattach moves the subtree of id below the other endpoint of e and
+// updates the depths inside it
+func (t *hopTree) reattach(id int, e *Edge, children map[int][]int) {
+	delta := t.depth[e.To.ID] + 1 - t.depth[id]
+	t.parent[id] = e.To.ID
+	t.via[id] = e
+	stack := []int{id}
+	for len(stack) > 0 {
+		v := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		t.depth[v] += delta
+		stack = append(stack, children[v]...)
+	}
+}
+
+// edges returns the tree edges in breadth-first order from the root
+func (t *hopTree) edges() []*Edge {
+	children := t.children()
+	edges := make([]*Edge, 0, len(t.via))
+	queue := []int{t.root}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		for _, c := range children[id] {
+			edges = append(edges, t.via[c])
+			queue = append(queue, c)
+		}
+	}
+	return edges
+}
+
+func (t *hopTree) weight() int {
+	total := 0
+	for _, e := range t.via {
+		total += e.Weight
+	}
+	return total
+}
@@ -0,0 +1,141 @@
+package mst
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// buildSpokedPath returns a cheap path 0-1-2-3-4 plus a costly spoke from 0 to every other vertex
+func buildSpokedPath() Graph {
+	g := NewGraph(false)
+	v := func(id int) *Vertex { return &Vertex{ID: id} }
+	for i := range 4 {
+		g.AddEdge(Edge{From: v(i), To: v(i + 1), Weight: 1})
+	}
+	for i := 2; i <= 4; i++ {
+		g.AddEdge(Edge{From: v(0), To: v(i), Weight: 10})
+	}
+	return g
+}
+
+// treeDepths returns the hop depth of every vertex of a tree hanging from root
+func treeDepths(tree []*Edge, root int) map[int]int {
+	adjacent := make(map[int][]int)
+	for _, e := range tree {
+		adjacent[e.From.ID] = append(adjacent[e.From.ID], e.To.ID)
+		adjacent[e.To.ID] = append(adjacent[e.To.ID], e.From.ID)
+	}
+	depth := map[int]int{root: 0}
+	queue := []int{root}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		for _, next := range adjacent[id] {
+			if _, seen := depth[next]; !seen {
+				depth[next] = depth[id] + 1
+				queue = append(queue, next)
+			}
+		}
+	}
+	return depth
+}
+
+// TestRootedMST tests hop-constrained trees on a path with spokes
+func TestRootedMST(t *testing.T) {
+	fmt.Println("\n=== ROOTED MST TEST ===")
+
+	g := buildSpokedPath()
+	for _, tc := range []struct {
+		maxHops, weight int
+	}{
+		{4, 4},  // the path itself
+		{2, 13}, // 0-1-2 and 0-3-4 (or 0-4-3)
+		{1, 31}, // a pure star
+	} {
+		tree, total, err := g.RootedMST(0, tc.maxHops)
+		if err != nil {
+			t.Fatal(err)
+		}
+		PrintMST(tree, total, fmt.Sprintf("ROOTED MST, %d HOPS", tc.maxHops))
+		if total != tc.weight || len(tree) != 4 || !validSpanningTree(g, tree) {
+			t.Errorf("maxHops %d: expected a spanning tree of weight %d, got %d edges of weight %d",
+				tc.maxHops, tc.weight, len(tree), total)
+		}
+		for id, d := range treeDepths(tree, 0) {
+			if d > tc.maxHops {
+				t.Errorf("maxHops %d: vertex %d is %d hops deep", tc.maxHops, id, d)
+			}
+		}
+	}
+
+	if _, _, err := g.RootedMST(0, 0); !errors.Is(err, ErrHopLimit) {
+		t.Errorf("Expected ErrHopLimit, got %v", err)
+	}
+	if _, _, err := g.RootedMST(9, 2); !errors.Is(err, ErrVertexNotFound) {
+		t.Errorf("Expected ErrVertexNotFound, got %v", err)
+	}
+	g.AddVertex(Vertex{ID: 9})
+	if _, _, err := g.RootedMST(0, 4); !errors.Is(err, ErrDisconnected) {
+		t.Errorf("Expected ErrDisconnected, got %v", err)
+	}
+}
+
+// TestRootedMSTFallback tests a graph where growing greedily strands a vertex
+func TestRootedMSTFallback(t *testing.T) {
+	fmt.Println("\n=== ROOTED MST FALLBACK TEST ===")
+
+	g := NewGraph(false)
+	v := func(id int) *Vertex { return &Vertex{ID: id} }
+	g.AddEdges([]Edge{
+		{From: v(0), To: v(1), Weight: 1},
+		{From: v(1), To: v(2), Weight: 1},
+		{From: v(0), To: v(2), Weight: 10},
+		{From: v(2), To: v(3), Weight: 1},
+	})
+
+	// Prim puts 2 two hops deep through 1, leaving no room for 3
+	tree, total, err := g.RootedMST(0, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 12 || !validSpanningTree(g, tree) {
+		t.Errorf("Expected a spanning tree of weight 12, got %d", total)
+	}
+}
+
+// TestRootedMSTComplete tests the bound and the MST case on complete graphs
+func TestRootedMSTComplete(t *testing.T) {
+	fmt.Println("\n=== ROOTED MST COMPLETE GRAPH TEST ===")
+
+	g := buildCompleteGraph(30)
+	_, mstWeight := g.Kruskal()
+	for _, maxHops := range []int{29, 4, 3, 2, 1} {
+		tree, total, err := g.RootedMST(0, maxHops)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fmt.Printf("maxHops %2d: weight %d (MST %d)\n", maxHops, total, mstWeight)
+		if !validSpanningTree(g, tree) || total < mstWeight {
+			t.Errorf("maxHops %d: expected a valid tree no lighter than the MST, got weight %d", maxHops, total)
+		}
+		for id, d := range treeDepths(tree, 0) {
+			if d > maxHops {
+				t.Errorf("maxHops %d: vertex %d is %d hops deep", maxHops, id, d)
+			}
+		}
+		if maxHops == 29 && total != mstWeight {
+			t.Errorf("Expected an unbounded tree to be the MST, got %d", total)
+		}
+		if maxHops == 1 {
+			star := 0
+			for id := 1; id < 30; id++ {
+				e, _ := g.GetEdge(0, id)
+				star += e.Weight
+			}
+			if total != star {
+				t.Errorf("Expected the star of weight %d, got %d", star, total)
+			}
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package mst
+
+import (
+	"math/rand/v2"
+	"slices"
+)
+
+// ==================== RANDOM WALKS & SAMPLING ====================
+
+// samplingRand returns the generator behind a seeded walk or sample, so
+// that equal seeds repeat the same result on the same graph
+func samplingRand(seed int64) *rand.Rand {
+	return rand.New(rand.NewPCG(uint64(seed), 0))
+}
+
+// RandomWalk walks steps times from startID along a uniformly chosen edge
+// and returns the visited vertices, startID first
+// Directed graphs follow edge direction, and the walk ends early at a
+// vertex without outgoing edges; parallel edges make a neighbor more
+// likely and self-loops stay in place. Weights are ignored
+func (g *Graph) RandomWalk(startID int, steps int, seed int64) ([]int, error) {
+	v, exists := g.Vertices[startID]
+	if !exists {
+		return nil, ErrVertexNotFound
+	}
+	rng := samplingRand(seed)
+	walk := make([]int, 1, steps+1)
+	walk[0] = startID
+	for range steps {
+		if len(v.Edges) == 0 {
+			break
+		}
+		v = g.Vertices[v.Edges[rng.IntN(len(v.Edges))].To.ID]
+		walk = append(walk, v.ID)
+	}
+	return walk, nil
+}
+
+// SampleVertices picks k distinct vertices uniformly at random and returns
+// their IDs in ascending order; k beyond the vertex count returns them all
+func (g *Graph) SampleVertices(k int, seed int64) []int {
+	ids := g.SortedVertexIDs()
+	picked := partialShuffle(ids, k, samplingRand(seed))
+	slices.Sort(picked)
+	return picked
+}
+
+// SampleEdges picks k distinct edges of g.Edges uniformly at random and
+// returns them in g.Edges order; k beyond the edge count returns them all
+func (g *Graph) SampleEdges(k int, seed int64) []*Edge {
+	position := make(map[*Edge]int, len(g.Edges))
+	for i, e := range g.Edges {
+		position[e] = i
+	}
+	picked := partialShuffle(slices.Clone(g.Edges), k, samplingRand(seed))
+	slices.SortFunc(picked, func(a, b *Edge) int { return position[a] - position[b] })
+	return picked
+}
+
+// SampleInducedSubgraph picks k vertices like SampleVertices and returns a
+// copy of the subgraph they induce, with every edge of g between them
+// Vertices and edges keep their names, data, and attributes, so metrics
+// such as ClusteringCoefficient can be estimated on the sample
+// The Attrs and Weights maps are copied, so changing them on the sample
+// leaves g alone; Data values are shared
+func (g *Graph) SampleInducedSubgraph(k int, seed int64) *Graph {
+	ids := g.SampleVertices(k, seed)
+	picked := make(map[int]bool, len(ids))
+	for _, id := range ids {
+		picked[id] = true
+	}
+	sub, _ := g.filterEdges(func(e *Edge) bool {
+		return picked[e.From.ID] && picked[e.To.ID]
+	}, false)
+	for _, id := range ids {
+		if _, exists := sub.Vertices[id]; !exists {
+			v := g.Vertices[id]
+			sub.AddVertex(Vertex{ID: v.ID, Name: v.Name, Data: v.Data, Attrs: v.Attrs, Weight: v.Weight})
+		}
+	}
+	return sub
+}
+
+// partialShuffle moves k random items of s to its front with a partial
+// Fisher-Yates shuffle and returns them
+func partialShuffle[T any](s []T, k int, rng *rand.Rand) []T {
+	k = max(0, min(k, len(s)))
+	for i := range k {
+		j := i + rng.IntN(len(s)-i)
+		s[i], s[j] = s[j], s[i]
+	}
+	return s[:k]
+}
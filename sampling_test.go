@@ -0,0 +1,140 @@
+package mst
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"testing"
+)
+
+// TestRandomWalk tests that walks follow edges and repeat with their seed
+func TestRandomWalk(t *testing.T) {
+	fmt.Println("\n=== RANDOM WALK TEST ===")
+
+	g := buildCompleteGraph(6)
+	walk, err := g.RandomWalk(2, 50, 42)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fmt.Printf("Walk: %v\n", walk[:10])
+	if len(walk) != 51 || walk[0] != 2 {
+		t.Fatalf("Expected 51 vertices starting at 2, got %d starting at %d", len(walk), walk[0])
+	}
+	for i := 1; i < len(walk); i++ {
+		if !g.HasEdge(walk[i-1], walk[i]) {
+			t.Fatalf("Step %d: no edge %d-%d", i, walk[i-1], walk[i])
+		}
+	}
+	again, _ := g.RandomWalk(2, 50, 42)
+	if !reflect.DeepEqual(walk, again) {
+		t.Error("Expected the same seed to repeat the walk")
+	}
+
+	// Visits of a long walk on a regular graph spread evenly
+	long, _ := g.RandomWalk(0, 60000, 7)
+	visits := make(map[int]int)
+	for _, id := range long {
+		visits[id]++
+	}
+	for id, n := range visits {
+		if math.Abs(float64(n)/float64(len(long))-1.0/6) > 0.01 {
+			t.Errorf("Vertex %d: expected about 1/6 of visits, got %d", id, n)
+		}
+	}
+
+	// Directed walks stop at a sink
+	dg := NewGraph(true)
+	dg.AddEdge(Edge{From: &Vertex{ID: 0}, To: &Vertex{ID: 1}, Weight: 1})
+	dg.AddEdge(Edge{From: &Vertex{ID: 1}, To: &Vertex{ID: 2}, Weight: 1})
+	if walk, _ := dg.RandomWalk(0, 10, 1); !reflect.DeepEqual(walk, []int{0, 1, 2}) {
+		t.Errorf("Expected the walk to end at the sink, got %v", walk)
+	}
+	if _, err := dg.RandomWalk(9, 10, 1); !errors.Is(err, ErrVertexNotFound) {
+		t.Errorf("Expected ErrVertexNotFound, got %v", err)
+	}
+}
+
+// TestSampling tests vertex, edge, and induced subgraph samples
+func TestSampling(t *testing.T) {
+	fmt.Println("\n=== SAMPLING TEST ===")
+
+	g := buildCompleteGraph(20)
+	ids := g.SampleVertices(5, 3)
+	fmt.Printf("Sampled vertices: %v\n", ids)
+	if len(ids) != 5 {
+		t.Fatalf("Expected 5 vertices, got %v", ids)
+	}
+	for i := 1; i < len(ids); i++ {
+		if ids[i] <= ids[i-1] {
+			t.Errorf("Expected distinct ascending IDs, got %v", ids)
+		}
+	}
+	if again := g.SampleVertices(5, 3); !reflect.DeepEqual(ids, again) {
+		t.Error("Expected the same seed to repeat the sample")
+	}
+	if all := g.SampleVertices(50, 3); len(all) != 20 {
+		t.Errorf("Expected every vertex for k beyond the count, got %d", len(all))
+	}
+
+	edges := g.SampleEdges(30, 4)
+	seen := make(map[*Edge]bool)
+	for _, e := range edges {
+		if seen[e] {
+			t.Errorf("Edge %d-%d sampled twice", e.From.ID, e.To.ID)
+		}
+		seen[e] = true
+	}
+	if len(edges) != 30 {
+		t.Errorf("Expected 30 edges, got %d", len(edges))
+	}
+
+	// Each vertex of a large sample turns up about as often as any other
+	counts := make(map[int]int)
+	for seed := range int64(2000) {
+		for _, id := range g.SampleVertices(5, seed) {
+			counts[id]++
+		}
+	}
+	for id, n := range counts {
+		if n < 400 || n > 600 {
+			t.Errorf("Vertex %d: expected about 500 picks, got %d", id, n)
+		}
+	}
+
+	// An induced sample of a complete graph is complete
+	sub := g.SampleInducedSubgraph(6, 5)
+	if sub.VertexCount() != 6 || sub.EdgeCount() != 15 {
+		t.Errorf("Expected K6, got %d vertices and %d edges", sub.VertexCount(), sub.EdgeCount())
+	}
+	if g.EdgeCount() != 190 {
+		t.Error("Original graph must not change")
+	}
+
+	// Attribute and criterion maps of the sample are its own
+	attributed := newPathGraph(4, 7)
+	attributed.SetVertexAttr(1, "zone", "east")
+	attributed.SetEdgeAttr(attributed.Edges[0], "kind", "fiber")
+	attributed.SetEdgeWeightBy(attributed.Edges[0], "latency", 9)
+	copied := attributed.SampleInducedSubgraph(3, 1)
+	copied.Vertices[1].Attrs["zone"] = "west"
+	copied.Edges[0].Attrs["kind"] = "copper"
+	copied.Edges[0].Weights["latency"] = 1
+	if attributed.Vertices[1].Attrs["zone"] != "east" || attributed.Edges[0].Attrs["kind"] != "fiber" || attributed.Edges[0].Weights["latency"] != 9 {
+		t.Error("Expected changes to the sample's maps to leave the source graph alone")
+	}
+
+	// Sampled vertices without edges among them are kept
+	path := newPathGraph(1, 1, 1, 1)
+	sparse := path.SampleInducedSubgraph(5, 1)
+	if sparse.VertexCount() != 5 || sparse.EdgeCount() != 4 {
+		t.Errorf("Expected the whole path, got %d vertices and %d edges", sparse.VertexCount(), sparse.EdgeCount())
+	}
+	for seed := range int64(20) {
+		s := path.SampleInducedSubgraph(2, seed)
+		ids := s.SortedVertexIDs()
+		if len(ids) != 2 || (s.EdgeCount() == 1) != (ids[1]-ids[0] == 1) {
+			t.Errorf("Seed %d: expected an edge only between neighbors, got %v with %d edges", seed, ids, s.EdgeCount())
+		}
+	}
+}
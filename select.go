@@ -0,0 +1,69 @@
+package mst
+
+import "math"
+
+// ==================== AUTOMATIC ALGORITHM SELECTION ====================
+
+// Algorithm names reported in MSTResult.Algorithm by MST
+const (
+	AlgorithmKruskal   = "kruskal"
+	AlgorithmPrimEager = "prim_eager"
+	AlgorithmPrimDense = "prim_dense"
+	AlgorithmBoruvka   = "boruvka"
+)
+
+// boruvkaThreshold is the edge count from which MST prefers Borůvka over
+// sorting when the weights are too spread out for the linear-time sorts
+const boruvkaThreshold = 1 << 20
+
+// SelectAlgorithm returns the algorithm MST would run on g with opts
+//   - Kruskal for options only Kruskal honors (criteria, tie breakers,
+//     merge observers) and for disconnected graphs
+//   - PrimDense when at least half of all vertex pairs are joined
+//   - Kruskal for large edge sets whose weights span a small range and
+//     therefore sort in linear time
+//   - Borůvka for very large graphs with widely spread weights
+//   - PrimEager for sparse graphs with an average degree of 8 or more
+//   - Kruskal otherwise
+func (g *Graph) SelectAlgorithm(opts ...Option) string {
+	o := newOptions(opts)
+	v, e := g.VertexCount(), g.EdgeCount()
+	if o.weight != nil || len(o.tieBreakers) > 0 || o.onMerge != nil || v < 2 || !g.IsConnected() {
+		return AlgorithmKruskal
+	}
+
+	if pairs := float64(v) * float64(v-1) / 2; float64(e) >= pairs/2 {
+		return AlgorithmPrimDense
+	}
+
+	lo, hi := math.MaxInt, math.MinInt
+	for _, edge := range g.Edges {
+		lo = min(lo, edge.Weight)
+		hi = max(hi, edge.Weight)
+	}
+	narrow := uint64(hi)-uint64(lo) < uint64(max(1<<16, e))
+	switch {
+	case e >= radixThreshold && narrow:
+		return AlgorithmKruskal
+	case e >= boruvkaThreshold:
+		return AlgorithmBoruvka
+	case e >= 8*v:
+		return AlgorithmPrimEager
+	}
+	return AlgorithmKruskal
+}
+
+// MST computes a minimum spanning tree with the algorithm SelectAlgorithm
+// picks for the shape of g, reporting it in the result's Algorithm field
+// Prim variants start from the smallest vertex ID. The total is
+// accumulated in int64 and ErrWeightOverflow is returned if it overflows
+func (g *Graph) MST(opts ...Option) (MSTResult, error) {
+	if g.Directed {
+		panic("MST only works for undirected graphs")
+	}
+
+	algorithm := g.SelectAlgorithm(opts...)
+	run, _ := Lookup(algorithm)
+	tree, _ := run(g, opts...)
+	return newMSTResult(algorithm, tree, g.Unit)
+}
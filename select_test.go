@@ -0,0 +1,57 @@
+package mst
+
+import (
+	"fmt"
+	"testing"
+)
+
+// TestMSTSelection tests which algorithm MST chooses for different graph shapes
+func TestMSTSelection(t *testing.T) {
+	fmt.Println("\n=== MST AUTO SELECTION TEST ===")
+
+	dense := buildCompleteGraph(30)
+	sparse := NewGraph(false)
+	v := make([]Vertex, 200)
+	for i := range v {
+		v[i] = Vertex{ID: i}
+	}
+	for i := 1; i < len(v); i++ {
+		sparse.AddEdge(Edge{From: &v[i-1], To: &v[i], Weight: i})
+	}
+	// Degree 20 with widely spread weights: too sparse for PrimDense, too wide for counting sort
+	spread := NewGraph(false)
+	for i := 0; i < 50; i++ {
+		for step := 1; step <= 10; step++ {
+			spread.AddEdge(Edge{From: &v[i], To: &v[(i+step)%50], Weight: (i*step + 1) << 20})
+		}
+	}
+	forest := newPathGraph(1, 2)
+	forest.AddEdge(Edge{From: &Vertex{ID: 10}, To: &Vertex{ID: 11}, Weight: 1})
+
+	cases := []struct {
+		name string
+		g    Graph
+		opts []Option
+		want string
+	}{
+		{"dense", dense, nil, AlgorithmPrimDense},
+		{"sparse", sparse, nil, AlgorithmKruskal},
+		{"spread", spread, nil, AlgorithmPrimEager},
+		{"disconnected", forest, nil, AlgorithmKruskal},
+		{"tie breakers", dense, []Option{WithTieBreakers(ByEndpoints)}, AlgorithmKruskal},
+	}
+	for _, c := range cases {
+		result, err := c.g.MST(c.opts...)
+		if err != nil {
+			t.Fatalf("%s: %v", c.name, err)
+		}
+		fmt.Printf("  %s: %s, total %d\n", c.name, result.Algorithm, result.TotalWeight)
+		if result.Algorithm != c.want {
+			t.Errorf("%s: expected %s, got %s", c.name, c.want, result.Algorithm)
+		}
+		_, want := c.g.Kruskal(c.opts...)
+		if result.TotalWeight != int64(want) {
+			t.Errorf("%s: expected total %d, got %d", c.name, want, result.TotalWeight)
+		}
+	}
+}
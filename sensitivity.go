@@ -0,0 +1,54 @@
+package mst
+
+// ==================== EDGE SENSITIVITY ANALYSIS ====================
+
+// EdgeSensitivity reports how far an edge weight can move before the MST changes
+// For a tree edge Slack is how much its weight may increase; for a non-tree
+// edge it is how much its weight may decrease. Changing the weight by exactly
+// Slack creates a tie, changing it by more replaces the edge in (or brings it
+// into) the MST
+type EdgeSensitivity struct {
+	Edge      *Edge
+	InTree    bool
+	Slack     int
+	Unbounded bool // no change of this edge's weight can alter the MST
+}
+
+// Sensitivity computes an MST and the sensitivity of every edge, in g.Edges order
+// Tree edges use the replacement analysis; non-tree edges compare against the
+// heaviest tree edge on the path between their endpoints, found with a PathMaxIndex
+func (g *Graph) Sensitivity() []EdgeSensitivity {
+	replacements := g.ReplacementEdges()
+	mst := make([]*Edge, 0, len(replacements))
+	byEdge := make(map[*Edge]Replacement, len(replacements))
+	for _, r := range replacements {
+		mst = append(mst, r.TreeEdge)
+		byEdge[r.TreeEdge] = r
+	}
+	paths := NewPathMaxIndex(mst)
+
+	result := make([]EdgeSensitivity, 0, len(g.Edges))
+	for _, e := range g.Edges {
+		if r, inTree := byEdge[e]; inTree {
+			result = append(result, EdgeSensitivity{
+				Edge:      e,
+				InTree:    true,
+				Slack:     r.Delta,
+				Unbounded: r.IsBridge(),
+			})
+			continue
+		}
+
+		if e.From.ID == e.To.ID {
+			// Self-loops can never join a spanning tree
+			result = append(result, EdgeSensitivity{Edge: e, Unbounded: true})
+			continue
+		}
+		heaviest, _ := paths.MaxEdgeOnPath(e.From.ID, e.To.ID)
+		result = append(result, EdgeSensitivity{
+			Edge:  e,
+			Slack: e.Weight - heaviest.Weight,
+		})
+	}
+	return result
+}
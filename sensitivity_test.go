@@ -0,0 +1,41 @@
+package mst
+
+import (
+	"fmt"
+	"testing"
+)
+
+// TestSensitivity tests tree and non-tree edge slack on a small graph
+func TestSensitivity(t *testing.T) {
+	fmt.Println("\n=== EDGE SENSITIVITY TEST ===")
+
+	// Square 0-1-2-3 with a diagonal, plus a pendant vertex 4
+	g := NewGraph(false)
+	vertices := make([]*Vertex, 5)
+	for i := 0; i < 5; i++ {
+		vertices[i] = &Vertex{ID: i, Name: fmt.Sprintf("V%d", i), Edges: make([]*Edge, 0)}
+	}
+	edges := []struct{ from, to, weight int }{
+		{0, 1, 1}, {1, 2, 2}, {2, 3, 3}, {3, 0, 7}, {0, 2, 5}, {3, 4, 4},
+	}
+	for _, e := range edges {
+		g.AddEdge(Edge{From: vertices[e.from], To: vertices[e.to], Weight: e.weight})
+	}
+
+	expected := map[[2]int]EdgeSensitivity{
+		{0, 1}: {InTree: true, Slack: 4}, // replaced by 0-2 (5)
+		{1, 2}: {InTree: true, Slack: 3}, // replaced by 0-2 (5)
+		{2, 3}: {InTree: true, Slack: 4}, // replaced by 3-0 (7)
+		{3, 0}: {Slack: 4},               // path max is 2-3 (3)
+		{0, 2}: {Slack: 3},               // path max is 1-2 (2)
+		{3, 4}: {InTree: true, Unbounded: true},
+	}
+
+	for _, s := range g.Sensitivity() {
+		fmt.Printf("  %s in tree: %v, slack: %d, unbounded: %v\n", s.Edge, s.InTree, s.Slack, s.Unbounded)
+		want := expected[[2]int{s.Edge.From.ID, s.Edge.To.ID}]
+		if s.InTree != want.InTree || s.Slack != want.Slack || s.Unbounded != want.Unbounded {
+			t.Errorf("Edge %s: expected %+v, got %+v", s.Edge, want, s)
+		}
+	}
+}
@@ -0,0 +1,344 @@
+package mst
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"math/bits"
+	"math/rand/v2"
+	"slices"
+)
+
+// ==================== AGM GRAPH SKETCH ====================
+
+// ErrSketchVertex is returned when a sketched edge has an endpoint outside [0, n)
+var ErrSketchVertex = errors.New("vertex outside sketch range")
+
+// SketchConfig controls the size and accuracy of a GraphSketch
+// The zero value uses weight classes that double, weights up to 1<<16, one
+// sampling round per bit of the vertex count, and a random seed
+type SketchConfig struct {
+	Epsilon   float64 // weight classes grow by a factor of 1+Epsilon
+	MaxWeight int     // heavier edges are counted in the last class
+	Rounds    int     // independent Borůvka rounds available per class
+	Seed      uint64  // hash seed, 0 for a random one
+}
+
+// maxSketchVertices keeps every edge index u·n+v below sketchPrime
+const maxSketchVertices = 1 << 30
+
+// sketchPrime is the Mersenne prime 2⁶¹-1 that cell index sums are kept modulo
+const sketchPrime = 1<<61 - 1
+
+// sketchCell is a 1-sparse recovery cell: the sums of the value, index times
+// value, and hashed fingerprint of every incidence entry sampled into it
+// The index sum is taken modulo sketchPrime, so it cannot overflow however
+// many edges pass through the cell
+type sketchCell struct {
+	count       int64
+	indexSum    uint64
+	fingerprint uint64
+}
+
+// add folds delta copies of edge index idx into the cell
+func (c *sketchCell) add(idx int64, delta int64, fp uint64) {
+	c.count += delta
+	c.indexSum = addMod61(c.indexSum, mulMod61(residue61(delta), uint64(idx)))
+	c.fingerprint += uint64(delta) * fp
+}
+
+// merge adds another cell into c, which cancels edges seen from both sides
+func (c *sketchCell) merge(o sketchCell) {
+	c.count += o.count
+	c.indexSum = addMod61(c.indexSum, o.indexSum)
+	c.fingerprint += o.fingerprint
+}
+
+// GraphSketch is an AGM-style linear sketch of a weighted edge stream over
+// vertices 0..n-1 that estimates the minimum spanning forest in one pass
+// Each vertex keeps, per weight class and round, an ℓ0-sampler of its signed
+// incidence vector. Summing the samplers of a component cancels its internal
+// edges, so a sample of the sum is an edge leaving the component, which is
+// all Borůvka needs. Deletions are supported
+// Samplers are sized from the stream: one is only allocated once an edge of
+// its class reaches its vertex, and only up to the highest subsampling level
+// an edge has reached, which is about log₂ of the edges it holds. Memory is
+// therefore O(min(m, n · classes) · rounds · log n) for m distinct edges, and
+// never more than O(n · classes · rounds · log n) however long the stream is
+type GraphSketch struct {
+	n, rounds, levels int
+	thresholds        []int    // upper weight bound of each class
+	seeds             []uint64 // level and fingerprint seed of each round
+	samplers          map[int][]sketchCell
+	cells             int // cells held by all samplers
+}
+
+// NewGraphSketch creates an empty sketch for vertex IDs in [0, n)
+// n must be at most 1<<30
+func NewGraphSketch(n int, cfg SketchConfig) *GraphSketch {
+	if n > maxSketchVertices {
+		panic("GraphSketch vertex count exceeds 1<<30")
+	}
+	if cfg.Epsilon <= 0 {
+		cfg.Epsilon = 1
+	}
+	if cfg.MaxWeight <= 0 {
+		cfg.MaxWeight = 1 << 16
+	}
+	if cfg.Rounds <= 0 {
+		cfg.Rounds = bits.Len(uint(n)) + 1
+	}
+
+	thresholds := []int{1}
+	for last := 1; last < cfg.MaxWeight; {
+		last = max(last+1, int(float64(last)*(1+cfg.Epsilon)))
+		thresholds = append(thresholds, last)
+	}
+
+	var rng *rand.Rand
+	if cfg.Seed == 0 {
+		rng = rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64()))
+	} else {
+		rng = rand.New(rand.NewPCG(cfg.Seed, cfg.Seed))
+	}
+	seeds := make([]uint64, 2*cfg.Rounds)
+	for i := range seeds {
+		seeds[i] = rng.Uint64()
+	}
+
+	// An edge index is below n², so this many levels halve it down to one entry
+	levels := 2*bits.Len(uint(n)) + 1
+	return &GraphSketch{
+		n:          n,
+		rounds:     cfg.Rounds,
+		levels:     levels,
+		thresholds: thresholds,
+		seeds:      seeds,
+		samplers:   make(map[int][]sketchCell),
+	}
+}
+
+// Bytes returns the memory held by the sketch cells
+func (s *GraphSketch) Bytes() int {
+	return s.cells * 24
+}
+
+// Insert adds an edge of the given weight to the sketch
+func (s *GraphSketch) Insert(u, v, weight int) error {
+	return s.update(u, v, weight, 1)
+}
+
+// Delete removes an edge previously inserted with the same weight
+func (s *GraphSketch) Delete(u, v, weight int) error {
+	return s.update(u, v, weight, -1)
+}
+
+// Consume inserts every edge of a stream such as ReadEdgeList
+func (s *GraphSketch) Consume(edges iter.Seq2[EdgeRecord, error]) error {
+	for e, err := range edges {
+		if err != nil {
+			return err
+		}
+		if err := s.Insert(e.From, e.To, e.Weight); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// update adds delta to the incidence entry of edge u-v in both endpoints
+func (s *GraphSketch) update(u, v, weight int, delta int64) error {
+	if u < 0 || u >= s.n || v < 0 || v >= s.n {
+		return fmt.Errorf("%w: edge %d-%d with n = %d", ErrSketchVertex, u, v, s.n)
+	}
+	if weight <= 0 {
+		return fmt.Errorf("%w: edge %d-%d has weight %d", ErrNonPositiveWeight, u, v, weight)
+	}
+	if u == v {
+		return nil
+	}
+	if u > v {
+		u, v = v, u
+	}
+
+	// Entries are +delta at the smaller endpoint and -delta at the larger
+	// one, so they cancel once both are in the same component
+	idx := int64(u)*int64(s.n) + int64(v)
+	class := s.classOf(weight)
+	for r := 0; r < s.rounds; r++ {
+		top := min(bits.TrailingZeros64(mix64(uint64(idx), s.seeds[2*r])), s.levels-1)
+		fp := mix64(uint64(idx), s.seeds[2*r+1])
+		lo, hi := s.sampler(u, class, r, top), s.sampler(v, class, r, top)
+		for l := 0; l <= top; l++ {
+			lo[l].add(idx, delta, fp)
+			hi[l].add(idx, -delta, fp)
+		}
+	}
+	return nil
+}
+
+// sampler returns the cells of vertex v, class k, round r, growing them to
+// cover levels 0..top
+func (s *GraphSketch) sampler(v, k, r, top int) []sketchCell {
+	key := s.key(v, k, r)
+	cells := s.samplers[key]
+	if len(cells) <= top {
+		s.cells += top + 1 - len(cells)
+		cells = append(cells, make([]sketchCell, top+1-len(cells))...)
+		s.samplers[key] = cells
+	}
+	return cells
+}
+
+// classOf returns the smallest weight class whose bound is at least weight
+func (s *GraphSketch) classOf(weight int) int {
+	for k, t := range s.thresholds {
+		if weight <= t {
+			return k
+		}
+	}
+	return len(s.thresholds) - 1
+}
+
+// key identifies the sampler of vertex v, class k, round r
+func (s *GraphSketch) key(v, k, r int) int {
+	return (v*len(s.thresholds)+k)*s.rounds + r
+}
+
+// sample recovers one edge index from a summed sampler, reporting whether
+// the sampler is empty or recovery failed
+func (s *GraphSketch) sample(cells []sketchCell, r int) (idx int64, empty, ok bool) {
+	if cells[0] == (sketchCell{}) {
+		return 0, true, false
+	}
+	for l := len(cells) - 1; l >= 0; l-- {
+		c := cells[l]
+		if c.count == 0 {
+			continue
+		}
+		// A 1-sparse cell holds count·idx, so idx is the sum over count mod p
+		idx = int64(mulMod61(c.indexSum, inverseMod61(residue61(c.count))))
+		if idx >= int64(s.n)*int64(s.n) {
+			continue
+		}
+		if c.fingerprint == uint64(c.count)*mix64(uint64(idx), s.seeds[2*r+1]) {
+			return idx, false, true
+		}
+	}
+	return 0, false, false
+}
+
+// ApproximateMST returns a spanning forest of the sketched edges and its
+// weight, where each edge is priced at the upper bound of its weight class
+// The forest is grown class by class with sketch-driven Borůvka rounds, so
+// with high probability the weight is at least the true MST weight and at
+// most 1+Epsilon times it. Recovery can fail on an unlucky round, in which
+// case some components stay unjoined; more Rounds make this rarer
+func (s *GraphSketch) ApproximateMST() ([]EdgeRecord, int) {
+	uf := NewDenseUnionFind(s.n)
+	forest := make([]EdgeRecord, 0)
+	total := 0
+
+	// Only vertices an edge has reached hold samplers, so walk those rather than all n
+	type held struct {
+		v, class int
+		cells    []sketchCell
+	}
+	byRound := make([][]held, s.rounds)
+	for key, cells := range s.samplers {
+		r, rest := key%s.rounds, key/s.rounds
+		v, class := rest/len(s.thresholds), rest%len(s.thresholds)
+		byRound[r] = append(byRound[r], held{v: v, class: class, cells: cells})
+	}
+
+	sums := make(map[int][]sketchCell)
+	for k, bound := range s.thresholds {
+		for r := 0; r < s.rounds; r++ {
+			// Sum the samplers of every component over classes up to k
+			clear(sums)
+			for _, h := range byRound[r] {
+				if h.class > k {
+					continue
+				}
+				root := uf.Find(h.v)
+				acc, seen := sums[root]
+				if !seen {
+					acc = make([]sketchCell, s.levels)
+					sums[root] = acc
+				}
+				for l, cell := range h.cells {
+					acc[l].merge(cell)
+				}
+			}
+
+			pending := false
+			picked := make([]int64, 0, len(sums))
+			for _, acc := range sums {
+				idx, empty, ok := s.sample(acc, r)
+				pending = pending || !empty
+				if ok {
+					picked = append(picked, idx)
+				}
+			}
+			slices.Sort(picked)
+			for _, idx := range picked {
+				u, v := int(idx/int64(s.n)), int(idx%int64(s.n))
+				if uf.Union(u, v) {
+					forest = append(forest, EdgeRecord{From: u, To: v, Weight: bound})
+					total += bound
+				}
+			}
+			if !pending {
+				break
+			}
+		}
+	}
+	return forest, total
+}
+
+// Components estimates the number of connected components of the sketched graph
+func (s *GraphSketch) Components() int {
+	forest, _ := s.ApproximateMST()
+	return s.n - len(forest)
+}
+
+// residue61 returns x modulo sketchPrime as a value in [0, sketchPrime)
+func residue61(x int64) uint64 {
+	r := x % sketchPrime
+	if r < 0 {
+		r += sketchPrime
+	}
+	return uint64(r)
+}
+
+// addMod61 adds two residues modulo sketchPrime
+func addMod61(a, b uint64) uint64 {
+	r := a + b
+	if r >= sketchPrime {
+		r -= sketchPrime
+	}
+	return r
+}
+
+// mulMod61 multiplies two residues modulo sketchPrime, folding the 122-bit
+// product with 2⁶¹ ≡ 1
+func mulMod61(a, b uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	r := lo&sketchPrime + (lo>>61 | hi<<3)
+	if r >= sketchPrime {
+		r -= sketchPrime
+	}
+	return r
+}
+
+// inverseMod61 returns the inverse of a non-zero residue by Fermat's little theorem
+func inverseMod61(a uint64) uint64 {
+	inv := uint64(1)
+	for e := uint64(sketchPrime - 2); e > 0; e >>= 1 {
+		if e&1 == 1 {
+			inv = mulMod61(inv, a)
+		}
+		a = mulMod61(a, a)
+	}
+	return inv
+}
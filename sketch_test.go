@@ -0,0 +1,119 @@
+package mst
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"strings"
+	"testing"
+)
+
+// TestGraphSketch tests the streaming sketch estimate against Kruskal, with deletions
+func TestGraphSketch(t *testing.T) {
+	fmt.Println("\n=== GRAPH SKETCH TEST ===")
+
+	const n = 64
+	rng := rand.New(rand.NewPCG(7, 7))
+	g := NewGraph(false)
+	vertices := make([]*Vertex, n)
+	for i := range vertices {
+		vertices[i] = &Vertex{ID: i}
+	}
+	sketch := NewGraphSketch(n, SketchConfig{Epsilon: 0.25, MaxWeight: 1000, Seed: 42})
+	for i := 1; i < n; i++ {
+		// A random tree plus extra random edges keeps the graph connected
+		for _, j := range []int{rng.IntN(i), rng.IntN(n), rng.IntN(n)} {
+			if i == j {
+				continue
+			}
+			w := rng.IntN(1000) + 1
+			g.AddEdge(Edge{From: vertices[i], To: vertices[j], Weight: w})
+			if err := sketch.Insert(i, j, w); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	// Cheap edges inserted and then deleted must leave no trace
+	noise := make([]EdgeRecord, 50)
+	for i := range noise {
+		noise[i] = EdgeRecord{From: rng.IntN(n), To: rng.IntN(n), Weight: 1}
+		sketch.Insert(noise[i].From, noise[i].To, noise[i].Weight)
+	}
+	for _, e := range noise {
+		sketch.Delete(e.From, e.To, e.Weight)
+	}
+
+	_, want := g.Kruskal()
+	forest, estimate := sketch.ApproximateMST()
+	fmt.Printf("Sketch estimate %d for MST weight %d using %d bytes\n", estimate, want, sketch.Bytes())
+	if len(forest) != n-1 {
+		t.Fatalf("Expected a spanning tree of %d edges, got %d", n-1, len(forest))
+	}
+	if estimate < want || float64(estimate) > 1.25*float64(want) {
+		t.Errorf("Expected estimate within [%d, %.0f], got %d", want, 1.25*float64(want), estimate)
+	}
+	for _, e := range forest {
+		if !g.HasEdge(e.From, e.To) {
+			t.Errorf("Sketch returned edge %d-%d that is not in the graph", e.From, e.To)
+		}
+	}
+
+	// Two separate triangles stay two components
+	split := NewGraphSketch(6, SketchConfig{Seed: 1})
+	split.Consume(ReadEdgeList(strings.NewReader("0 1 1\n1 2 1\n2 0 1\n3 4 1\n4 5 1\n5 3 1\n")))
+	if c := split.Components(); c != 2 {
+		t.Errorf("Expected 2 components, got %d", c)
+	}
+
+	if err := split.Insert(0, 6, 1); !errors.Is(err, ErrSketchVertex) {
+		t.Errorf("Expected ErrSketchVertex, got %v", err)
+	}
+}
+
+// TestGraphSketchStream tests that sketch memory follows the stream, weights
+// must be positive, and repeated inserts of one edge are still recovered
+func TestGraphSketchStream(t *testing.T) {
+	fmt.Println("\n=== GRAPH SKETCH STREAM TEST ===")
+
+	sketch := NewGraphSketch(1<<20, SketchConfig{Seed: 3})
+	if b := sketch.Bytes(); b != 0 {
+		t.Errorf("Expected an empty sketch to hold no cells, got %d bytes", b)
+	}
+	for _, w := range []int{0, -5} {
+		if err := sketch.Insert(0, 1, w); !errors.Is(err, ErrNonPositiveWeight) {
+			t.Errorf("Expected ErrNonPositiveWeight for weight %d, got %v", w, err)
+		}
+	}
+
+	last := 1<<20 - 1
+	for range 1 << 10 {
+		if err := sketch.Insert(last-1, last, 7); err != nil {
+			t.Fatal(err)
+		}
+	}
+	sketch.Insert(last-2, last-1, 3)
+	fmt.Printf("Sparse sketch over 2^20 vertices uses %d bytes\n", sketch.Bytes())
+	if b := sketch.Bytes(); b > 1<<20 {
+		t.Errorf("Expected a three-vertex stream to stay under 1 MiB, got %d bytes", b)
+	}
+
+	// Weights 7 and 3 are priced at their class bounds 8 and 4
+	forest, weight := sketch.ApproximateMST()
+	if len(forest) != 2 || weight != 12 {
+		t.Errorf("Expected 2 edges of total weight 12, got %d edges weighing %d", len(forest), weight)
+	}
+	if c := sketch.Components(); c != 1<<20-2 {
+		t.Errorf("Expected %d components, got %d", 1<<20-2, c)
+	}
+
+	// Count times index overflows int64 here, but the sum is kept modulo a prime
+	cells := make([]sketchCell, 1)
+	idx := int64(last)*(1<<20) + int64(last-1)
+	fp := mix64(uint64(idx), sketch.seeds[1])
+	cells[0].add(idx, 1<<40, fp)
+	cells[0].add(idx, -(1 << 39), fp)
+	if got, _, ok := sketch.sample(cells, 0); !ok || got != idx {
+		t.Errorf("Expected index %d from a heavy cell, got %d (ok %v)", idx, got, ok)
+	}
+}
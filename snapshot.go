@@ -0,0 +1,230 @@
+package mst
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// ==================== VERSIONED SNAPSHOTS ====================
+
+// SnapshotVersion is the snapshot format version written by WriteSnapshot
+const SnapshotVersion = 3
+
+// snapshotFormat tags snapshot documents so other JSON is not misread
+const snapshotFormat = "mst-graph"
+
+// ErrUnsupportedVersion is returned for snapshots newer than this package
+// or with a version no migration is known for
+var ErrUnsupportedVersion = errors.New("unsupported snapshot version")
+
+// SnapshotCodec is the archival JSON format of a graph, not to be confused
+// with the in-memory versions taken by Graph.Snapshot
+//
+//	{"format": "mst-graph", "version": 3, "directed": false,
+//	 "vertices": [{"id": 0, "name": "A"}], "edges": [{"from": 0, "to": 1, "weight": 4}]}
+//
+// Unlike node-link JSON it keeps the weight unit, vertex weights, and the
+// named criteria in Edge.Weights. Every document carries its version, and
+// Decode migrates older versions step by step to the current one, so
+// archived files stay readable as the format evolves
+// Version 1 is the untagged node-link JSON written by WriteNodeLink before
+// snapshots existed; version 2 had no vertex weights
+// Vertex Data and Edge Data are not archived: they can hold any Go value,
+// which JSON cannot represent, and decoded graphs have nil Data throughout
+type SnapshotCodec struct{}
+
+// snapshotHeader identifies the format and version of a document
+type snapshotHeader struct {
+	Format  string `json:"format"`
+	Version int    `json:"version"`
+}
+
+// snapshotV3 is the layout of a version 3 document
+type snapshotV3 struct {
+	snapshotHeader
+	Directed bool             `json:"directed"`
+	Unit     *snapshotUnit    `json:"unit,omitempty"`
+	Vertices []snapshotVertex `json:"vertices"`
+	Edges    []snapshotEdge   `json:"edges"`
+}
+
+// snapshotVertex is a vertex of a version 3 document
+type snapshotVertex struct {
+	ID     int        `json:"id"`
+	Name   string     `json:"name,omitempty"`
+	Weight int        `json:"weight,omitempty"`
+	Attrs  Attributes `json:"attrs,omitempty"`
+}
+
+// snapshotEdge is an edge of a version 3 document
+type snapshotEdge struct {
+	From    int            `json:"from"`
+	To      int            `json:"to"`
+	Weight  int            `json:"weight"`
+	Weights map[string]int `json:"weights,omitempty"`
+	Attrs   Attributes     `json:"attrs,omitempty"`
+}
+
+// snapshotUnit is the serialized form of a WeightUnit
+type snapshotUnit struct {
+	Label    string        `json:"label,omitempty"`
+	Duration time.Duration `json:"duration,omitempty"`
+}
+
+// snapshotMigrations[v] upgrades a version v document to a later version
+// A migration may jump several versions, e.g. by re-encoding the decoded
+// graph with Encode, as long as the result reports a higher version
+var snapshotMigrations = map[int]func(doc []byte) ([]byte, error){
+	1: migrateNodeLinkSnapshot,
+	2: migrateVertexWeightSnapshot,
+}
+
+// WriteSnapshot writes the graph in the current snapshot format
+// Vertex and edge Data are not archived
+func (g *Graph) WriteSnapshot(w io.Writer) error {
+	return SnapshotCodec{}.Encode(w, g)
+}
+
+// ReadSnapshot reads a graph from a snapshot of any supported version
+func ReadSnapshot(r io.Reader) (Graph, error) {
+	return SnapshotCodec{}.Decode(r)
+}
+
+// Encode writes g as a version SnapshotVersion document
+func (SnapshotCodec) Encode(w io.Writer, g *Graph) error {
+	doc := snapshotV3{
+		snapshotHeader: snapshotHeader{Format: snapshotFormat, Version: SnapshotVersion},
+		Directed:       g.Directed,
+	}
+	if g.Unit != (WeightUnit{}) {
+		doc.Unit = &snapshotUnit{Label: g.Unit.Label, Duration: g.Unit.Duration}
+	}
+	for _, id := range g.SortedVertexIDs() {
+		v := g.Vertices[id]
+		doc.Vertices = append(doc.Vertices, snapshotVertex{ID: id, Name: v.Name, Weight: v.Weight, Attrs: v.Attrs})
+	}
+	for _, e := range g.Edges {
+		doc.Edges = append(doc.Edges, snapshotEdge{
+			From: e.From.ID, To: e.To.ID, Weight: e.Weight, Weights: e.Weights, Attrs: e.Attrs,
+		})
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(doc)
+}
+
+// Decode reads a snapshot, migrating it to the current version first
+// Documents without a format tag are treated as version 1
+func (SnapshotCodec) Decode(r io.Reader) (Graph, error) {
+	doc, err := io.ReadAll(r)
+	if err != nil {
+		return Graph{}, err
+	}
+	version, err := SnapshotVersionOf(doc)
+	if err != nil {
+		return Graph{}, err
+	}
+	if version > SnapshotVersion {
+		return Graph{}, fmt.Errorf("%w: %d is newer than %d", ErrUnsupportedVersion, version, SnapshotVersion)
+	}
+	for version < SnapshotVersion {
+		migrate, known := snapshotMigrations[version]
+		if !known {
+			return Graph{}, fmt.Errorf("%w: no migration from %d", ErrUnsupportedVersion, version)
+		}
+		if doc, err = migrate(doc); err != nil {
+			return Graph{}, fmt.Errorf("snapshot: migrating from version %d: %w", version, err)
+		}
+		next, err := SnapshotVersionOf(doc)
+		if err != nil {
+			return Graph{}, err
+		}
+		if next <= version {
+			return Graph{}, fmt.Errorf("snapshot: migration from version %d did not upgrade the document", version)
+		}
+		version = next
+	}
+
+	var snap snapshotV3
+	dec := json.NewDecoder(bytes.NewReader(doc))
+	dec.UseNumber()
+	if err := dec.Decode(&snap); err != nil {
+		return Graph{}, fmt.Errorf("snapshot: %w", err)
+	}
+
+	g := NewGraph(snap.Directed)
+	if snap.Unit != nil {
+		g.Unit = WeightUnit{Label: snap.Unit.Label, Duration: snap.Unit.Duration}
+	}
+	vertices := make(map[int]*Vertex, len(snap.Vertices))
+	for _, sv := range snap.Vertices {
+		v := g.AddVertex(Vertex{ID: sv.ID, Name: sv.Name, Weight: sv.Weight, Attrs: snapshotAttrs(sv.Attrs)})
+		vertices[sv.ID] = v
+	}
+	edges := make([]Edge, 0, len(snap.Edges))
+	for i, se := range snap.Edges {
+		from, to := vertices[se.From], vertices[se.To]
+		if from == nil || to == nil {
+			return Graph{}, fmt.Errorf("snapshot: edge %d has unknown endpoint %d-%d", i, se.From, se.To)
+		}
+		edges = append(edges, Edge{From: from, To: to, Weight: se.Weight, Weights: se.Weights, Attrs: snapshotAttrs(se.Attrs)})
+	}
+	g.AddEdges(edges)
+	return g, nil
+}
+
+// SnapshotVersionOf returns the format version of a snapshot document
+// without decoding the graph; untagged node-link documents are version 1
+func SnapshotVersionOf(doc []byte) (int, error) {
+	var header snapshotHeader
+	if err := json.Unmarshal(doc, &header); err != nil {
+		return 0, fmt.Errorf("snapshot: %w", err)
+	}
+	switch {
+	case header.Format == "":
+		return 1, nil
+	case header.Format != snapshotFormat:
+		return 0, fmt.Errorf("snapshot: unknown format %q", header.Format)
+	case header.Version < 2:
+		// Version 1 documents were never tagged
+		return 0, fmt.Errorf("%w: %d", ErrUnsupportedVersion, header.Version)
+	}
+	return header.Version, nil
+}
+
+// snapshotAttrs converts JSON numbers in decoded attributes to int or float64
+func snapshotAttrs(attrs Attributes) Attributes {
+	for k, v := range attrs {
+		attrs[k] = jsonValue(v)
+	}
+	return attrs
+}
+
+// migrateNodeLinkSnapshot upgrades an untagged node-link document to the current version
+func migrateNodeLinkSnapshot(doc []byte) ([]byte, error) {
+	g, err := ReadNodeLink(bytes.NewReader(doc))
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := (SnapshotCodec{}).Encode(&buf, &g); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// migrateVertexWeightSnapshot upgrades a version 2 document to version 3
+// Version 3 only added vertex weights, which are absent and so 0 in version 2
+func migrateVertexWeightSnapshot(doc []byte) ([]byte, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(doc, &fields); err != nil {
+		return nil, err
+	}
+	fields["version"] = json.RawMessage("3")
+	return json.Marshal(fields)
+}
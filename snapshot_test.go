@@ -0,0 +1,81 @@
+package mst
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// TestSnapshot tests versioned snapshots, including migration of old versions
+func TestSnapshot(t *testing.T) {
+	fmt.Println("\n=== SNAPSHOT TEST ===")
+
+	g := NewGraph(false)
+	g.Unit = Unit("km")
+	a, b, c := &Vertex{ID: 0, Name: "A"}, &Vertex{ID: 1, Name: "B"}, &Vertex{ID: 2, Name: "C"}
+	e := g.AddEdge(Edge{From: a, To: b, Weight: 4, Weights: map[string]int{"latency": 9}, Data: "not archived"})
+	e.SetAttr("link", "fiber")
+	g.AddEdge(Edge{From: b, To: c, Weight: 2})
+	g.SetVertexAttr(2, "rack", 7)
+
+	var buf bytes.Buffer
+	if err := g.WriteSnapshot(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if v, err := SnapshotVersionOf(buf.Bytes()); err != nil || v != SnapshotVersion {
+		t.Errorf("Expected version %d, got %d (%v)", SnapshotVersion, v, err)
+	}
+	back, err := ReadSnapshot(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if back.Hash() != g.Hash() || back.Unit != g.Unit {
+		t.Error("Expected the snapshot to round-trip structure and unit")
+	}
+	edge, _ := back.GetEdge(0, 1)
+	if link, _ := edge.GetString("link"); edge.Weights["latency"] != 9 || link != "fiber" {
+		t.Errorf("Expected criteria and attributes to survive, got %v and %v", edge.Weights, edge.Attrs)
+	}
+	if rack, _ := back.Vertices[2].Attrs.GetInt("rack"); rack != 7 {
+		t.Errorf("Expected rack 7, got %d", rack)
+	}
+	if edge.Data != nil {
+		t.Errorf("Expected edge Data not to be archived, got %v", edge.Data)
+	}
+
+	// Untagged node-link files from before snapshots existed are version 1
+	var legacy bytes.Buffer
+	g.WriteNodeLink(&legacy)
+	if v, _ := SnapshotVersionOf(legacy.Bytes()); v != 1 {
+		t.Errorf("Expected node-link JSON to be version 1, got %d", v)
+	}
+	migrated, err := ReadSnapshot(&legacy)
+	if err != nil || migrated.Hash() != g.Hash() {
+		t.Errorf("Expected version 1 to migrate to the same graph, got %v", err)
+	}
+
+	// Version 2 documents had no vertex weights
+	v2 := `{"format": "mst-graph", "version": 2, "directed": false,
+		"vertices": [{"id": 0, "name": "A", "attrs": {"rack": 1}}, {"id": 1}],
+		"edges": [{"from": 0, "to": 1, "weight": 4, "weights": {"latency": 9}}]}`
+	old, err := ReadSnapshot(strings.NewReader(v2))
+	if err != nil {
+		t.Fatalf("Expected version 2 to migrate, got %v", err)
+	}
+	if old.Vertices[0].Name != "A" || old.Vertices[0].Weight != 0 || old.Edges[0].Weights["latency"] != 9 {
+		t.Errorf("Expected the version 2 graph intact, got %v and %v", old.Vertices[0], old.Edges[0])
+	}
+	if rack, _ := old.Vertices[0].Attrs.GetInt("rack"); rack != 1 {
+		t.Errorf("Expected rack 1 after migration, got %d", rack)
+	}
+
+	future := `{"format": "mst-graph", "version": 99, "vertices": [], "edges": []}`
+	if _, err := ReadSnapshot(strings.NewReader(future)); !errors.Is(err, ErrUnsupportedVersion) {
+		t.Errorf("Expected ErrUnsupportedVersion for a newer file, got %v", err)
+	}
+	if _, err := ReadSnapshot(strings.NewReader(`{"format": "other"}`)); err == nil {
+		t.Error("Expected an error for a foreign format")
+	}
+}
@@ -0,0 +1,104 @@
+package mst
+
+import "container/heap"
+
+// ==================== REUSABLE MST SOLVER ====================
+
+// MSTSolver runs Kruskal and Prim repeatedly without reallocating
+// The sort buffers, union-find, vertex index, visited flags, priority queue,
+// and result slice are kept between runs and only grow, so recomputing the
+// MST of a graph every few seconds allocates nothing once warmed up
+// The returned tree is reused by the next call on the same solver; copy it
+// to keep it. A solver is not safe for concurrent use
+type MSTSolver struct {
+	sorter  edgeSorter
+	edges   []*Edge
+	index   map[int]int32
+	uf      DenseUnionFind
+	visited []bool
+	pq      PriorityQueue
+	tree    []*Edge
+}
+
+// NewMSTSolver creates a solver with empty scratch buffers
+func NewMSTSolver() *MSTSolver {
+	return &MSTSolver{index: make(map[int]int32)}
+}
+
+// indexVertices numbers the vertices of g densely in s.index
+func (s *MSTSolver) indexVertices(g *Graph) int {
+	clear(s.index)
+	for id := range g.Vertices {
+		s.index[id] = int32(len(s.index))
+	}
+	return len(s.index)
+}
+
+// Kruskal finds the MST of g like Graph.Kruskal without options
+func (s *MSTSolver) Kruskal(g *Graph) ([]*Edge, int) {
+	if g.Directed {
+		panic("Kruskal algorithm only works for undirected graphs")
+	}
+
+	n := s.indexVertices(g)
+	s.edges = append(s.edges[:0], g.Edges...)
+	s.sorter.sort(s.edges)
+	s.uf.Reset(n)
+
+	s.tree = s.tree[:0]
+	total := 0
+	for _, edge := range s.edges {
+		if s.uf.Union(int(s.index[edge.From.ID]), int(s.index[edge.To.ID])) {
+			s.tree = append(s.tree, edge)
+			total += edge.Weight
+			if len(s.tree) == n-1 {
+				break
+			}
+		}
+	}
+	clear(s.edges)
+	return s.tree, total
+}
+
+// Prim finds the MST of g from startID like Graph.Prim without options
+func (s *MSTSolver) Prim(g *Graph, startID int) ([]*Edge, int) {
+	if g.Directed {
+		panic("Prim algorithm only works for undirected graphs")
+	}
+	s.tree = s.tree[:0]
+	start, exists := g.Vertices[startID]
+	if !exists {
+		return s.tree, 0
+	}
+
+	n := s.indexVertices(g)
+	if cap(s.visited) < n {
+		s.visited = make([]bool, n)
+	}
+	s.visited = s.visited[:n]
+	clear(s.visited)
+	s.pq = s.pq[:0]
+
+	visit := func(v Vertex) {
+		s.visited[s.index[v.ID]] = true
+		for _, edge := range v.Edges {
+			if !s.visited[s.index[edge.To.ID]] {
+				heap.Push(&s.pq, edge)
+			}
+		}
+	}
+
+	total := 0
+	visit(start)
+	for s.pq.Len() > 0 && len(s.tree) < n-1 {
+		edge := heap.Pop(&s.pq).(*Edge)
+		if s.visited[s.index[edge.To.ID]] {
+			continue
+		}
+		s.tree = append(s.tree, edge)
+		total += edge.Weight
+		visit(g.Vertices[edge.To.ID])
+	}
+	clear(s.pq[:cap(s.pq)])
+	return s.tree, total
+}
@@ -0,0 +1,62 @@
+package mst
+
+import (
+	"fmt"
+	"testing"
+)
+
+// TestMSTSolver tests that a reused solver matches the one-shot algorithms
+func TestMSTSolver(t *testing.T) {
+	fmt.Println("\n=== MST SOLVER TEST ===")
+
+	s := NewMSTSolver()
+	for _, n := range []int{30, 5, 60} {
+		g := buildCompleteGraph(n)
+		_, want := g.Kruskal()
+
+		for run := range 2 {
+			if tree, total := s.Kruskal(&g); total != want || len(tree) != n-1 {
+				t.Errorf("n=%d run %d: Kruskal got %d with %d edges, want %d", n, run, total, len(tree), want)
+			}
+			if tree, total := s.Prim(&g, 0); total != want || len(tree) != n-1 {
+				t.Errorf("n=%d run %d: Prim got %d with %d edges, want %d", n, run, total, len(tree), want)
+			}
+		}
+	}
+
+	g := buildCompleteGraph(40)
+	s.Kruskal(&g)
+	s.Prim(&g, 0)
+	allocs := testing.AllocsPerRun(10, func() {
+		s.Kruskal(&g)
+		s.Prim(&g, 0)
+	})
+	fmt.Printf("  allocations per warm run: %.0f\n", allocs)
+	if allocs > 0 {
+		t.Errorf("Expected no allocations once warmed up, got %.0f", allocs)
+	}
+	if tree, total := s.Prim(&g, 999); len(tree) != 0 || total != 0 {
+		t.Error("Missing start vertex should give an empty tree")
+	}
+}
+
+// BenchmarkMSTSolverKruskal compares repeated Kruskal runs with and without a solver
+func BenchmarkMSTSolverKruskal(b *testing.B) {
+	edges := benchmarkEdgeBatch(25000)
+	g := NewGraph(false)
+	g.AddEdges(edges)
+
+	b.Run("graph", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			g.Kruskal()
+		}
+	})
+	b.Run("solver", func(b *testing.B) {
+		s := NewMSTSolver()
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			s.Kruskal(&g)
+		}
+	})
+}
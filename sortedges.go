@@ -0,0 +1,100 @@
+package mst
+
+import (
+	"math/bits"
+	"sort"
+)
+
+// ==================== EDGE SORTING ====================
+
+// radixThreshold is the edge count below which a comparison sort is faster
+const radixThreshold = 256
+
+// edgeSorter sorts edges by weight and keeps its scratch space between calls
+type edgeSorter struct {
+	buffer []*Edge
+	counts []int
+}
+
+// sortEdgesByWeight orders edges by ascending weight
+func sortEdgesByWeight(edges []*Edge) {
+	var s edgeSorter
+	s.sort(edges)
+}
+
+// sort orders edges by ascending weight
+// Large inputs use a stable counting sort when the weight range is at most
+// max(65536, len(edges)) values, such as weights 0-10,000, and an LSD radix
+// sort on 8-bit digits of the offset from the minimum weight otherwise;
+// both run in linear time. Small inputs fall back to sort.Slice
+func (s *edgeSorter) sort(edges []*Edge) {
+	n := len(edges)
+	if n < radixThreshold {
+		sort.Slice(edges, func(i, j int) bool {
+			return edges[i].Weight < edges[j].Weight
+		})
+		return
+	}
+
+	lo, hi := edges[0].Weight, edges[0].Weight
+	for _, e := range edges {
+		lo = min(lo, e.Weight)
+		hi = max(hi, e.Weight)
+	}
+	// The span may exceed the int range, so it is computed in uint64
+	span := uint64(hi) - uint64(lo)
+	if span == 0 {
+		return
+	}
+
+	if cap(s.buffer) < n {
+		s.buffer = make([]*Edge, n)
+	}
+	buffer := s.buffer[:n]
+	defer clear(buffer)
+	if span < uint64(max(1<<16, n)) {
+		s.countingSort(edges, buffer, lo, int(span)+1)
+		return
+	}
+
+	src, dst := edges, buffer
+	for shift := 0; shift < bits.Len64(span); shift += 8 {
+		var count [257]int
+		for _, e := range src {
+			count[(uint64(e.Weight)-uint64(lo))>>shift&0xff+1]++
+		}
+		for d := 1; d < len(count); d++ {
+			count[d] += count[d-1]
+		}
+		for _, e := range src {
+			d := (uint64(e.Weight) - uint64(lo)) >> shift & 0xff
+			dst[count[d]] = e
+			count[d]++
+		}
+		src, dst = dst, src
+	}
+	if &src[0] != &edges[0] {
+		copy(edges, src)
+	}
+}
+
+// countingSort stably sorts edges whose weights lie in [lo, lo+size) using buffer as scratch space
+func (s *edgeSorter) countingSort(edges, buffer []*Edge, lo, size int) {
+	if cap(s.counts) < size+1 {
+		s.counts = make([]int, size+1)
+	}
+	count := s.counts[:size+1]
+	clear(count)
+	for _, e := range edges {
+		count[e.Weight-lo+1]++
+	}
+	for d := 1; d <= size; d++ {
+		count[d] += count[d-1]
+	}
+	for _, e := range edges {
+		d := e.Weight - lo
+		buffer[count[d]] = e
+		count[d]++
+	}
+	copy(edges, buffer)
+}
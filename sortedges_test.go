@@ -0,0 +1,65 @@
+package mst
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"sort"
+	"testing"
+)
+
+// TestSortEdgesByWeight tests the counting and radix sort paths against sort.SliceStable
+func TestSortEdgesByWeight(t *testing.T) {
+	fmt.Println("\n=== EDGE SORT TEST ===")
+
+	rng := rand.New(rand.NewPCG(1, 2))
+	cases := map[string]func() int{
+		"small range":   func() int { return rng.IntN(10001) },
+		"negative":      func() int { return rng.IntN(200) - 100 },
+		"wide range":    func() int { return rng.IntN(1<<40) - 1<<39 },
+		"extreme range": func() int { return []int{math.MinInt, math.MaxInt, 0, -1}[rng.IntN(4)] },
+		"constant":      func() int { return 42 },
+	}
+	for name, weight := range cases {
+		for _, n := range []int{10, radixThreshold, 5000} {
+			edges := make([]*Edge, n)
+			for i := range edges {
+				edges[i] = &Edge{Weight: weight(), Data: i}
+			}
+			want := append([]*Edge(nil), edges...)
+			sort.SliceStable(want, func(i, j int) bool { return want[i].Weight < want[j].Weight })
+
+			sortEdgesByWeight(edges)
+			for i := range edges {
+				if edges[i].Weight != want[i].Weight {
+					t.Fatalf("%s, n=%d: position %d has weight %d, want %d",
+						name, n, i, edges[i].Weight, want[i].Weight)
+				}
+				// Counting and radix sorts are stable like SliceStable
+				if n >= radixThreshold && edges[i] != want[i] {
+					t.Fatalf("%s, n=%d: unstable order at position %d", name, n, i)
+				}
+			}
+		}
+	}
+}
+
+// BenchmarkKruskalSmallWeights benchmarks Kruskal on 100k edges with weights 0-10,000
+func BenchmarkKruskalSmallWeights(b *testing.B) {
+	rng := rand.New(rand.NewPCG(3, 4))
+	g := NewGraph(false)
+	vertices := make([]Vertex, 10000)
+	for i := range vertices {
+		vertices[i] = Vertex{ID: i}
+	}
+	edges := make([]Edge, 100000)
+	for i := range edges {
+		edges[i] = Edge{From: &vertices[rng.IntN(len(vertices))], To: &vertices[rng.IntN(len(vertices))], Weight: rng.IntN(10001)}
+	}
+	g.AddEdges(edges)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		g.Kruskal()
+	}
+}
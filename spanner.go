@@ -0,0 +1,72 @@
+package mst
+
+// ==================== SPANNERS ====================
+
+// GreedySpanner builds a t-spanner: a subgraph in which every shortest
+// path is at most t times as long as in the graph, and returns its edges
+// with their total weight
+// Edges are scanned in Kruskal order and kept only when the spanner built
+// so far has no path between their endpoints within t times their weight.
+// A t of 1 keeps every shortest path and large t approach the Kruskal MST,
+// which the spanner equals from t = V-1 on; values below 1 count as 1. It
+// accepts the Kruskal options, so required edges are always kept and
+// forbidden ones never are, and path lengths follow the criterion. Weights
+// must not be negative. Each edge runs one Dijkstra search bounded by t
+// times its weight, so it takes O(E·(V log V + E'))
+func (g *Graph) GreedySpanner(t float64, opts ...Option) ([]*Edge, int) {
+	if g.Directed {
+		panic("GreedySpanner only works for undirected graphs")
+	}
+	t = max(t, 1)
+	o := newOptions(opts)
+	o.prepareConstraints(g)
+
+	spanner := make([]*Edge, 0)
+	total := 0
+	adjacency := make(map[int][]*Edge, len(g.Vertices))
+	for _, e := range g.sortedEdges(o) {
+		w := o.weightOf(e)
+		if !o.constraints.isRequired(e) && spannerPath(adjacency, e.From.ID, e.To.ID, t*float64(w), o) {
+			continue
+		}
+		spanner = append(spanner, e)
+		total += w
+		adjacency[e.From.ID] = append(adjacency[e.From.ID], e)
+		adjacency[e.To.ID] = append(adjacency[e.To.ID], e)
+	}
+	return spanner, total
+}
+
+// spannerPath reports whether the spanner edges in adjacency join from and
+// to by a path no longer than limit
+func spannerPath(adjacency map[int][]*Edge, from, to int, limit float64, o *options) bool {
+	if from == to {
+		return true
+	}
+	dist := map[int]int{from: 0}
+	done := make(map[int]bool)
+	heap := NewDAryHeap(4, 0)
+	heap.Push(from, 0, nil)
+	for heap.Len() > 0 {
+		v, d, _ := heap.PopMin()
+		if float64(d) > limit {
+			return false
+		}
+		if v == to {
+			return true
+		}
+		done[v] = true
+		for _, e := range adjacency[v] {
+			w := e.To.ID
+			if w == v {
+				w = e.From.ID
+			}
+			next := d + o.weightOf(e)
+			if old, seen := dist[w]; !done[w] && (!seen || next < old) {
+				dist[w] = next
+				heap.Push(w, next, e)
+			}
+		}
+	}
+	return false
+}
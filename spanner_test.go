@@ -0,0 +1,76 @@
+package mst
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"testing"
+)
+
+// spannerGraph builds a graph from spanner edges over all vertices of g
+func spannerGraph(g *Graph, edges []*Edge) *Graph {
+	keep := make(map[*Edge]bool, len(edges))
+	for _, e := range edges {
+		keep[e] = true
+	}
+	sub, _ := g.filterEdges(func(e *Edge) bool { return keep[e] }, true)
+	return sub
+}
+
+// TestGreedySpanner tests the stretch bound and the MST extreme
+func TestGreedySpanner(t *testing.T) {
+	fmt.Println("\n=== GREEDY SPANNER TEST ===")
+
+	g := buildCompleteGraph(30)
+	full, err := g.DistanceMatrix()
+	if err != nil {
+		t.Fatal(err)
+	}
+	previous := len(g.Edges) + 1
+	for _, stretch := range []float64{1, 1.5, 2, 3} {
+		edges, total := g.GreedySpanner(stretch)
+		fmt.Printf("t = %.1f: %d of %d edges, weight %d\n", stretch, len(edges), len(g.Edges), total)
+		if len(edges) > previous {
+			t.Errorf("t = %.1f: expected no more edges than a smaller t, got %d", stretch, len(edges))
+		}
+		previous = len(edges)
+
+		dm, _ := spannerGraph(&g, edges).DistanceMatrix()
+		for u := range 30 {
+			for v := range 30 {
+				want, _ := full.Distance(u, v)
+				if got, _ := dm.Distance(u, v); float64(got) > stretch*float64(want) {
+					t.Fatalf("t = %.1f: %d-%d is %d in the spanner, over %v times %d", stretch, u, v, got, stretch, want)
+				}
+			}
+		}
+	}
+
+	mst, weight := g.Kruskal()
+	edges, total := g.GreedySpanner(math.Inf(1))
+	if total != weight || !reflect.DeepEqual(edges, mst) {
+		t.Errorf("Expected an unbounded stretch to give the Kruskal MST, got weight %d vs %d", total, weight)
+	}
+	if edges, _ := g.GreedySpanner(29); len(edges) != 29 {
+		t.Errorf("Expected a stretch of V-1 to give a tree, got %d edges", len(edges))
+	}
+}
+
+// TestGreedySpannerConstraints tests required and forbidden edges
+func TestGreedySpannerConstraints(t *testing.T) {
+	fmt.Println("\n=== GREEDY SPANNER CONSTRAINTS TEST ===")
+
+	g, _ := buildConstraintGraph()
+	e02, _ := g.GetEdge(0, 2)
+	e24, _ := g.GetEdge(2, 4)
+	edges, _ := g.GreedySpanner(10, WithForbiddenEdges(e02), WithRequiredEdges(e24))
+	if containsPair(edges, 0, 2) {
+		t.Error("Expected the forbidden edge 0-2 to be left out")
+	}
+	if !containsPair(edges, 2, 4) {
+		t.Error("Expected the required edge 2-4 to be kept")
+	}
+	if !containsPair(edges, 0, 1) {
+		t.Error("Expected 0-1 to replace the forbidden edge")
+	}
+}
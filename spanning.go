@@ -0,0 +1,70 @@
+package mst
+
+// ==================== UNWEIGHTED SPANNING TREES ====================
+
+// SpanningTreeBFS returns the breadth-first spanning tree of the vertices
+// reachable from rootID, ignoring weights, and its total weight
+// Every vertex is attached to the tree at its fewest-hops distance from the
+// root, which makes this a shallow broadcast tree. On directed graphs
+// edges are followed in their direction. Missing roots yield an empty tree
+func (g *Graph) SpanningTreeBFS(rootID int) ([]*Edge, int) {
+	if _, exists := g.Vertices[rootID]; !exists {
+		return nil, 0
+	}
+
+	tree := make([]*Edge, 0)
+	totalWeight := 0
+	visited := map[int]bool{rootID: true}
+	queue := []int{rootID}
+	for len(queue) > 0 {
+		v := queue[0]
+		queue = queue[1:]
+		for _, e := range g.Vertices[v].Edges {
+			if !visited[e.To.ID] {
+				visited[e.To.ID] = true
+				tree = append(tree, e)
+				totalWeight += e.Weight
+				queue = append(queue, e.To.ID)
+			}
+		}
+	}
+	return tree, totalWeight
+}
+
+// SpanningTreeDFS returns the depth-first spanning tree of the vertices
+// reachable from rootID, ignoring weights, and its total weight
+// Edges appear in discovery order and the walk is iterative, so deep
+// graphs do not grow the call stack. On directed graphs edges are followed
+// in their direction. Missing roots yield an empty tree
+func (g *Graph) SpanningTreeDFS(rootID int) ([]*Edge, int) {
+	if _, exists := g.Vertices[rootID]; !exists {
+		return nil, 0
+	}
+
+	// Each frame remembers how many edges of its vertex were already tried
+	type frame struct {
+		id, next int
+	}
+
+	tree := make([]*Edge, 0)
+	totalWeight := 0
+	visited := map[int]bool{rootID: true}
+	stack := []frame{{id: rootID}}
+	for len(stack) > 0 {
+		top := &stack[len(stack)-1]
+		edges := g.Vertices[top.id].Edges
+		if top.next == len(edges) {
+			stack = stack[:len(stack)-1]
+			continue
+		}
+		e := edges[top.next]
+		top.next++
+		if !visited[e.To.ID] {
+			visited[e.To.ID] = true
+			tree = append(tree, e)
+			totalWeight += e.Weight
+			stack = append(stack, frame{id: e.To.ID})
+		}
+	}
+	return tree, totalWeight
+}
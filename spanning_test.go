@@ -0,0 +1,52 @@
+package mst
+
+import (
+	"fmt"
+	"testing"
+)
+
+// TestSpanningTreeBFSDFS tests the unweighted spanning tree constructors
+func TestSpanningTreeBFSDFS(t *testing.T) {
+	fmt.Println("\n=== BFS/DFS SPANNING TREE TEST ===")
+
+	g := buildCompleteGraph(30)
+	for name, build := range map[string]func(int) ([]*Edge, int){
+		"BFS": g.SpanningTreeBFS,
+		"DFS": g.SpanningTreeDFS,
+	} {
+		tree, total := build(0)
+		if !validSpanningTree(g, tree) {
+			t.Errorf("%s: expected a spanning tree, got %d edges", name, len(tree))
+		}
+		if sum := GetMSTWeight(tree); sum != total {
+			t.Errorf("%s: expected total %d, got %d", name, sum, total)
+		}
+	}
+
+	// On a complete graph BFS is a star and DFS is a Hamiltonian path
+	bfs, _ := g.SpanningTreeBFS(0)
+	for _, e := range bfs {
+		if e.From.ID != 0 {
+			t.Errorf("Expected every BFS edge to leave the root, got %d-%d", e.From.ID, e.To.ID)
+		}
+	}
+	dfs, _ := g.SpanningTreeDFS(0)
+	for i := 1; i < len(dfs); i++ {
+		if dfs[i].From.ID != dfs[i-1].To.ID {
+			t.Errorf("Expected DFS edges to form a path, broke at %d", i)
+			break
+		}
+	}
+
+	// Directed graphs follow edge direction
+	dg := NewGraph(true)
+	v := []Vertex{{ID: 0}, {ID: 1}, {ID: 2}}
+	dg.AddEdge(Edge{From: &v[0], To: &v[1], Weight: 1})
+	dg.AddEdge(Edge{From: &v[2], To: &v[1], Weight: 1})
+	if tree, _ := dg.SpanningTreeBFS(0); len(tree) != 1 {
+		t.Errorf("Expected 1 reachable edge, got %d", len(tree))
+	}
+	if tree, total := dg.SpanningTreeDFS(9); tree != nil || total != 0 {
+		t.Error("Expected an empty tree for a missing root")
+	}
+}
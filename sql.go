@@ -0,0 +1,73 @@
+package mst
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// ==================== SQL LOADER ====================
+
+// loadBatch is how many rows are buffered before they are added with AddEdges
+const loadBatch = 4096
+
+// RowScanner is the Scan method shared by *sql.Rows and *sql.Row
+type RowScanner interface {
+	Scan(dest ...any) error
+}
+
+// RowMapper turns the current row into an edge
+// Endpoints only need their ID; they are matched against existing vertices
+// like in AddEdge, and unknown ones are created from the given Vertex
+type RowMapper func(scan RowScanner) (Edge, error)
+
+// ScanEdge is a RowMapper for rows of exactly (from_id, to_id, weight)
+func ScanEdge(scan RowScanner) (Edge, error) {
+	var from, to, weight int
+	if err := scan.Scan(&from, &to, &weight); err != nil {
+		return Edge{}, err
+	}
+	return Edge{From: &Vertex{ID: from}, To: &Vertex{ID: to}, Weight: weight}, nil
+}
+
+// LoadFromRows streams every remaining row through mapper into the graph and
+// returns the number of edges added
+// Rows are added in batches with AddEdges; on error the edges of earlier
+// batches stay in the graph. The caller still owns rows and must close it
+func (g *Graph) LoadFromRows(rows *sql.Rows, mapper RowMapper) (int, error) {
+	if mapper == nil {
+		mapper = ScanEdge
+	}
+	loaded := 0
+	batch := make([]Edge, 0, loadBatch)
+	flush := func() {
+		g.AddEdges(batch)
+		loaded += len(batch)
+		batch = batch[:0]
+	}
+
+	for row := 1; rows.Next(); row++ {
+		edge, err := mapper(rows)
+		if err != nil {
+			flush()
+			return loaded, fmt.Errorf("row %d: %w", row, err)
+		}
+		batch = append(batch, edge)
+		if len(batch) == loadBatch {
+			flush()
+		}
+	}
+	flush()
+	return loaded, rows.Err()
+}
+
+// LoadFromQuery runs a query and loads its rows into the graph with LoadFromRows
+// A nil mapper uses ScanEdge
+func (g *Graph) LoadFromQuery(ctx context.Context, db *sql.DB, query string, mapper RowMapper, args ...any) (int, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+	return g.LoadFromRows(rows, mapper)
+}
@@ -0,0 +1,103 @@
+package mst
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+)
+
+// ==================== IN-MEMORY TEST DRIVER ====================
+
+// tableDriver serves every query from a fixed table of rows
+type tableDriver struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+func (d *tableDriver) Open(string) (driver.Conn, error) { return &tableConn{d}, nil }
+
+type tableConn struct{ d *tableDriver }
+
+func (c *tableConn) Prepare(string) (driver.Stmt, error) { return &tableStmt{c.d}, nil }
+func (c *tableConn) Close() error                        { return nil }
+func (c *tableConn) Begin() (driver.Tx, error)           { return nil, errors.New("not supported") }
+
+type tableStmt struct{ d *tableDriver }
+
+func (s *tableStmt) Close() error  { return nil }
+func (s *tableStmt) NumInput() int { return -1 }
+func (s *tableStmt) Exec([]driver.Value) (driver.Result, error) {
+	return nil, errors.New("not supported")
+}
+func (s *tableStmt) Query([]driver.Value) (driver.Rows, error) {
+	return &tableRows{d: s.d}, nil
+}
+
+type tableRows struct {
+	d    *tableDriver
+	next int
+}
+
+func (r *tableRows) Columns() []string { return r.d.columns }
+func (r *tableRows) Close() error      { return nil }
+func (r *tableRows) Next(dest []driver.Value) error {
+	if r.next == len(r.d.rows) {
+		return io.EOF
+	}
+	copy(dest, r.d.rows[r.next])
+	r.next++
+	return nil
+}
+
+func init() {
+	sql.Register("mst-table", &tableDriver{
+		columns: []string{"src", "dst", "cost", "label"},
+		rows: [][]driver.Value{
+			{int64(0), int64(1), int64(4), "a"},
+			{int64(1), int64(2), int64(2), "b"},
+			{int64(0), int64(2), int64(3), "c"},
+		},
+	})
+}
+
+// TestLoadFromQuery tests streaming edges from a SQL query
+func TestLoadFromQuery(t *testing.T) {
+	fmt.Println("\n=== SQL LOADER TEST ===")
+
+	db, err := sql.Open("mst-table", "")
+	if err != nil {
+		t.Fatalf("sql.Open failed: %v", err)
+	}
+	defer db.Close()
+
+	g := NewGraph(false)
+	n, err := g.LoadFromQuery(context.Background(), db, "SELECT src, dst, cost, label FROM links",
+		func(scan RowScanner) (Edge, error) {
+			var from, to, weight int
+			var label string
+			if err := scan.Scan(&from, &to, &weight, &label); err != nil {
+				return Edge{}, err
+			}
+			return Edge{From: &Vertex{ID: from}, To: &Vertex{ID: to}, Weight: weight, Data: label}, nil
+		})
+	if err != nil || n != 3 {
+		t.Fatalf("Expected 3 edges, got %d (%v)", n, err)
+	}
+	g.Print()
+	if _, total := g.Kruskal(); total != 5 {
+		t.Errorf("Expected MST weight 5, got %d", total)
+	}
+	if e, _ := g.GetEdge(1, 2); e.Data != "b" {
+		t.Errorf("Expected edge data b, got %v", e.Data)
+	}
+
+	// ScanEdge expects exactly three columns, so the four-column table fails
+	bad := NewGraph(false)
+	if n, err := bad.LoadFromQuery(context.Background(), db, "SELECT *", nil); err == nil || n != 0 {
+		t.Errorf("Expected a scan error with no edges, got %d (%v)", n, err)
+	}
+}
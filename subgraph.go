@@ -0,0 +1,55 @@
+package mst
+
+// ==================== EDGE-FILTERED SUBGRAPHS ====================
+
+// SubgraphByWeight returns a new graph with only the edges whose weight lies
+// in [min, max], copied with their data
+// With keepVertices every vertex of g is kept, including ones left isolated;
+// otherwise only endpoints of kept edges appear
+func (g *Graph) SubgraphByWeight(min, max int, keepVertices bool) *Graph {
+	sub, _ := g.filterEdges(func(e *Edge) bool {
+		return e.Weight >= min && e.Weight <= max
+	}, keepVertices)
+	return sub
+}
+
+// filterEdges copies g with only the edges keep accepts, returning the copy
+// and the edges of g that the copy's edges came from, in matching order
+func (g *Graph) filterEdges(keep func(e *Edge) bool, keepVertices bool) (*Graph, []*Edge) {
+	sub := NewGraph(g.Directed)
+	sub.Unit = g.Unit
+	vertices := make(map[int]*Vertex)
+	vertex := func(id int) *Vertex {
+		if v, exists := vertices[id]; exists {
+			return v
+		}
+		v := g.Vertices[id]
+		copied := &Vertex{ID: v.ID, Name: v.Name, Data: v.Data, Attrs: v.Attrs, Weight: v.Weight}
+		vertices[id] = copied
+		return copied
+	}
+
+	if keepVertices {
+		for _, id := range g.SortedVertexIDs() {
+			sub.AddVertex(*vertex(id))
+		}
+	}
+
+	edges := make([]Edge, 0)
+	sources := make([]*Edge, 0)
+	for _, e := range g.Edges {
+		if keep(e) {
+			sources = append(sources, e)
+			edges = append(edges, Edge{
+				From:    vertex(e.From.ID),
+				To:      vertex(e.To.ID),
+				Weight:  e.Weight,
+				Data:    e.Data,
+				Weights: e.Weights,
+				Attrs:   e.Attrs,
+			})
+		}
+	}
+	sub.AddEdges(edges)
+	return &sub, sources
+}
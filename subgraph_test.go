@@ -0,0 +1,33 @@
+package mst
+
+import (
+	"fmt"
+	"testing"
+)
+
+// TestSubgraphByWeight tests filtering edges by a weight band
+func TestSubgraphByWeight(t *testing.T) {
+	fmt.Println("\n=== SUBGRAPH BY WEIGHT TEST ===")
+
+	g := newPathGraph(1, 5, 3, 9) // 0-1-2-3-4
+	sub := g.SubgraphByWeight(2, 5, false)
+	sub.Print()
+
+	if sub.EdgeCount() != 2 || sub.VertexCount() != 3 {
+		t.Errorf("Expected 2 edges on 3 vertices, got %d and %d", sub.EdgeCount(), sub.VertexCount())
+	}
+	if !sub.HasEdge(1, 2) || !sub.HasEdge(3, 2) || sub.HasEdge(0, 1) {
+		t.Error("Expected exactly the edges 1-2 and 2-3")
+	}
+	if g.EdgeCount() != 4 {
+		t.Error("Original graph must not change")
+	}
+
+	full := g.SubgraphByWeight(2, 5, true)
+	if full.VertexCount() != 5 || len(full.Vertices[0].Edges) != 0 {
+		t.Errorf("Expected all 5 vertices with 0 isolated, got %d", full.VertexCount())
+	}
+	if full.IsConnected() {
+		t.Error("Band subgraph with isolated vertices should be disconnected")
+	}
+}
@@ -0,0 +1,149 @@
+package mst
+
+import (
+	"maps"
+	"slices"
+	"time"
+)
+
+// ==================== TEMPORAL GRAPH ====================
+
+// Validity is the half-open time interval [From, To) in which an edge exists
+// A zero From means the edge has always existed and a zero To means it
+// has not been retired, so the zero value is valid at every time
+type Validity struct {
+	From, To time.Time
+}
+
+// Contains reports whether t lies within the interval
+func (v Validity) Contains(t time.Time) bool {
+	return (v.From.IsZero() || !t.Before(v.From)) && (v.To.IsZero() || t.Before(v.To))
+}
+
+// TemporalGraph is a graph whose edges carry validity intervals, so one
+// dataset describes the network at every point in its history
+// Edges added through the embedded Graph are valid at all times. Every
+// Graph algorithm is available through the embedded Graph and sees all
+// edges regardless of time; use AsOf or MSTAt for a point in time
+// Removing an edge or clearing the graph through the Graph methods drops
+// the validity of the removed edges; rolling back a transaction restores it
+type TemporalGraph struct {
+	Graph
+	validity map[*Edge]Validity
+	parked   map[*Edge]Validity // validity of edges removed inside a transaction
+}
+
+// NewTemporalGraph creates an empty temporal graph
+func NewTemporalGraph(directed bool) *TemporalGraph {
+	t := &TemporalGraph{Graph: NewGraph(directed)}
+	t.track()
+	return t
+}
+
+// track allocates the validity maps and keeps them in step with the graph
+func (t *TemporalGraph) track() {
+	t.validity = make(map[*Edge]Validity)
+	t.parked = make(map[*Edge]Validity)
+	t.Observe(MutationFunc(t.forget))
+}
+
+// forget drops the validity of removed edges so it does not outlive them
+// Inside a transaction the intervals are parked instead, so the edges a
+// rollback restores get them back
+func (t *TemporalGraph) forget(m Mutation) {
+	switch m.Kind {
+	case MutationEdgeRemoved:
+		for _, e := range [2]*Edge{m.Edge, m.Edge.twin} {
+			if v, ok := t.validity[e]; ok {
+				delete(t.validity, e)
+				if t.transactions > 0 {
+					t.parked[e] = v
+				}
+			}
+		}
+	case MutationEdgeAdded:
+		if v, ok := t.parked[m.Edge]; ok {
+			t.validity[m.Edge] = v
+		}
+	case MutationGraphCleared:
+		if t.transactions > 0 {
+			maps.Copy(t.parked, t.validity)
+		}
+		clear(t.validity)
+	}
+	if t.transactions == 0 {
+		clear(t.parked)
+	}
+}
+
+// AddTemporalEdge adds an edge that exists from from until to; a zero
+// time leaves that end of the interval open
+func (t *TemporalGraph) AddTemporalEdge(edge Edge, from, to time.Time) *Edge {
+	if t.validity == nil {
+		t.track()
+	}
+	added := t.AddEdge(edge)
+	t.validity[added] = Validity{From: from, To: to}
+	return added
+}
+
+// ValidityOf returns the validity interval of an edge or its reverse copy
+func (t *TemporalGraph) ValidityOf(edge *Edge) Validity {
+	if v, ok := t.validity[edge]; ok {
+		return v
+	}
+	if edge.twin != nil {
+		return t.validity[edge.twin]
+	}
+	return Validity{}
+}
+
+// AsOf returns the graph as it existed at time at: every vertex, and the
+// edges whose validity contains at, copied with their data
+func (t *TemporalGraph) AsOf(at time.Time) *Graph {
+	sub, _ := t.filterEdges(func(e *Edge) bool {
+		return t.ValidityOf(e).Contains(at)
+	}, true)
+	return sub
+}
+
+// MSTAt computes the minimum spanning forest of the graph as of time at
+// with Kruskal. The returned edges are the TemporalGraph's own edges, so
+// their validity can be looked up with ValidityOf
+func (t *TemporalGraph) MSTAt(at time.Time, opts ...Option) ([]*Edge, int) {
+	sub, sources := t.filterEdges(func(e *Edge) bool {
+		return t.ValidityOf(e).Contains(at)
+	}, true)
+	origin := make(map[*Edge]*Edge, len(sources))
+	for i, e := range sub.Edges {
+		origin[e] = sources[i]
+	}
+
+	mst, total := sub.Kruskal(opts...)
+	for i, e := range mst {
+		if source, ok := origin[e]; ok {
+			mst[i] = source
+		} else {
+			mst[i] = origin[e.twin]
+		}
+	}
+	return mst, total
+}
+
+// ChangeTimes returns every distinct time at which an edge appears or
+// disappears, in ascending order
+// The MST can only change at these times, so MSTAt over them covers the
+// whole history of the network
+func (t *TemporalGraph) ChangeTimes() []time.Time {
+	times := make([]time.Time, 0, 2*len(t.validity))
+	for _, e := range t.Edges {
+		v := t.ValidityOf(e)
+		for _, at := range [2]time.Time{v.From, v.To} {
+			if !at.IsZero() {
+				times = append(times, at)
+			}
+		}
+	}
+	slices.SortFunc(times, time.Time.Compare)
+	return slices.CompactFunc(times, time.Time.Equal)
+}
@@ -0,0 +1,86 @@
+package mst
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+// TestTemporalGraph tests point-in-time snapshots and MSTs of a temporal graph
+func TestTemporalGraph(t *testing.T) {
+	fmt.Println("\n=== TEMPORAL GRAPH TEST ===")
+
+	day := func(d int) time.Time { return time.Date(2024, 1, d, 0, 0, 0, 0, time.UTC) }
+	tg := NewTemporalGraph(false)
+	v := []*Vertex{{ID: 0, Name: "A"}, {ID: 1, Name: "B"}, {ID: 2, Name: "C"}}
+	tg.AddEdge(Edge{From: v[0], To: v[1], Weight: 5})
+	cheap := tg.AddTemporalEdge(Edge{From: v[1], To: v[2], Weight: 1}, day(10), day(20))
+	tg.AddTemporalEdge(Edge{From: v[0], To: v[2], Weight: 8}, time.Time{}, day(15))
+	tg.AddTemporalEdge(Edge{From: v[0], To: v[2], Weight: 3}, day(15), time.Time{})
+
+	for _, c := range []struct {
+		day, edges, total int
+	}{
+		{1, 2, 13}, // A-B and the old A-C link
+		{12, 2, 6}, // the cheap B-C link replaces A-C
+		{15, 2, 4}, // the old A-C link retires as the new one starts
+		{25, 2, 8}, // B-C has been decommissioned
+	} {
+		mst, total := tg.MSTAt(day(c.day))
+		fmt.Printf("Day %d: %d edges, weight %d\n", c.day, len(mst), total)
+		if len(mst) != c.edges || total != c.total {
+			t.Errorf("Day %d: expected %d edges of weight %d, got %d of %d", c.day, c.edges, c.total, len(mst), total)
+		}
+	}
+
+	// MSTAt returns the temporal graph's own edges
+	mst, _ := tg.MSTAt(day(12))
+	found := false
+	for _, e := range mst {
+		if e == cheap || e == cheap.twin {
+			found = true
+		}
+	}
+	if !found || tg.ValidityOf(cheap.twin) != (Validity{From: day(10), To: day(20)}) {
+		t.Error("Expected MSTAt to return the original B-C edge with its validity")
+	}
+
+	if snap := tg.AsOf(day(1)); snap.VertexCount() != 3 || snap.EdgeCount() != 2 {
+		t.Errorf("Expected 3 vertices and 2 edges on day 1, got %d and %d", snap.VertexCount(), snap.EdgeCount())
+	}
+	if times := tg.ChangeTimes(); len(times) != 3 || !times[0].Equal(day(10)) {
+		t.Errorf("Expected change times on days 10, 15, 20, got %v", times)
+	}
+}
+
+// TestTemporalGraphRemoval tests that removed edges take their validity with them
+// unless a rollback brings them back
+func TestTemporalGraphRemoval(t *testing.T) {
+	fmt.Println("\n=== TEMPORAL GRAPH REMOVAL TEST ===")
+
+	day := func(d int) time.Time { return time.Date(2024, 1, d, 0, 0, 0, 0, time.UTC) }
+	v := []*Vertex{{ID: 0}, {ID: 1}, {ID: 2}}
+	window := Validity{From: day(1), To: day(5)}
+
+	var tg TemporalGraph
+	tg.Graph = NewGraph(false)
+	first := tg.AddTemporalEdge(Edge{From: v[0], To: v[1], Weight: 1}, window.From, window.To)
+	second := tg.AddTemporalEdge(Edge{From: v[1], To: v[2], Weight: 2}, window.From, window.To)
+
+	tx := tg.Begin()
+	tg.RemoveEdge(first.twin)
+	tg.Clear()
+	tx.Rollback()
+	if tg.ValidityOf(first) != window || tg.ValidityOf(second) != window {
+		t.Error("Expected a rollback to restore the validity of removed edges")
+	}
+
+	tg.RemoveEdge(first)
+	if len(tg.validity) != 1 || len(tg.parked) != 0 {
+		t.Errorf("Expected 1 validity entry after RemoveEdge, got %d and %d parked", len(tg.validity), len(tg.parked))
+	}
+	tg.Clear()
+	if len(tg.validity) != 0 {
+		t.Errorf("Expected no validity entries after Clear, got %d", len(tg.validity))
+	}
+}
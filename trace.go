@@ -0,0 +1,106 @@
+package mst
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// ==================== ALGORITHM TRACING ====================
+
+// TraceEventKind identifies what happened at a step of an algorithm
+type TraceEventKind string
+
+const (
+	// EventEdgeConsidered is emitted when an edge is examined
+	EventEdgeConsidered TraceEventKind = "edge_considered"
+	// EventEdgeAccepted is emitted when an edge joins the tree
+	EventEdgeAccepted TraceEventKind = "edge_accepted"
+	// EventEdgeRejected is emitted when an edge is discarded because it would form a cycle
+	EventEdgeRejected TraceEventKind = "edge_rejected"
+	// EventVertexAdded is emitted when a vertex joins the tree
+	EventVertexAdded TraceEventKind = "vertex_added"
+)
+
+// TraceEvent is a single step of an algorithm run
+// Edge events carry From, To, and Weight; vertex events carry Vertex
+type TraceEvent struct {
+	Step      int            `json:"step"`
+	Algorithm string         `json:"algorithm"`
+	Kind      TraceEventKind `json:"kind"`
+	From      int            `json:"from"`
+	To        int            `json:"to"`
+	Weight    int            `json:"weight"`
+	Vertex    int            `json:"vertex"`
+	Edge      *Edge          `json:"-"`
+}
+
+// Tracer receives algorithm events as they happen
+type Tracer interface {
+	Trace(event TraceEvent)
+}
+
+// TraceFunc adapts an ordinary function to the Tracer interface
+type TraceFunc func(event TraceEvent)
+
+// Trace calls f(event)
+func (f TraceFunc) Trace(event TraceEvent) {
+	f(event)
+}
+
+// TraceRecorder is a Tracer that keeps every event in memory
+type TraceRecorder struct {
+	Events []TraceEvent
+}
+
+// NewTraceRecorder creates an empty TraceRecorder
+func NewTraceRecorder() *TraceRecorder {
+	return &TraceRecorder{Events: make([]TraceEvent, 0)}
+}
+
+// Trace records the event
+func (r *TraceRecorder) Trace(event TraceEvent) {
+	r.Events = append(r.Events, event)
+}
+
+// Reset discards all recorded events
+func (r *TraceRecorder) Reset() {
+	r.Events = r.Events[:0]
+}
+
+// WriteJSON writes the recorded events as an indented JSON array
+func (r *TraceRecorder) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r.Events)
+}
+
+// traceEdge emits an edge event if a tracer is configured
+func (o *options) traceEdge(algorithm string, kind TraceEventKind, edge *Edge) {
+	if o.tracer == nil {
+		return
+	}
+	o.step++
+	o.tracer.Trace(TraceEvent{
+		Step:      o.step,
+		Algorithm: algorithm,
+		Kind:      kind,
+		From:      edge.From.ID,
+		To:        edge.To.ID,
+		Weight:    edge.Weight,
+		Edge:      edge,
+	})
+}
+
+// traceVertex emits a vertex event if a tracer is configured
+func (o *options) traceVertex(algorithm string, id int) {
+	if o.tracer == nil {
+		return
+	}
+	o.step++
+	o.tracer.Trace(TraceEvent{
+		Step:      o.step,
+		Algorithm: algorithm,
+		Kind:      EventVertexAdded,
+		Vertex:    id,
+	})
+}
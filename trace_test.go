@@ -0,0 +1,72 @@
+package mst
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+// TestKruskalTrace tests the events emitted by Kruskal
+func TestKruskalTrace(t *testing.T) {
+	fmt.Println("\n=== KRUSKAL TRACE TEST ===")
+
+	g := NewGraph(false)
+	v0 := &Vertex{ID: 0, Name: "A", Edges: make([]*Edge, 0)}
+	v1 := &Vertex{ID: 1, Name: "B", Edges: make([]*Edge, 0)}
+	v2 := &Vertex{ID: 2, Name: "C", Edges: make([]*Edge, 0)}
+	g.AddEdge(Edge{From: v0, To: v1, Weight: 1})
+	g.AddEdge(Edge{From: v1, To: v2, Weight: 2})
+	g.AddEdge(Edge{From: v0, To: v2, Weight: 3})
+	g.AddEdge(Edge{From: v2, To: v0, Weight: 4})
+
+	rec := NewTraceRecorder()
+	g.Kruskal(WithTracer(rec))
+
+	// The MST is complete after two edges, so the remaining ones are never examined
+	expected := []TraceEventKind{
+		EventEdgeConsidered, EventEdgeAccepted,
+		EventEdgeConsidered, EventEdgeAccepted,
+	}
+	if len(rec.Events) != len(expected) {
+		t.Fatalf("Expected %d events, got %d", len(expected), len(rec.Events))
+	}
+	for i, kind := range expected {
+		if rec.Events[i].Kind != kind || rec.Events[i].Step != i+1 {
+			t.Errorf("Event %d: expected %s at step %d, got %s at step %d",
+				i, kind, i+1, rec.Events[i].Kind, rec.Events[i].Step)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := rec.WriteJSON(&buf); err != nil {
+		t.Fatalf("WriteJSON failed: %v", err)
+	}
+	var decoded []TraceEvent
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatalf("Trace is not valid JSON: %v", err)
+	}
+	if len(decoded) != len(expected) || decoded[1].Weight != 1 {
+		t.Errorf("Unexpected decoded trace: %+v", decoded)
+	}
+}
+
+// TestPrimTrace tests that Prim reports rejected edges and added vertices
+func TestPrimTrace(t *testing.T) {
+	g := buildCompleteGraph(5)
+
+	counts := make(map[TraceEventKind]int)
+	g.Prim(0, WithTracer(TraceFunc(func(e TraceEvent) {
+		counts[e.Kind]++
+	})))
+
+	if counts[EventVertexAdded] != 5 {
+		t.Errorf("Expected 5 vertices added, got %d", counts[EventVertexAdded])
+	}
+	if counts[EventEdgeAccepted] != 4 {
+		t.Errorf("Expected 4 edges accepted, got %d", counts[EventEdgeAccepted])
+	}
+	if counts[EventEdgeConsidered] != counts[EventEdgeAccepted]+counts[EventEdgeRejected] {
+		t.Errorf("Expected every considered edge to be accepted or rejected, got %v", counts)
+	}
+}
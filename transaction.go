@@ -0,0 +1,192 @@
+package mst
+
+import (
+	"errors"
+	"slices"
+)
+
+// ==================== TRANSACTIONS ====================
+
+// ErrTransactionClosed is returned when a committed or rolled back transaction is used again
+var ErrTransactionClosed = errors.New("transaction closed")
+
+// Transaction records the mutations made to a graph so they can be undone
+// It is an ordinary mutation observer, so every change made through the
+// Graph methods while it is open is logged, including changes made by other
+// code. Undoing a removed edge restores the original *Edge values, so
+// pointers held by callers stay valid; restored edges are appended to g.Edges
+// Undoing Clear restores the original *Edge values too, except for their
+// expiry times; while a transaction is open Clear does not reset the graph's
+// arena, so those edges are not handed out again
+// Transactions nest: rolling back an inner one is itself recorded by the outer one
+type Transaction struct {
+	g       *Graph
+	log     []Mutation
+	undoing bool
+	cancel  func()
+}
+
+// Begin starts recording mutations of the graph
+func (g *Graph) Begin() *Transaction {
+	tx := &Transaction{g: g}
+	tx.cancel = g.Observe(tx)
+	g.transactions++
+	return tx
+}
+
+// OnMutation appends a mutation to the log
+func (tx *Transaction) OnMutation(m Mutation) {
+	if tx.undoing {
+		return
+	}
+	if m.Kind == MutationGraphCleared {
+		m.cleared = saveContents(tx.g)
+	}
+	tx.log = append(tx.log, m)
+}
+
+// Len returns the number of recorded mutations that can still be undone
+func (tx *Transaction) Len() int {
+	return len(tx.log)
+}
+
+// Undo reverts the last n recorded mutations, newest first, and keeps the
+// transaction open. It returns how many mutations were reverted
+func (tx *Transaction) Undo(n int) int {
+	if tx.cancel == nil {
+		return 0
+	}
+	n = min(n, len(tx.log))
+
+	tx.undoing = true
+	defer func() { tx.undoing = false }()
+	for i := 0; i < n; i++ {
+		last := len(tx.log) - 1
+		tx.g.revert(tx.log[last])
+		tx.log = tx.log[:last]
+	}
+	return n
+}
+
+// Commit keeps every change and stops recording
+func (tx *Transaction) Commit() error {
+	if tx.cancel == nil {
+		return ErrTransactionClosed
+	}
+	tx.close()
+	return nil
+}
+
+// Rollback reverts every recorded change and stops recording
+func (tx *Transaction) Rollback() error {
+	if tx.cancel == nil {
+		return ErrTransactionClosed
+	}
+	tx.Undo(len(tx.log))
+	tx.close()
+	return nil
+}
+
+func (tx *Transaction) close() {
+	tx.g.transactions--
+	tx.cancel()
+	tx.cancel = nil
+	tx.log = nil
+}
+
+// revert applies the inverse of a mutation
+func (g *Graph) revert(m Mutation) {
+	switch m.Kind {
+	case MutationVertexAdded:
+		g.RemoveVertex(m.Vertex.ID)
+	case MutationVertexRemoved:
+		// Its edges were removed first, so they are restored after it
+		v := *m.Vertex
+		v.Edges = nil
+		g.AddVertex(v)
+	case MutationEdgeAdded:
+		g.RemoveEdge(m.Edge)
+	case MutationEdgeRemoved:
+		g.restoreEdge(m.Edge)
+	case MutationEdgeWeightChanged:
+		g.SetEdgeWeight(m.Edge, m.OldWeight)
+	case MutationEdgeCriterionChanged:
+		if m.OldPresent {
+			g.SetEdgeWeightBy(m.Edge, m.Criterion, m.OldWeight)
+		} else {
+			g.unsetEdgeWeightBy(m.Edge, m.Criterion)
+		}
+	case MutationVertexWeightChanged:
+		g.SetVertexWeight(m.Vertex.ID, m.OldWeight)
+	case MutationVertexDataChanged:
+		g.SetVertexData(m.Vertex.ID, m.OldData)
+	case MutationVertexNameChanged:
+		g.SetVertexName(m.Vertex.ID, m.OldValue.(string))
+	case MutationVertexAttrChanged:
+		if m.OldPresent {
+			g.SetVertexAttr(m.Vertex.ID, m.Attr, m.OldValue)
+		} else {
+			g.unsetVertexAttr(m.Vertex.ID, m.Attr)
+		}
+	case MutationEdgeAttrChanged:
+		if m.OldPresent {
+			g.SetEdgeAttr(m.Edge, m.Attr, m.OldValue)
+		} else {
+			g.unsetEdgeAttr(m.Edge, m.Attr)
+		}
+	case MutationGraphCleared:
+		m.cleared.restore(g)
+	}
+}
+
+// clearedContents is the graph as it was just before Clear
+type clearedContents struct {
+	vertices []Vertex
+	edges    []*Edge
+}
+
+// saveContents records the vertices and edges of g, keeping the original
+// *Edge values so older log entries that refer to them can still be undone
+func saveContents(g *Graph) *clearedContents {
+	c := &clearedContents{
+		vertices: make([]Vertex, 0, len(g.Vertices)),
+		edges:    slices.Clone(g.Edges),
+	}
+	for _, id := range g.SortedVertexIDs() {
+		v := g.Vertices[id]
+		v.Edges = nil
+		c.vertices = append(c.vertices, v)
+	}
+	return c
+}
+
+// restore puts the saved vertices and edges back into g
+func (c *clearedContents) restore(g *Graph) {
+	for _, v := range c.vertices {
+		g.AddVertex(v)
+	}
+	for _, edge := range c.edges {
+		g.restoreEdge(edge)
+	}
+}
+
+// restoreEdge puts a removed edge and its reverse copy back into the graph
+func (g *Graph) restoreEdge(edge *Edge) {
+	g.Edges = append(g.Edges, edge)
+
+	from := g.Vertices[edge.From.ID]
+	from.Edges = append(from.Edges, edge)
+	g.Vertices[edge.From.ID] = from
+
+	if !g.Directed {
+		if edge.twin == nil {
+			edge.twin = edge.Reverse()
+			edge.twin.twin = edge
+		}
+		to := g.Vertices[edge.To.ID]
+		to.Edges = append(to.Edges, edge.twin)
+		g.Vertices[edge.To.ID] = to
+	}
+
+	g.notify(Mutation{Kind: MutationEdgeAdded, Edge: edge})
+}
@@ -0,0 +1,168 @@
+package mst
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// TestTransactionRollback tests that rolling back restores the original graph
+func TestTransactionRollback(t *testing.T) {
+	fmt.Println("\n=== TRANSACTION TEST ===")
+
+	g := NewGraph(false)
+	v := make([]Vertex, 4)
+	for i := range v {
+		v[i] = Vertex{ID: i, Name: fmt.Sprintf("V%d", i)}
+	}
+	ab := g.AddEdge(Edge{From: &v[0], To: &v[1], Weight: 4})
+	g.AddEdge(Edge{From: &v[1], To: &v[2], Weight: 2})
+	g.AddEdge(Edge{From: &v[0], To: &v[2], Weight: 3})
+	before := g.Hash()
+	_, weight := g.Kruskal()
+
+	tx := g.Begin()
+	g.AddEdge(Edge{From: &v[2], To: &v[3], Weight: 1})
+	g.SetEdgeWeight(ab, 1)
+	g.RemoveEdge(ab)
+	g.RemoveVertex(2)
+	if tx.Len() != 8 {
+		t.Errorf("Expected 8 recorded mutations, got %d", tx.Len())
+	}
+
+	if err := tx.Rollback(); err != nil {
+		t.Fatalf("Rollback failed: %v", err)
+	}
+	if g.Hash() != before {
+		t.Error("Expected rollback to restore the original graph")
+	}
+	if _, w := g.Kruskal(); w != weight {
+		t.Errorf("Expected MST weight %d after rollback, got %d", weight, w)
+	}
+	if ab.Weight != 4 || !g.HasEdge(1, 0) {
+		t.Error("Expected the original edge pointer to be restored")
+	}
+	if _, ok := g.GetVertex(3); ok {
+		t.Error("Expected vertex 3 to be gone after rollback")
+	}
+	if err := tx.Commit(); !errors.Is(err, ErrTransactionClosed) {
+		t.Errorf("Expected ErrTransactionClosed, got %v", err)
+	}
+}
+
+// TestTransactionUndo tests partial undo and commit
+func TestTransactionUndo(t *testing.T) {
+	g := NewGraph(false)
+	a, b, c := Vertex{ID: 0}, Vertex{ID: 1}, Vertex{ID: 2}
+	g.AddEdge(Edge{From: &a, To: &b, Weight: 1})
+
+	tx := g.Begin()
+	g.AddEdge(Edge{From: &b, To: &c, Weight: 2})
+	g.AddEdge(Edge{From: &a, To: &c, Weight: 5})
+
+	if n := tx.Undo(1); n != 1 {
+		t.Errorf("Expected 1 undone mutation, got %d", n)
+	}
+	if g.HasEdge(0, 2) || !g.HasEdge(1, 2) {
+		t.Error("Expected only the last edge to be undone")
+	}
+
+	tx.Commit()
+	g.AddEdge(Edge{From: &a, To: &c, Weight: 5})
+	if tx.Undo(1) != 0 || !g.HasEdge(0, 2) {
+		t.Error("Expected a committed transaction to stop recording")
+	}
+}
+
+// TestTransactionRollbackCriterion tests that rollback restores named
+// criteria set with SetEdgeWeightBy, removing ones that were added
+func TestTransactionRollbackCriterion(t *testing.T) {
+	g := NewGraph(false)
+	a, b := Vertex{ID: 0}, Vertex{ID: 1}
+	ab := g.AddEdge(Edge{From: &a, To: &b, Weight: 1, Weights: map[string]int{"latency": 5}})
+
+	tx := g.Begin()
+	g.SetEdgeWeightBy(ab, "latency", 7)
+	g.SetEdgeWeightBy(ab, "loss", 2)
+	if tx.Len() != 2 {
+		t.Errorf("Expected 2 recorded mutations, got %d", tx.Len())
+	}
+	if err := tx.Rollback(); err != nil {
+		t.Fatalf("Rollback failed: %v", err)
+	}
+
+	if ab.Weights["latency"] != 5 {
+		t.Errorf("Expected latency 5 after rollback, got %d", ab.Weights["latency"])
+	}
+	if _, ok := ab.Weights["loss"]; ok {
+		t.Error("Expected the added criterion to be removed by rollback")
+	}
+	if reverse := g.Vertices[1].Edges[0]; reverse.Weights["latency"] != 5 {
+		t.Errorf("Expected the reverse copy to see latency 5, got %d", reverse.Weights["latency"])
+	}
+}
+
+// TestTransactionRollbackAttrs tests that rollback restores edge and vertex
+// attributes, removing ones that were added
+func TestTransactionRollbackAttrs(t *testing.T) {
+	g := NewGraph(false)
+	a, b := Vertex{ID: 0, Attrs: Attributes{"city": "Baku"}}, Vertex{ID: 1}
+	ab := g.AddEdge(Edge{From: &a, To: &b, Weight: 1, Attrs: Attributes{"cable": "fiber"}})
+
+	tx := g.Begin()
+	g.SetEdgeAttr(ab, "cable", "copper")
+	g.SetEdgeAttr(ab, "capacity", 2.5)
+	g.SetVertexAttr(0, "city", "Ganja")
+	g.SetVertexAttr(1, "city", "Sheki")
+	if tx.Len() != 4 {
+		t.Errorf("Expected 4 recorded mutations, got %d", tx.Len())
+	}
+	if err := tx.Rollback(); err != nil {
+		t.Fatalf("Rollback failed: %v", err)
+	}
+
+	if s, _ := ab.GetString("cable"); s != "fiber" {
+		t.Errorf("Expected cable fiber after rollback, got %q", s)
+	}
+	if _, ok := g.Vertices[1].Edges[0].Attrs["capacity"]; ok {
+		t.Error("Expected the added attribute to be removed from both copies by rollback")
+	}
+	if s, _ := g.Vertices[0].Attrs.GetString("city"); s != "Baku" {
+		t.Errorf("Expected city Baku after rollback, got %q", s)
+	}
+	if _, ok := g.Vertices[1].Attrs["city"]; ok {
+		t.Error("Expected the added vertex attribute to be removed by rollback")
+	}
+}
+
+// TestTransactionRollbackClear tests that changes made before a Clear are
+// still undone after it, with and without an edge arena
+func TestTransactionRollbackClear(t *testing.T) {
+	for _, arena := range []*EdgeArena{nil, NewEdgeArena(4)} {
+		g := NewGraph(false)
+		g.SetEdgeArena(arena)
+		v := []Vertex{{ID: 0}, {ID: 1}, {ID: 2}}
+		ab := g.AddEdge(Edge{From: &v[0], To: &v[1], Weight: 1})
+		g.AddEdge(Edge{From: &v[1], To: &v[2], Weight: 2})
+		before := g.Hash()
+
+		tx := g.Begin()
+		g.AddEdge(Edge{From: &v[0], To: &v[2], Weight: 3})
+		g.SetEdgeWeight(ab, 9)
+		g.Clear()
+		g.AddEdge(Edge{From: &v[0], To: &v[1], Weight: 5})
+		if err := tx.Rollback(); err != nil {
+			t.Fatalf("Rollback failed: %v", err)
+		}
+
+		if g.Hash() != before {
+			t.Errorf("arena %v: expected rollback to restore the graph, got %v", arena != nil, g.Edges)
+		}
+		if ab.Weight != 1 || g.Edges[0] != ab && g.Edges[1] != ab {
+			t.Errorf("arena %v: expected the original edge 0-1 with weight 1, got weight %d", arena != nil, ab.Weight)
+		}
+		if g.HasEdge(0, 2) || !g.HasEdge(1, 2) {
+			t.Errorf("arena %v: expected edges 0-1 and 1-2 only", arena != nil)
+		}
+	}
+}
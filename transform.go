@@ -0,0 +1,114 @@
+package mst
+
+import "fmt"
+
+// ==================== GRAPH TRANSFORMATIONS ====================
+
+// LineWeightFunc gives the weight of the line graph edge joining two original edges
+type LineWeightFunc func(a, b *Edge) int
+
+// PairWeightFunc gives the weight of a complement edge between two vertices
+type PairWeightFunc func(from, to Vertex) int
+
+// LineGraph returns the line graph of g: one vertex per edge of g, joined
+// wherever the two edges share an endpoint
+// Vertex i stands for g.Edges[i], is named after it, and carries it as Data
+// In a directed graph an arc runs from u→v to every v→w instead
+// Edge weights come from weight, or are 1 when weight is nil
+func (g *Graph) LineGraph(weight LineWeightFunc) Graph {
+	lg := NewGraph(g.Directed)
+	vertices := make([]Vertex, len(g.Edges))
+	for i, e := range g.Edges {
+		vertices[i] = Vertex{ID: i, Name: fmt.Sprintf("%d-%d", e.From.ID, e.To.ID), Data: e}
+		lg.AddVertex(vertices[i])
+	}
+
+	// Edges touching each vertex; directed graphs only need arrivals and departures
+	out := make(map[int][]int)
+	in := make(map[int][]int)
+	for i, e := range g.Edges {
+		out[e.From.ID] = append(out[e.From.ID], i)
+		if e.From.ID != e.To.ID || g.Directed {
+			in[e.To.ID] = append(in[e.To.ID], i)
+		}
+	}
+
+	seen := make(map[[2]int]bool)
+	edges := make([]Edge, 0)
+	link := func(a, b int) {
+		if a == b {
+			return
+		}
+		if !g.Directed && a > b {
+			a, b = b, a
+		}
+		if seen[[2]int{a, b}] {
+			return
+		}
+		seen[[2]int{a, b}] = true
+		w := 1
+		if weight != nil {
+			w = weight(g.Edges[a], g.Edges[b])
+		}
+		edges = append(edges, Edge{From: &vertices[a], To: &vertices[b], Weight: w})
+	}
+
+	for _, id := range g.SortedVertexIDs() {
+		if g.Directed {
+			for _, a := range in[id] {
+				for _, b := range out[id] {
+					link(a, b)
+				}
+			}
+			continue
+		}
+		incident := append(append([]int(nil), out[id]...), in[id]...)
+		for x, a := range incident {
+			for _, b := range incident[x+1:] {
+				link(a, b)
+			}
+		}
+	}
+	lg.AddEdges(edges)
+	return lg
+}
+
+// Complement returns the graph on the same vertices with an edge between
+// every pair of distinct vertices that g does not join
+// In a directed graph each missing arc is added; vertex Data and names are
+// kept but no edges are. Edge weights come from weight, or are 1 when it is nil
+func (g *Graph) Complement(weight PairWeightFunc) Graph {
+	cg := NewGraph(g.Directed)
+	ids := g.SortedVertexIDs()
+	vertices := make(map[int]*Vertex, len(ids))
+	for _, id := range ids {
+		v := g.Vertices[id]
+		copied := Vertex{ID: v.ID, Name: v.Name, Data: v.Data, Attrs: v.Attrs, Weight: v.Weight}
+		vertices[id] = &copied
+		cg.AddVertex(copied)
+	}
+
+	adjacent := make(map[[2]int]bool, len(g.Edges))
+	for _, e := range g.Edges {
+		adjacent[[2]int{e.From.ID, e.To.ID}] = true
+		if !g.Directed {
+			adjacent[[2]int{e.To.ID, e.From.ID}] = true
+		}
+	}
+
+	edges := make([]Edge, 0)
+	for i, u := range ids {
+		for j, v := range ids {
+			if i == j || (!g.Directed && j < i) || adjacent[[2]int{u, v}] {
+				continue
+			}
+			w := 1
+			if weight != nil {
+				w = weight(g.Vertices[u], g.Vertices[v])
+			}
+			edges = append(edges, Edge{From: vertices[u], To: vertices[v], Weight: w})
+		}
+	}
+	cg.AddEdges(edges)
+	return cg
+}
@@ -0,0 +1,68 @@
+package mst
+
+import (
+	"fmt"
+	"testing"
+)
+
+// TestLineGraph tests the line graph of a star and a directed path
+func TestLineGraph(t *testing.T) {
+	fmt.Println("\n=== LINE GRAPH TEST ===")
+
+	// A star with three leaves becomes a triangle
+	star := NewGraph(false)
+	v := []Vertex{{ID: 0}, {ID: 1}, {ID: 2}, {ID: 3}}
+	for i := 1; i <= 3; i++ {
+		star.AddEdge(Edge{From: &v[0], To: &v[i], Weight: i})
+	}
+	lg := star.LineGraph(func(a, b *Edge) int { return a.Weight + b.Weight })
+	lg.Print()
+	if lg.VertexCount() != 3 || lg.EdgeCount() != 3 || lg.CountTriangles() != 1 {
+		t.Errorf("Expected a triangle, got %d vertices and %d edges", lg.VertexCount(), lg.EdgeCount())
+	}
+	if e, ok := lg.GetEdge(1, 2); !ok || e.Weight != 5 {
+		t.Errorf("Expected edge 1-2 of weight 5, got %v", e)
+	}
+	if lg.Vertices[0].Data != star.Edges[0] {
+		t.Error("Line graph vertex should carry its original edge")
+	}
+
+	// A directed path 0→1→2 links only consecutive arcs
+	path := NewGraph(true)
+	path.AddEdge(Edge{From: &v[0], To: &v[1], Weight: 1})
+	path.AddEdge(Edge{From: &v[1], To: &v[2], Weight: 1})
+	path.AddEdge(Edge{From: &v[0], To: &v[2], Weight: 1})
+	dlg := path.LineGraph(nil)
+	if dlg.EdgeCount() != 1 || !dlg.HasEdge(0, 1) {
+		t.Errorf("Expected the single arc 0→1, got %d arcs", dlg.EdgeCount())
+	}
+}
+
+// TestComplement tests complementing a path
+func TestComplement(t *testing.T) {
+	fmt.Println("\n=== COMPLEMENT TEST ===")
+
+	g := newPathGraph(1, 1, 1) // 0-1-2-3
+	cg := g.Complement(func(from, to Vertex) int { return to.ID - from.ID })
+	cg.Print()
+
+	if cg.VertexCount() != 4 || cg.EdgeCount() != 3 {
+		t.Fatalf("Expected 4 vertices and 3 edges, got %d and %d", cg.VertexCount(), cg.EdgeCount())
+	}
+	for _, p := range [][3]int{{0, 2, 2}, {0, 3, 3}, {1, 3, 2}} {
+		if e, ok := cg.GetEdge(p[0], p[1]); !ok || e.Weight != p[2] {
+			t.Errorf("Expected edge %d-%d of weight %d, got %v", p[0], p[1], p[2], e)
+		}
+	}
+	if cg.HasEdge(0, 1) {
+		t.Error("Complement must not contain original edges")
+	}
+
+	directed := NewGraph(true)
+	v := []Vertex{{ID: 0}, {ID: 1}}
+	directed.AddEdge(Edge{From: &v[0], To: &v[1], Weight: 1})
+	dc := directed.Complement(nil)
+	if dc.EdgeCount() != 1 || !dc.HasEdge(1, 0) {
+		t.Errorf("Expected only the arc 1→0, got %d arcs", dc.EdgeCount())
+	}
+}
@@ -0,0 +1,194 @@
+package mst
+
+import (
+	"iter"
+	"sort"
+)
+
+// ==================== ROOTED TREE HELPERS ====================
+
+// rootedForest is a spanning forest with every tree hung from a root
+type rootedForest struct {
+	parent     map[int]int   // parent vertex, roots are their own parent
+	parentEdge map[int]*Edge // edge to the parent, nil for roots
+	depth      map[int]int
+	order      []int // vertices in BFS order, parents before children
+}
+
+// rootForest roots every tree of a forest given as an edge list
+// Trees are rooted at their smallest vertex ID, except that the tree
+// containing preferredRoot (if any) is rooted there
+func rootForest(edges []*Edge, preferredRoot int) *rootedForest {
+	adj := make(map[int][]*Edge)
+	for _, e := range edges {
+		adj[e.From.ID] = append(adj[e.From.ID], e)
+		adj[e.To.ID] = append(adj[e.To.ID], e)
+	}
+
+	ids := make([]int, 0, len(adj))
+	for id := range adj {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	if _, exists := adj[preferredRoot]; exists {
+		ids = append([]int{preferredRoot}, ids...)
+	}
+
+	rf := &rootedForest{
+		parent:     make(map[int]int, len(adj)),
+		parentEdge: make(map[int]*Edge, len(adj)),
+		depth:      make(map[int]int, len(adj)),
+		order:      make([]int, 0, len(adj)),
+	}
+	for _, root := range ids {
+		if _, seen := rf.parent[root]; seen {
+			continue
+		}
+		rf.parent[root] = root
+		rf.parentEdge[root] = nil
+		rf.depth[root] = 0
+		queue := []int{root}
+		for len(queue) > 0 {
+			v := queue[0]
+			queue = queue[1:]
+			rf.order = append(rf.order, v)
+			for _, e := range adj[v] {
+				other := e.To.ID
+				if other == v {
+					other = e.From.ID
+				}
+				if _, seen := rf.parent[other]; seen {
+					continue
+				}
+				rf.parent[other] = v
+				rf.parentEdge[other] = e
+				rf.depth[other] = rf.depth[v] + 1
+				queue = append(queue, other)
+			}
+		}
+	}
+	return rf
+}
+
+// ==================== ROOTED MST VIEW ====================
+
+// RootedTree is an MST hung from a chosen root
+// Only the tree containing the root is included; the root is its own parent
+type RootedTree struct {
+	Root        int
+	Parent      map[int]int
+	ParentEdge  map[int]*Edge // nil for the root
+	Depth       map[int]int
+	SubtreeSize map[int]int   // number of vertices in the subtree, including itself
+	Children    map[int][]int // sorted by vertex ID
+}
+
+// RootMST turns a flat MST edge list into a rooted hierarchy
+func RootMST(mst []*Edge, rootID int) *RootedTree {
+	adj := make(map[int][]*Edge)
+	for _, e := range mst {
+		adj[e.From.ID] = append(adj[e.From.ID], e)
+		adj[e.To.ID] = append(adj[e.To.ID], e)
+	}
+
+	t := &RootedTree{
+		Root:        rootID,
+		Parent:      map[int]int{rootID: rootID},
+		ParentEdge:  map[int]*Edge{rootID: nil},
+		Depth:       map[int]int{rootID: 0},
+		SubtreeSize: make(map[int]int),
+		Children:    make(map[int][]int),
+	}
+
+	order := []int{rootID}
+	for i := 0; i < len(order); i++ {
+		v := order[i]
+		for _, e := range adj[v] {
+			child := e.To.ID
+			if child == v {
+				child = e.From.ID
+			}
+			if _, seen := t.Parent[child]; seen {
+				continue
+			}
+			t.Parent[child] = v
+			t.ParentEdge[child] = e
+			t.Depth[child] = t.Depth[v] + 1
+			t.Children[v] = append(t.Children[v], child)
+			order = append(order, child)
+		}
+		sort.Ints(t.Children[v])
+	}
+
+	// BFS order lists parents before children, so accumulate sizes backwards
+	for i := len(order) - 1; i >= 0; i-- {
+		v := order[i]
+		t.SubtreeSize[v]++
+		if v != rootID {
+			t.SubtreeSize[t.Parent[v]] += t.SubtreeSize[v]
+		}
+	}
+	return t
+}
+
+// Len returns the number of vertices in the tree
+func (t *RootedTree) Len() int {
+	return len(t.Parent)
+}
+
+// Preorder yields every vertex before its children, visiting children in ID order
+func (t *RootedTree) Preorder() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		stack := []int{t.Root}
+		for len(stack) > 0 {
+			v := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if !yield(v) {
+				return
+			}
+			children := t.Children[v]
+			for i := len(children) - 1; i >= 0; i-- {
+				stack = append(stack, children[i])
+			}
+		}
+	}
+}
+
+// Postorder yields every vertex after all of its children, visiting children in ID order
+func (t *RootedTree) Postorder() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		type frame struct {
+			v    int
+			next int // index of the next child to visit
+		}
+		stack := []frame{{v: t.Root}}
+		for len(stack) > 0 {
+			top := &stack[len(stack)-1]
+			children := t.Children[top.v]
+			if top.next < len(children) {
+				child := children[top.next]
+				top.next++
+				stack = append(stack, frame{v: child})
+				continue
+			}
+			stack = stack[:len(stack)-1]
+			if !yield(top.v) {
+				return
+			}
+		}
+	}
+}
+
+// PathToRoot returns the vertices from id up to the root, inclusive
+// It returns nil if id is not in the tree
+func (t *RootedTree) PathToRoot(id int) []int {
+	if _, exists := t.Parent[id]; !exists {
+		return nil
+	}
+	path := []int{id}
+	for id != t.Root {
+		id = t.Parent[id]
+		path = append(path, id)
+	}
+	return path
+}
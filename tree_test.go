@@ -0,0 +1,60 @@
+package mst
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+)
+
+// TestRootMST tests parents, depths, subtree sizes, and traversal orders
+func TestRootMST(t *testing.T) {
+	fmt.Println("\n=== ROOTED MST TEST ===")
+
+	g, _ := buildConstraintGraph()
+	mst, _ := g.Kruskal()
+
+	// MST edges: 1-2, 0-2, 3-4, 4-5, 1-3
+	tree := RootMST(mst, 0)
+
+	if tree.Len() != 6 {
+		t.Fatalf("Expected 6 vertices, got %d", tree.Len())
+	}
+	expectedParent := map[int]int{0: 0, 2: 0, 1: 2, 3: 1, 4: 3, 5: 4}
+	for v, p := range expectedParent {
+		if tree.Parent[v] != p {
+			t.Errorf("Vertex %d: expected parent %d, got %d", v, p, tree.Parent[v])
+		}
+	}
+	if tree.Depth[5] != 5 {
+		t.Errorf("Expected depth 5 for vertex 5, got %d", tree.Depth[5])
+	}
+	if tree.SubtreeSize[0] != 6 || tree.SubtreeSize[3] != 3 || tree.SubtreeSize[5] != 1 {
+		t.Errorf("Unexpected subtree sizes: %v", tree.SubtreeSize)
+	}
+
+	pre := slices.Collect(tree.Preorder())
+	post := slices.Collect(tree.Postorder())
+	fmt.Println("  preorder: ", pre)
+	fmt.Println("  postorder:", post)
+	if !slices.Equal(pre, []int{0, 2, 1, 3, 4, 5}) {
+		t.Errorf("Unexpected preorder %v", pre)
+	}
+	if !slices.Equal(post, []int{5, 4, 3, 1, 2, 0}) {
+		t.Errorf("Unexpected postorder %v", post)
+	}
+	if path := tree.PathToRoot(4); !slices.Equal(path, []int{4, 3, 1, 2, 0}) {
+		t.Errorf("Unexpected path to root %v", path)
+	}
+
+	// Early termination must stop the iterator
+	count := 0
+	for range tree.Preorder() {
+		count++
+		if count == 2 {
+			break
+		}
+	}
+	if count != 2 {
+		t.Errorf("Expected iteration to stop after 2 vertices, got %d", count)
+	}
+}
@@ -0,0 +1,49 @@
+package mst
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+)
+
+// TestSpanningTreeCount tests the Matrix-Tree theorem against known counts
+func TestSpanningTreeCount(t *testing.T) {
+	fmt.Println("\n=== SPANNING TREE COUNT TEST ===")
+
+	// Cayley's formula: the complete graph K_n has n^(n-2) spanning trees
+	for _, n := range []int{2, 3, 5, 10, 20} {
+		g := buildCompleteGraph(n)
+		expected := new(big.Int).Exp(big.NewInt(int64(n)), big.NewInt(int64(n-2)), nil)
+		count := g.SpanningTreeCount()
+		fmt.Printf("  K_%d: %s\n", n, count)
+		if count.Cmp(expected) != 0 {
+			t.Errorf("K_%d: expected %s spanning trees, got %s", n, expected, count)
+		}
+	}
+
+	// A 4-cycle has 4 spanning trees; doubling one edge adds one more choice
+	g := NewGraph(false)
+	v := make([]*Vertex, 4)
+	for i := range v {
+		v[i] = &Vertex{ID: i, Edges: make([]*Edge, 0)}
+	}
+	for i := 0; i < 4; i++ {
+		g.AddEdge(Edge{From: v[i], To: v[(i+1)%4], Weight: 1})
+	}
+	if c := g.SpanningTreeCount(); c.Int64() != 4 {
+		t.Errorf("Expected 4 spanning trees in a 4-cycle, got %s", c)
+	}
+	g.AddEdge(Edge{From: v[0], To: v[1], Weight: 1})
+	g.AddEdge(Edge{From: v[2], To: v[2], Weight: 1}) // self-loop is ignored
+	if c := g.SpanningTreeCount(); c.Int64() != 7 {
+		t.Errorf("Expected 7 spanning trees with a doubled edge, got %s", c)
+	}
+
+	// Disconnected graph
+	h := NewGraph(false)
+	h.AddEdge(Edge{From: v[0], To: v[1], Weight: 1})
+	h.AddEdge(Edge{From: v[2], To: v[3], Weight: 1})
+	if c := h.SpanningTreeCount(); c.Sign() != 0 {
+		t.Errorf("Expected 0 spanning trees in a disconnected graph, got %s", c)
+	}
+}
@@ -0,0 +1,115 @@
+package mst
+
+import "sort"
+
+// ==================== ADJACENCY SETS ====================
+
+// AdjacencySets returns the set of distinct neighbors of every vertex
+// Edge direction is ignored, and self-loops and parallel edges are dropped,
+// so the result describes the underlying simple undirected graph
+func (g *Graph) AdjacencySets() map[int]map[int]struct{} {
+	sets := make(map[int]map[int]struct{}, len(g.Vertices))
+	for id := range g.Vertices {
+		sets[id] = make(map[int]struct{})
+	}
+	for _, e := range g.Edges {
+		u, v := e.From.ID, e.To.ID
+		if u == v {
+			continue
+		}
+		sets[u][v] = struct{}{}
+		sets[v][u] = struct{}{}
+	}
+	return sets
+}
+
+// Neighbors returns the distinct neighbors of a vertex in ascending ID order
+// Edge direction is ignored; nil is returned if the vertex does not exist
+func (g *Graph) Neighbors(id int) []int {
+	if _, exists := g.Vertices[id]; !exists {
+		return nil
+	}
+	seen := make(map[int]bool)
+	for _, e := range g.Edges {
+		switch {
+		case e.From.ID == e.To.ID:
+		case e.From.ID == id:
+			seen[e.To.ID] = true
+		case e.To.ID == id:
+			seen[e.From.ID] = true
+		}
+	}
+	neighbors := make([]int, 0, len(seen))
+	for n := range seen {
+		neighbors = append(neighbors, n)
+	}
+	sort.Ints(neighbors)
+	return neighbors
+}
+
+// ==================== TRIANGLES ====================
+
+// CountTriangles returns the number of distinct triangles in the graph
+// Each triangle is counted once, by its lowest-ID corner
+func (g *Graph) CountTriangles() int {
+	sets := g.AdjacencySets()
+	count := 0
+	for u, nu := range sets {
+		for v := range nu {
+			if v <= u {
+				continue
+			}
+			for w := range sets[v] {
+				if w > v {
+					if _, closed := nu[w]; closed {
+						count++
+					}
+				}
+			}
+		}
+	}
+	return count
+}
+
+// ClusteringCoefficient returns the fraction of pairs of neighbors of a
+// vertex that are themselves adjacent, in [0, 1]
+// Vertices with fewer than two neighbors, or missing from the graph, have coefficient 0
+func (g *Graph) ClusteringCoefficient(id int) float64 {
+	if _, exists := g.Vertices[id]; !exists {
+		return 0
+	}
+	return localClustering(g.AdjacencySets(), id)
+}
+
+// AverageClusteringCoefficient returns the mean local clustering coefficient
+// over all vertices, the global measure of Watts and Strogatz
+func (g *Graph) AverageClusteringCoefficient() float64 {
+	if len(g.Vertices) == 0 {
+		return 0
+	}
+	sets := g.AdjacencySets()
+	sum := 0.0
+	for id := range sets {
+		sum += localClustering(sets, id)
+	}
+	return sum / float64(len(sets))
+}
+
+// localClustering computes the clustering coefficient of id from adjacency sets
+func localClustering(sets map[int]map[int]struct{}, id int) float64 {
+	neighbors := sets[id]
+	k := len(neighbors)
+	if k < 2 {
+		return 0
+	}
+	links := 0
+	for u := range neighbors {
+		for v := range sets[u] {
+			if _, shared := neighbors[v]; shared {
+				links++
+			}
+		}
+	}
+	// Each link between neighbors was seen from both ends
+	return float64(links) / float64(k*(k-1))
+}
@@ -0,0 +1,50 @@
+package mst
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"testing"
+)
+
+// TestTriangles tests triangle counting and clustering coefficients
+func TestTriangles(t *testing.T) {
+	fmt.Println("\n=== TRIANGLES TEST ===")
+
+	// Two triangles 0-1-2 and 1-2-3 sharing edge 1-2, plus pendant 4 on 3
+	g := NewGraph(false)
+	v := make([]Vertex, 5)
+	for i := range v {
+		v[i] = Vertex{ID: i}
+	}
+	for _, p := range [][2]int{{0, 1}, {0, 2}, {1, 2}, {1, 3}, {2, 3}, {3, 4}} {
+		g.AddEdge(Edge{From: &v[p[0]], To: &v[p[1]], Weight: 1})
+	}
+	// A parallel edge and a self-loop must not change anything
+	g.AddEdge(Edge{From: &v[0], To: &v[1], Weight: 5})
+	g.AddEdge(Edge{From: &v[4], To: &v[4], Weight: 1})
+
+	if got := g.CountTriangles(); got != 2 {
+		t.Errorf("Expected 2 triangles, got %d", got)
+	}
+	if got := g.Neighbors(1); !slices.Equal(got, []int{0, 2, 3}) {
+		t.Errorf("Expected neighbors [0 2 3], got %v", got)
+	}
+
+	expected := map[int]float64{0: 1, 1: 2.0 / 3, 2: 2.0 / 3, 3: 1.0 / 3, 4: 0}
+	sum := 0.0
+	for id, want := range expected {
+		got := g.ClusteringCoefficient(id)
+		fmt.Printf("  C(%d) = %.3f\n", id, got)
+		if math.Abs(got-want) > 1e-9 {
+			t.Errorf("Vertex %d: expected %.3f, got %.3f", id, want, got)
+		}
+		sum += want
+	}
+	if got := g.AverageClusteringCoefficient(); math.Abs(got-sum/5) > 1e-9 {
+		t.Errorf("Expected average %.3f, got %.3f", sum/5, got)
+	}
+	if g.ClusteringCoefficient(99) != 0 || g.Neighbors(99) != nil {
+		t.Error("Missing vertex should have no neighbors and coefficient 0")
+	}
+}
@@ -0,0 +1,83 @@
+package mst
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// ==================== EDGE EXPIRY ====================
+
+// SetExpiry makes an edge expire at the given time, so that PruneExpired
+// removes it from then on. A zero time clears the expiry
+// Either the edge from g.Edges or its reverse adjacency copy may be passed
+func (g *Graph) SetExpiry(edge *Edge, at time.Time) {
+	if at.IsZero() {
+		delete(g.expiry, edge)
+		if edge.twin != nil {
+			delete(g.expiry, edge.twin)
+		}
+		return
+	}
+	if g.expiry == nil {
+		g.expiry = make(map[*Edge]time.Time)
+	}
+	g.expiry[edge] = at
+	if edge.twin != nil {
+		g.expiry[edge.twin] = at
+	}
+}
+
+// SetTTL makes an edge expire ttl after now
+// Calling it again on every heartbeat of a link keeps the link alive
+func (g *Graph) SetTTL(edge *Edge, ttl time.Duration, now time.Time) {
+	g.SetExpiry(edge, now.Add(ttl))
+}
+
+// ExpiryOf returns when an edge expires, and false if it never does
+func (g *Graph) ExpiryOf(edge *Edge) (time.Time, bool) {
+	at, ok := g.expiry[edge]
+	return at, ok
+}
+
+// PruneExpired removes every edge whose expiry is not after now and
+// returns the removed edges in g.Edges order
+// Removal goes through RemoveEdge, so observers and transactions see it;
+// removed edges lose their expiry, also if a rollback restores them
+func (g *Graph) PruneExpired(now time.Time) []*Edge {
+	if len(g.expiry) == 0 {
+		return nil
+	}
+	expired := make([]*Edge, 0)
+	for _, edge := range g.Edges {
+		if at, ok := g.expiry[edge]; ok && !at.After(now) {
+			expired = append(expired, edge)
+		}
+	}
+	for _, edge := range expired {
+		g.RemoveEdge(edge)
+	}
+	return expired
+}
+
+// RunJanitor calls PruneExpired every interval until ctx is done, holding
+// mu while it does so; other users of the graph must hold mu as well
+// onPrune, if not nil, receives each non-empty batch of removed edges
+// It blocks, so it is usually started with go
+func (g *Graph) RunJanitor(ctx context.Context, interval time.Duration, mu sync.Locker, onPrune func(removed []*Edge)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			mu.Lock()
+			removed := g.PruneExpired(now)
+			mu.Unlock()
+			if len(removed) > 0 && onPrune != nil {
+				onPrune(removed)
+			}
+		}
+	}
+}
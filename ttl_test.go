@@ -0,0 +1,71 @@
+package mst
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+// TestPruneExpired tests per-edge TTLs, heartbeats, and pruning
+func TestPruneExpired(t *testing.T) {
+	fmt.Println("\n=== EDGE EXPIRY TEST ===")
+
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	g := NewGraph(false)
+	v := []*Vertex{{ID: 0}, {ID: 1}, {ID: 2}}
+	ab := g.AddEdge(Edge{From: v[0], To: v[1], Weight: 1})
+	bc := g.AddEdge(Edge{From: v[1], To: v[2], Weight: 2})
+	ac := g.AddEdge(Edge{From: v[0], To: v[2], Weight: 9})
+
+	g.SetTTL(ab, 10*time.Second, now)
+	g.SetTTL(bc.twin, 10*time.Second, now)
+	if at, ok := g.ExpiryOf(bc); !ok || !at.Equal(now.Add(10*time.Second)) {
+		t.Errorf("Expected the reverse copy to set the expiry, got %v", at)
+	}
+
+	// A heartbeat keeps A-B alive while B-C goes stale
+	g.SetTTL(ab, 10*time.Second, now.Add(5*time.Second))
+	removed := g.PruneExpired(now.Add(10 * time.Second))
+	if len(removed) != 1 || removed[0] != bc || g.EdgeCount() != 2 {
+		t.Errorf("Expected only B-C to be pruned, got %d removed", len(removed))
+	}
+	if _, ok := g.ExpiryOf(bc); ok {
+		t.Error("Expected the pruned edge to lose its expiry")
+	}
+
+	mst, total := g.Kruskal()
+	PrintMST(mst, total, "KRUSKAL AFTER PRUNING")
+	if total != 10 {
+		t.Errorf("Expected the MST to fall back to A-C, got total %d", total)
+	}
+
+	g.SetExpiry(ac, time.Time{})
+	if _, ok := g.ExpiryOf(ac); ok {
+		t.Error("Expected a zero time to clear the expiry")
+	}
+
+	// The janitor prunes in the background under the caller's lock
+	var mu sync.Mutex
+	g.SetExpiry(ab, time.Now())
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan []*Edge, 1)
+	go g.RunJanitor(ctx, time.Millisecond, &mu, func(removed []*Edge) {
+		done <- removed
+		cancel()
+	})
+	select {
+	case removed := <-done:
+		if len(removed) != 1 || removed[0] != ab {
+			t.Errorf("Expected the janitor to prune A-B, got %d edges", len(removed))
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected the janitor to prune within 5s")
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if g.EdgeCount() != 1 {
+		t.Errorf("Expected 1 edge left, got %d", g.EdgeCount())
+	}
+}
@@ -0,0 +1,69 @@
+package mst
+
+import "fmt"
+
+// ==================== TYPED GRAPH ====================
+
+// TypedGraph wraps a Graph whose vertex payloads are VD and edge payloads are ED
+// Adding vertices and edges through it is type-checked at compile time, and the
+// payloads can be read back without type assertions. Every Graph algorithm is
+// available through the embedded Graph
+type TypedGraph[VD any, ED any] struct {
+	Graph
+}
+
+// NewTypedGraph creates an empty graph with typed payloads
+func NewTypedGraph[VD any, ED any](directed bool) *TypedGraph[VD, ED] {
+	return &TypedGraph[VD, ED]{Graph: NewGraph(directed)}
+}
+
+// AddVertex adds a vertex carrying data; an existing ID is left unchanged
+func (t *TypedGraph[VD, ED]) AddVertex(id int, name string, data VD) *Vertex {
+	return t.Graph.AddVertex(Vertex{ID: id, Name: name, Data: data})
+}
+
+// AddEdge connects two existing vertices with an edge carrying data
+func (t *TypedGraph[VD, ED]) AddEdge(fromID, toID, weight int, data ED) (*Edge, error) {
+	from, fromExists := t.GetVertex(fromID)
+	if !fromExists {
+		return nil, fmt.Errorf("%w: %d", ErrVertexNotFound, fromID)
+	}
+	to, toExists := t.GetVertex(toID)
+	if !toExists {
+		return nil, fmt.Errorf("%w: %d", ErrVertexNotFound, toID)
+	}
+	return t.Graph.AddEdge(Edge{From: from, To: to, Weight: weight, Data: data}), nil
+}
+
+// VertexData returns the payload of a vertex
+// ok is false if the vertex is missing or its Data was not set through TypedGraph
+func (t *TypedGraph[VD, ED]) VertexData(id int) (data VD, ok bool) {
+	v, exists := t.Vertices[id]
+	if !exists {
+		return data, false
+	}
+	return VertexDataOf[VD](&v)
+}
+
+// EdgeData returns the payload of an edge, such as one returned by Kruskal or Prim
+func (t *TypedGraph[VD, ED]) EdgeData(edge *Edge) (data ED, ok bool) {
+	return EdgeDataOf[ED](edge)
+}
+
+// VertexDataOf reads a vertex payload as type VD
+func VertexDataOf[VD any](v *Vertex) (data VD, ok bool) {
+	if v == nil {
+		return data, false
+	}
+	data, ok = v.Data.(VD)
+	return data, ok
+}
+
+// EdgeDataOf reads an edge payload as type ED
+func EdgeDataOf[ED any](edge *Edge) (data ED, ok bool) {
+	if edge == nil {
+		return data, false
+	}
+	data, ok = edge.Data.(ED)
+	return data, ok
+}
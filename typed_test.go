@@ -0,0 +1,61 @@
+package mst
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+type CityInfo struct {
+	Population int
+}
+
+type LinkInfo struct {
+	Carrier string
+}
+
+// TestTypedGraph tests typed payloads on vertices and edges
+func TestTypedGraph(t *testing.T) {
+	fmt.Println("\n=== TYPED GRAPH TEST ===")
+
+	g := NewTypedGraph[CityInfo, LinkInfo](false)
+	g.AddVertex(0, "Istanbul", CityInfo{Population: 15_000_000})
+	g.AddVertex(1, "Ankara", CityInfo{Population: 5_700_000})
+	g.AddVertex(2, "Izmir", CityInfo{Population: 4_400_000})
+
+	links := []struct {
+		from, to, weight int
+		carrier          string
+	}{
+		{0, 1, 450, "rail"},
+		{1, 2, 580, "road"},
+		{0, 2, 330, "ferry"},
+	}
+	for _, l := range links {
+		if _, err := g.AddEdge(l.from, l.to, l.weight, LinkInfo{Carrier: l.carrier}); err != nil {
+			t.Fatalf("AddEdge failed: %v", err)
+		}
+	}
+
+	mst, weight := g.Kruskal()
+	if weight != 780 {
+		t.Errorf("Expected MST weight 780, got %d", weight)
+	}
+	for _, edge := range mst {
+		info, ok := g.EdgeData(edge)
+		if !ok || info.Carrier == "road" {
+			t.Errorf("Unexpected edge payload %+v (ok=%v)", info, ok)
+		}
+		fmt.Printf("%s - %s via %s\n", edge.From.Name, edge.To.Name, info.Carrier)
+	}
+
+	if city, ok := g.VertexData(0); !ok || city.Population != 15_000_000 {
+		t.Errorf("Expected Istanbul payload, got %+v (ok=%v)", city, ok)
+	}
+	if _, ok := g.VertexData(9); ok {
+		t.Error("Expected no payload for a missing vertex")
+	}
+	if _, err := g.AddEdge(0, 9, 1, LinkInfo{}); !errors.Is(err, ErrVertexNotFound) {
+		t.Errorf("Expected ErrVertexNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,425 @@
+package mst
+
+// ==================== GENERIC UNION-FIND ====================
+
+// DisjointSet is a Union-Find structure over any comparable key type
+// It uses union by rank and path halving, and can be used on its own
+// outside of the MST algorithms
+type DisjointSet[K comparable] struct {
+	parent map[K]K
+	rank   map[K]int
+	size   map[K]int // only meaningful for roots
+	count  int       // number of disjoint sets
+}
+
+// NewDisjointSet creates an empty DisjointSet
+func NewDisjointSet[K comparable]() *DisjointSet[K] {
+	return &DisjointSet[K]{
+		parent: make(map[K]K),
+		rank:   make(map[K]int),
+		size:   make(map[K]int),
+	}
+}
+
+// MakeSet creates a new set for a vertex
+func (uf *DisjointSet[K]) MakeSet(x K) {
+	if _, exists := uf.parent[x]; !exists {
+		uf.parent[x] = x
+		uf.rank[x] = 0
+		uf.size[x] = 1
+		uf.count++
+	}
+}
+
+// Find finds the root vertex of a vertex (with path halving)
+// It runs iteratively, so long parent chains cannot exhaust the stack
+// A vertex that was never added with MakeSet is its own root
+func (uf *DisjointSet[K]) Find(x K) K {
+	if _, exists := uf.parent[x]; !exists {
+		return x
+	}
+	for uf.parent[x] != x {
+		// Path halving: point every other node at its grandparent
+		uf.parent[x] = uf.parent[uf.parent[x]]
+		x = uf.parent[x]
+	}
+	return x
+}
+
+// Union merges two sets (with union by rank)
+// If two vertices are in different sets, it merges them and returns true
+// If they are in the same set (cycle would be formed), it returns false
+func (uf *DisjointSet[K]) Union(x, y K) bool {
+	rootX := uf.Find(x)
+	rootY := uf.Find(y)
+
+	if rootX == rootY {
+		return false // Already in the same set, cycle would be formed
+	}
+
+	// Union by rank
+	if uf.rank[rootX] < uf.rank[rootY] {
+		rootX, rootY = rootY, rootX
+	} else if uf.rank[rootX] == uf.rank[rootY] {
+		uf.rank[rootX]++
+	}
+	uf.parent[rootY] = rootX
+	uf.size[rootX] += uf.size[rootY]
+	delete(uf.size, rootY)
+	uf.count--
+	return true
+}
+
+// Connected reports whether two vertices are in the same set
+func (uf *DisjointSet[K]) Connected(x, y K) bool {
+	return uf.Find(x) == uf.Find(y)
+}
+
+// Count returns the number of disjoint sets
+func (uf *DisjointSet[K]) Count() int {
+	return uf.count
+}
+
+// SetSize returns the number of elements in the set containing x
+// An element that was never added with MakeSet has size 0
+func (uf *DisjointSet[K]) SetSize(x K) int {
+	return uf.size[uf.Find(x)]
+}
+
+// ==================== DENSE UNION-FIND ====================
+
+// DenseUnionFind is a slice-backed Union-Find over the integer range [0, n)
+// It avoids map overhead entirely and is the fastest choice when
+// elements are already numbered densely
+type DenseUnionFind struct {
+	parent []int32
+	rank   []uint8
+	size   []int32
+	count  int
+}
+
+// NewDenseUnionFind creates n singleton sets numbered 0 to n-1
+func NewDenseUnionFind(n int) *DenseUnionFind {
+	uf := &DenseUnionFind{
+		parent: make([]int32, n),
+		rank:   make([]uint8, n),
+		size:   make([]int32, n),
+		count:  n,
+	}
+	for i := range uf.parent {
+		uf.parent[i] = int32(i)
+		uf.size[i] = 1
+	}
+	return uf
+}
+
+// Reset turns the structure into n singleton sets again, reusing its memory when possible
+func (uf *DenseUnionFind) Reset(n int) {
+	if cap(uf.parent) < n {
+		*uf = *NewDenseUnionFind(n)
+		return
+	}
+	uf.parent, uf.rank, uf.size = uf.parent[:n], uf.rank[:n], uf.size[:n]
+	for i := range uf.parent {
+		uf.parent[i] = int32(i)
+		uf.size[i] = 1
+	}
+	clear(uf.rank)
+	uf.count = n
+}
+
+// Len returns the number of elements
+func (uf *DenseUnionFind) Len() int {
+	return len(uf.parent)
+}
+
+// Find finds the root of x (with path halving)
+func (uf *DenseUnionFind) Find(x int) int {
+	p := uf.parent
+	i := int32(x)
+	for p[i] != i {
+		p[i] = p[p[i]]
+		i = p[i]
+	}
+	return int(i)
+}
+
+// Union merges the sets containing x and y and reports whether they were separate
+func (uf *DenseUnionFind) Union(x, y int) bool {
+	rootX := uf.Find(x)
+	rootY := uf.Find(y)
+	if rootX == rootY {
+		return false
+	}
+
+	if uf.rank[rootX] < uf.rank[rootY] {
+		rootX, rootY = rootY, rootX
+	} else if uf.rank[rootX] == uf.rank[rootY] {
+		uf.rank[rootX]++
+	}
+	uf.parent[rootY] = int32(rootX)
+	uf.size[rootX] += uf.size[rootY]
+	uf.count--
+	return true
+}
+
+// Connected reports whether x and y are in the same set
+func (uf *DenseUnionFind) Connected(x, y int) bool {
+	return uf.Find(x) == uf.Find(y)
+}
+
+// Count returns the number of disjoint sets
+func (uf *DenseUnionFind) Count() int {
+	return uf.count
+}
+
+// SetSize returns the number of elements in the set containing x
+func (uf *DenseUnionFind) SetSize(x int) int {
+	return int(uf.size[uf.Find(x)])
+}
+
+// ==================== ROLLBACK UNION-FIND ====================
+
+// unionRecord remembers what a single Union changed so it can be undone
+type unionRecord[K comparable] struct {
+	child    K
+	root     K
+	rankGrew bool
+	merged   bool
+}
+
+// RollbackDisjointSet is a Union-Find that can undo unions in LIFO order
+// It uses union by rank without path compression, so every Union touches
+// a constant number of entries and Find stays O(log n)
+// Use Snapshot and Rollback to tentatively apply unions and revert them
+type RollbackDisjointSet[K comparable] struct {
+	parent  map[K]K
+	rank    map[K]int
+	size    map[K]int
+	count   int
+	history []unionRecord[K]
+}
+
+// NewRollbackDisjointSet creates an empty RollbackDisjointSet
+func NewRollbackDisjointSet[K comparable]() *RollbackDisjointSet[K] {
+	return &RollbackDisjointSet[K]{
+		parent: make(map[K]K),
+		rank:   make(map[K]int),
+		size:   make(map[K]int),
+	}
+}
+
+// MakeSet creates a new set for a vertex
+// MakeSet is not recorded in the history and cannot be rolled back
+func (uf *RollbackDisjointSet[K]) MakeSet(x K) {
+	if _, exists := uf.parent[x]; !exists {
+		uf.parent[x] = x
+		uf.rank[x] = 0
+		uf.size[x] = 1
+		uf.count++
+	}
+}
+
+// Find finds the root vertex of a vertex without modifying the structure
+func (uf *RollbackDisjointSet[K]) Find(x K) K {
+	for {
+		p, exists := uf.parent[x]
+		if !exists || p == x {
+			return x
+		}
+		x = p
+	}
+}
+
+// Union merges two sets and reports whether they were separate
+// Every call is recorded, including ones that merge nothing, so that
+// Undo always reverts exactly the most recent Union
+func (uf *RollbackDisjointSet[K]) Union(x, y K) bool {
+	rootX := uf.Find(x)
+	rootY := uf.Find(y)
+
+	if rootX == rootY {
+		uf.history = append(uf.history, unionRecord[K]{})
+		return false
+	}
+
+	if uf.rank[rootX] < uf.rank[rootY] {
+		rootX, rootY = rootY, rootX
+	}
+	grew := uf.rank[rootX] == uf.rank[rootY]
+	if grew {
+		uf.rank[rootX]++
+	}
+	uf.parent[rootY] = rootX
+	uf.size[rootX] += uf.size[rootY]
+	uf.count--
+
+	uf.history = append(uf.history, unionRecord[K]{child: rootY, root: rootX, rankGrew: grew, merged: true})
+	return true
+}
+
+// Connected reports whether two vertices are in the same set
+func (uf *RollbackDisjointSet[K]) Connected(x, y K) bool {
+	return uf.Find(x) == uf.Find(y)
+}
+
+// Count returns the number of disjoint sets
+func (uf *RollbackDisjointSet[K]) Count() int {
+	return uf.count
+}
+
+// SetSize returns the number of elements in the set containing x
+func (uf *RollbackDisjointSet[K]) SetSize(x K) int {
+	return uf.size[uf.Find(x)]
+}
+
+// Snapshot returns a marker for the current state that can be passed to Rollback
+func (uf *RollbackDisjointSet[K]) Snapshot() int {
+	return len(uf.history)
+}
+
+// Undo reverts the most recent Union and reports whether there was one to revert
+func (uf *RollbackDisjointSet[K]) Undo() bool {
+	n := len(uf.history)
+	if n == 0 {
+		return false
+	}
+	rec := uf.history[n-1]
+	uf.history = uf.history[:n-1]
+
+	if rec.merged {
+		uf.parent[rec.child] = rec.child
+		uf.size[rec.root] -= uf.size[rec.child]
+		if rec.rankGrew {
+			uf.rank[rec.root]--
+		}
+		uf.count++
+	}
+	return true
+}
+
+// Rollback reverts every Union performed after the given snapshot
+func (uf *RollbackDisjointSet[K]) Rollback(snapshot int) {
+	for len(uf.history) > snapshot {
+		uf.Undo()
+	}
+}
+
+// ==================== AGGREGATE UNION-FIND ====================
+
+// AggregateDisjointSet is a Union-Find that keeps a value per set
+// When two sets are merged their values are combined with the merge
+// function, so callers can track totals, counts, or any custom reduction
+// while sets grow
+type AggregateDisjointSet[K comparable, A any] struct {
+	sets   *DisjointSet[K]
+	values map[K]A
+	merge  func(a, b A) A
+}
+
+// NewAggregateDisjointSet creates an empty set that combines values with merge
+func NewAggregateDisjointSet[K comparable, A any](merge func(a, b A) A) *AggregateDisjointSet[K, A] {
+	return &AggregateDisjointSet[K, A]{
+		sets:   NewDisjointSet[K](),
+		values: make(map[K]A),
+		merge:  merge,
+	}
+}
+
+// MakeSet creates a new set for x holding the initial value v
+func (uf *AggregateDisjointSet[K, A]) MakeSet(x K, v A) {
+	if _, exists := uf.sets.parent[x]; !exists {
+		uf.sets.MakeSet(x)
+		uf.values[x] = v
+	}
+}
+
+// Find finds the root of the set containing x
+func (uf *AggregateDisjointSet[K, A]) Find(x K) K {
+	return uf.sets.Find(x)
+}
+
+// Union merges two sets and their values, and reports whether they were separate
+// The merge function receives the value of x's set first
+func (uf *AggregateDisjointSet[K, A]) Union(x, y K) bool {
+	rootX := uf.sets.Find(x)
+	rootY := uf.sets.Find(y)
+	if !uf.sets.Union(rootX, rootY) {
+		return false
+	}
+
+	merged := uf.merge(uf.values[rootX], uf.values[rootY])
+	root := uf.sets.Find(rootX)
+	delete(uf.values, rootX)
+	delete(uf.values, rootY)
+	uf.values[root] = merged
+	return true
+}
+
+// Aggregate returns the value of the set containing x
+func (uf *AggregateDisjointSet[K, A]) Aggregate(x K) A {
+	return uf.values[uf.sets.Find(x)]
+}
+
+// Update replaces the value of the set containing x with fn applied to it
+func (uf *AggregateDisjointSet[K, A]) Update(x K, fn func(A) A) {
+	root := uf.sets.Find(x)
+	uf.values[root] = fn(uf.values[root])
+}
+
+// Connected reports whether two elements are in the same set
+func (uf *AggregateDisjointSet[K, A]) Connected(x, y K) bool {
+	return uf.sets.Connected(x, y)
+}
+
+// Count returns the number of disjoint sets
+func (uf *AggregateDisjointSet[K, A]) Count() int {
+	return uf.sets.Count()
+}
+
+// SetSize returns the number of elements in the set containing x
+func (uf *AggregateDisjointSet[K, A]) SetSize(x K) int {
+	return uf.sets.SetSize(x)
+}
+
+// ==================== CLUSTER TRACKING ====================
+
+// ClusterStats summarizes a cluster of vertices joined by tree edges
+type ClusterStats struct {
+	Root      int // representative vertex ID, may change as clusters merge
+	Size      int // number of vertices
+	EdgeCount int // number of tree edges inside the cluster
+	Weight    int // total weight of the tree edges inside the cluster
+}
+
+// mergeClusterStats combines the statistics of two clusters
+func mergeClusterStats(a, b ClusterStats) ClusterStats {
+	return ClusterStats{
+		Size:      a.Size + b.Size,
+		EdgeCount: a.EdgeCount + b.EdgeCount,
+		Weight:    a.Weight + b.Weight,
+	}
+}
+
+// newClusterTracker creates one singleton cluster per vertex of the graph
+func newClusterTracker(g *Graph) *AggregateDisjointSet[int, ClusterStats] {
+	clusters := NewAggregateDisjointSet[int](mergeClusterStats)
+	for id := range g.Vertices {
+		clusters.MakeSet(id, ClusterStats{Size: 1})
+	}
+	return clusters
+}
+
+// joinClusters merges the clusters at both ends of a tree edge and
+// returns the statistics of the combined cluster
+func joinClusters(clusters *AggregateDisjointSet[int, ClusterStats], edge *Edge) ClusterStats {
+	clusters.Union(edge.From.ID, edge.To.ID)
+	clusters.Update(edge.From.ID, func(c ClusterStats) ClusterStats {
+		c.EdgeCount++
+		c.Weight += edge.Weight
+		return c
+	})
+	stats := clusters.Aggregate(edge.From.ID)
+	stats.Root = clusters.Find(edge.From.ID)
+	return stats
+}
@@ -0,0 +1,262 @@
+package mst
+
+import (
+	"fmt"
+	"testing"
+)
+
+// TestUnionFind tests basic union and find operations
+func TestUnionFind(t *testing.T) {
+	fmt.Println("\n=== UNION-FIND TEST ===")
+
+	uf := NewUnionFind()
+	for i := 0; i < 6; i++ {
+		uf.MakeSet(i)
+	}
+
+	if !uf.Union(0, 1) || !uf.Union(1, 2) || !uf.Union(3, 4) {
+		t.Error("Expected unions of separate sets to succeed")
+	}
+	if uf.Union(0, 2) {
+		t.Error("Expected union within the same set to fail")
+	}
+	if uf.Find(0) != uf.Find(2) {
+		t.Error("Expected 0 and 2 to share a root")
+	}
+	if uf.Find(0) == uf.Find(3) {
+		t.Error("Expected 0 and 3 to have different roots")
+	}
+	if uf.Find(42) != 42 {
+		t.Errorf("Expected unknown element to be its own root, got %d", uf.Find(42))
+	}
+}
+
+// TestUnionFindCounts tests Connected, Count, and SetSize
+func TestUnionFindCounts(t *testing.T) {
+	uf := NewUnionFind()
+	for i := 0; i < 5; i++ {
+		uf.MakeSet(i)
+	}
+	uf.MakeSet(0) // duplicate must not change the count
+
+	if uf.Count() != 5 {
+		t.Errorf("Expected 5 sets, got %d", uf.Count())
+	}
+
+	uf.Union(0, 1)
+	uf.Union(2, 3)
+	uf.Union(1, 3)
+
+	if !uf.Connected(0, 2) {
+		t.Error("Expected 0 and 2 to be connected")
+	}
+	if uf.Connected(0, 4) {
+		t.Error("Expected 0 and 4 to be disconnected")
+	}
+	if uf.Count() != 2 {
+		t.Errorf("Expected 2 sets, got %d", uf.Count())
+	}
+	if uf.SetSize(3) != 4 {
+		t.Errorf("Expected set size 4, got %d", uf.SetSize(3))
+	}
+	if uf.SetSize(4) != 1 {
+		t.Errorf("Expected set size 1, got %d", uf.SetSize(4))
+	}
+}
+
+// TestDisjointSetStrings tests the generic structure with string keys
+func TestDisjointSetStrings(t *testing.T) {
+	ds := NewDisjointSet[string]()
+	for _, city := range []string{"Istanbul", "Ankara", "Izmir", "Bursa"} {
+		ds.MakeSet(city)
+	}
+
+	ds.Union("Istanbul", "Bursa")
+	ds.Union("Ankara", "Izmir")
+
+	if !ds.Connected("Bursa", "Istanbul") {
+		t.Error("Expected Bursa and Istanbul to be connected")
+	}
+	if ds.Connected("Bursa", "Izmir") {
+		t.Error("Expected Bursa and Izmir to be disconnected")
+	}
+	if ds.Count() != 2 {
+		t.Errorf("Expected 2 sets, got %d", ds.Count())
+	}
+}
+
+// TestDenseUnionFind tests the slice-backed structure
+func TestDenseUnionFind(t *testing.T) {
+	uf := NewDenseUnionFind(6)
+
+	uf.Union(0, 1)
+	uf.Union(1, 2)
+	uf.Union(4, 5)
+	if uf.Union(2, 0) {
+		t.Error("Expected union within the same set to fail")
+	}
+
+	if uf.Count() != 3 {
+		t.Errorf("Expected 3 sets, got %d", uf.Count())
+	}
+	if uf.SetSize(2) != 3 {
+		t.Errorf("Expected set size 3, got %d", uf.SetSize(2))
+	}
+	if !uf.Connected(4, 5) || uf.Connected(3, 4) {
+		t.Error("Unexpected connectivity in dense union-find")
+	}
+}
+
+// TestRollbackDisjointSet tests tentative unions and rollback
+func TestRollbackDisjointSet(t *testing.T) {
+	uf := NewRollbackDisjointSet[int]()
+	for i := 0; i < 5; i++ {
+		uf.MakeSet(i)
+	}
+	uf.Union(0, 1)
+
+	snap := uf.Snapshot()
+	uf.Union(1, 2)
+	uf.Union(3, 4)
+	uf.Union(0, 2) // no-op, but still recorded
+
+	if uf.Count() != 2 || !uf.Connected(0, 2) {
+		t.Errorf("Expected 2 sets with 0 and 2 connected, got %d sets", uf.Count())
+	}
+
+	// Undo only the no-op union
+	uf.Undo()
+	if uf.Count() != 2 {
+		t.Errorf("Expected no-op undo to keep 2 sets, got %d", uf.Count())
+	}
+
+	uf.Rollback(snap)
+	if uf.Count() != 4 {
+		t.Errorf("Expected 4 sets after rollback, got %d", uf.Count())
+	}
+	if uf.Connected(0, 2) || uf.Connected(3, 4) {
+		t.Error("Expected rolled back unions to be reverted")
+	}
+	if !uf.Connected(0, 1) || uf.SetSize(1) != 2 {
+		t.Error("Expected union before snapshot to be kept")
+	}
+	if uf.Undo() && uf.Count() != 5 {
+		t.Errorf("Expected 5 sets after undoing the first union, got %d", uf.Count())
+	}
+	if uf.Undo() {
+		t.Error("Expected Undo on an empty history to return false")
+	}
+}
+
+// TestAggregateDisjointSet tests that values merge on union
+func TestAggregateDisjointSet(t *testing.T) {
+	sum := func(a, b int) int { return a + b }
+	uf := NewAggregateDisjointSet[string](sum)
+	uf.MakeSet("a", 1)
+	uf.MakeSet("b", 2)
+	uf.MakeSet("c", 4)
+
+	uf.Union("a", "b")
+	if uf.Aggregate("b") != 3 {
+		t.Errorf("Expected aggregate 3, got %d", uf.Aggregate("b"))
+	}
+
+	uf.Update("a", func(v int) int { return v * 10 })
+	uf.Union("c", "a")
+	if uf.Aggregate("c") != 34 {
+		t.Errorf("Expected aggregate 34, got %d", uf.Aggregate("c"))
+	}
+	if uf.Union("a", "c") {
+		t.Errorf("Expected union within the same set to fail")
+	}
+	if uf.Count() != 1 || uf.SetSize("a") != 3 {
+		t.Errorf("Expected one set of size 3, got %d sets", uf.Count())
+	}
+}
+
+// TestKruskalMergeObserver tests cluster growth reporting during Kruskal
+func TestKruskalMergeObserver(t *testing.T) {
+	fmt.Println("\n=== KRUSKAL MERGE OBSERVER TEST ===")
+
+	g := buildCompleteGraph(8)
+
+	var last ClusterStats
+	merges := 0
+	mst, totalWeight := g.Kruskal(WithMergeObserver(func(edge *Edge, c ClusterStats) {
+		fmt.Printf("  +%s -> size %d, weight %d\n", edge, c.Size, c.Weight)
+		merges++
+		last = c
+	}))
+
+	if merges != len(mst) {
+		t.Errorf("Expected %d merges, got %d", len(mst), merges)
+	}
+	if last.Size != 8 || last.EdgeCount != 7 || last.Weight != totalWeight {
+		t.Errorf("Expected final cluster of 8 vertices and weight %d, got %+v", totalWeight, last)
+	}
+}
+
+// TestUnionFindLongChain tests Find on a degenerate chain without recursion
+func TestUnionFindLongChain(t *testing.T) {
+	const n = 1_000_000
+	uf := chainUnionFind(n)
+
+	if root := uf.Find(0); root != n-1 {
+		t.Errorf("Expected root %d, got %d", n-1, root)
+	}
+	// Path halving must have shortened the chain
+	if uf.parent[0] == 1 {
+		t.Error("Expected Find to compress the path from 0")
+	}
+}
+
+// chainUnionFind builds an adversarial chain 0 -> 1 -> ... -> n-1
+// bypassing union by rank to create the worst case for Find
+func chainUnionFind(n int) *UnionFind {
+	uf := &UnionFind{
+		parent: make(map[int]int, n),
+		rank:   make(map[int]int, n),
+		size:   map[int]int{n - 1: n},
+		count:  1,
+	}
+	for i := 0; i < n-1; i++ {
+		uf.parent[i] = i + 1
+	}
+	uf.parent[n-1] = n - 1
+	return uf
+}
+
+// findRecursive is the previous recursive Find with full path compression
+func findRecursive(uf *UnionFind, x int) int {
+	if uf.parent[x] != x {
+		uf.parent[x] = findRecursive(uf, uf.parent[x])
+	}
+	return uf.parent[x]
+}
+
+// benchmarkSetSize is the number of elements used by the Find benchmarks
+const benchmarkSetSize = 10_000_000
+
+// BenchmarkFindIterative benchmarks the iterative Find on a 10M-element chain
+func BenchmarkFindIterative(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		uf := chainUnionFind(benchmarkSetSize)
+		b.StartTimer()
+		for x := 0; x < benchmarkSetSize; x += 1000 {
+			uf.Find(x)
+		}
+	}
+}
+
+// BenchmarkFindRecursive benchmarks the recursive Find on a 10M-element chain
+func BenchmarkFindRecursive(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		uf := chainUnionFind(benchmarkSetSize)
+		b.StartTimer()
+		for x := 0; x < benchmarkSetSize; x += 1000 {
+			findRecursive(uf, x)
+		}
+	}
+}
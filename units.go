@@ -0,0 +1,63 @@
+package mst
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// ==================== WEIGHT UNITS ====================
+
+// WeightUnit describes what an integer weight measures, for display only
+// The zero value formats weights as bare integers
+type WeightUnit struct {
+	Label    string        // suffix such as "km" or "ms", used when Duration is zero
+	Duration time.Duration // if non-zero, each weight point is this long
+}
+
+// Unit returns a WeightUnit that appends label to every weight, e.g. "450 km"
+func Unit(label string) WeightUnit {
+	return WeightUnit{Label: label}
+}
+
+// DurationUnit returns a WeightUnit for weights counted in multiples of d,
+// formatted as a time.Duration, e.g. "12ms"
+func DurationUnit(d time.Duration) WeightUnit {
+	return WeightUnit{Duration: d}
+}
+
+// Format renders a weight in this unit
+func (u WeightUnit) Format(weight int64) string {
+	switch {
+	case u.Duration != 0:
+		return (time.Duration(weight) * u.Duration).String()
+	case u.Label != "":
+		return strconv.FormatInt(weight, 10) + " " + u.Label
+	default:
+		return strconv.FormatInt(weight, 10)
+	}
+}
+
+// Weight converts a duration into an integer weight of this unit, rounding to
+// the nearest unit; it is meant for building edges from measured latencies
+func (u WeightUnit) Weight(d time.Duration) int {
+	if u.Duration == 0 {
+		return int(d)
+	}
+	return int(d.Round(u.Duration) / u.Duration)
+}
+
+// String formats the result as one line, e.g. "kruskal: 2 edges, total 780 km"
+func (r MSTResult) String() string {
+	return fmt.Sprintf("%s: %d edges, total %s", r.Algorithm, len(r.Edges), r.Unit.Format(r.TotalWeight))
+}
+
+// Print prints the result like PrintMST, formatting weights in its unit
+func (r MSTResult) Print() {
+	printMST(r.Edges, r.Unit.Format(r.TotalWeight), r.Algorithm, r.Unit)
+}
+
+// PrintMSTIn prints the MST like PrintMST, formatting every weight in unit
+func PrintMSTIn(mst []*Edge, totalWeight int, algorithmName string, unit WeightUnit) {
+	printMST(mst, unit.Format(int64(totalWeight)), algorithmName, unit)
+}
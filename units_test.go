@@ -0,0 +1,46 @@
+package mst
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+// TestWeightUnit tests unit-aware weight formatting
+func TestWeightUnit(t *testing.T) {
+	fmt.Println("\n=== WEIGHT UNIT TEST ===")
+
+	tests := []struct {
+		unit   WeightUnit
+		weight int64
+		want   string
+	}{
+		{WeightUnit{}, 450, "450"},
+		{Unit("km"), 450, "450 km"},
+		{DurationUnit(time.Millisecond), 12, "12ms"},
+		{DurationUnit(time.Microsecond), 1500, "1.5ms"},
+	}
+	for _, tt := range tests {
+		if got := tt.unit.Format(tt.weight); got != tt.want {
+			t.Errorf("Format(%d) = %q, want %q", tt.weight, got, tt.want)
+		}
+	}
+
+	ms := DurationUnit(time.Millisecond)
+	if w := ms.Weight(12400 * time.Microsecond); w != 12 {
+		t.Errorf("Expected 12.4ms to round to 12, got %d", w)
+	}
+
+	g, err := NewBuilder(WithWeightUnit(ms)).
+		AddEdge(0, 1, ms.Weight(12*time.Millisecond), nil).
+		AddEdge(1, 2, ms.Weight(30*time.Millisecond), nil).
+		Build()
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+	res, _ := g.KruskalResult()
+	res.Print()
+	if got := res.String(); got != "kruskal: 2 edges, total 42ms" {
+		t.Errorf("Unexpected result string %q", got)
+	}
+}
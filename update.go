@@ -0,0 +1,121 @@
+package mst
+
+// ==================== INCREMENTAL MST UPDATE ====================
+
+// UpdateMST repairs an MST after the weight of a single edge has changed
+// prev must be an MST (or spanning forest) of the graph before the change
+// and changed must already carry its new weight, for example after
+// SetEdgeWeight. Instead of a full recompute it either swaps the changed
+// edge in for the heaviest edge on its tree cycle (O(V)) or, when a tree
+// edge got heavier, reconnects the two halves with the cheapest crossing
+// edge (O(V + E))
+func (g *Graph) UpdateMST(prev []*Edge, changed *Edge) ([]*Edge, int) {
+	mst := make([]*Edge, len(prev))
+	copy(mst, prev)
+
+	if changed.From.ID == changed.To.ID {
+		return mst, GetMSTWeight(mst)
+	}
+
+	treeIndex := -1
+	for i, e := range mst {
+		if e == changed || (changed.twin != nil && e == changed.twin) {
+			treeIndex = i
+			break
+		}
+	}
+
+	if treeIndex >= 0 {
+		mst[treeIndex] = g.cheapestReconnection(mst, treeIndex)
+		return mst, GetMSTWeight(mst)
+	}
+
+	// A non-tree edge only matters if it is now lighter than the heaviest
+	// edge on the tree path between its endpoints
+	rf := rootForest(mst, changed.From.ID)
+	u, v := changed.From.ID, changed.To.ID
+	if !rf.contains(u) || !rf.contains(v) || rf.rootOf(u) != rf.rootOf(v) {
+		// The edge joins two trees of a forest
+		mst = append(mst, changed)
+		return mst, GetMSTWeight(mst)
+	}
+	heaviest := rf.maxEdgeOnPath(u, v)
+	if heaviest.Weight > changed.Weight {
+		for i, e := range mst {
+			if e == heaviest {
+				mst[i] = changed
+				break
+			}
+		}
+	}
+	return mst, GetMSTWeight(mst)
+}
+
+// cheapestReconnection removes the tree edge at index i and returns the
+// cheapest graph edge that reconnects the two resulting components
+// The removed edge itself is a candidate, so it is returned if nothing is cheaper
+func (g *Graph) cheapestReconnection(mst []*Edge, i int) *Edge {
+	removed := mst[i]
+
+	adj := make(map[int][]int)
+	for j, e := range mst {
+		if j == i {
+			continue
+		}
+		adj[e.From.ID] = append(adj[e.From.ID], e.To.ID)
+		adj[e.To.ID] = append(adj[e.To.ID], e.From.ID)
+	}
+
+	// Mark the side of the cut containing the removed edge's From vertex
+	side := map[int]bool{removed.From.ID: true}
+	stack := []int{removed.From.ID}
+	for len(stack) > 0 {
+		v := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, w := range adj[v] {
+			if !side[w] {
+				side[w] = true
+				stack = append(stack, w)
+			}
+		}
+	}
+
+	best := removed
+	for _, e := range g.Edges {
+		if side[e.From.ID] != side[e.To.ID] && e.Weight < best.Weight {
+			best = e
+		}
+	}
+	return best
+}
+
+// contains reports whether a vertex is part of the forest
+func (rf *rootedForest) contains(v int) bool {
+	_, exists := rf.parent[v]
+	return exists
+}
+
+// rootOf returns the root of the tree containing v
+func (rf *rootedForest) rootOf(v int) int {
+	for rf.parent[v] != v {
+		v = rf.parent[v]
+	}
+	return v
+}
+
+// maxEdgeOnPath walks the tree path between u and v and returns its heaviest edge
+// Both vertices must be in the same tree
+func (rf *rootedForest) maxEdgeOnPath(u, v int) *Edge {
+	var heaviest *Edge
+	for u != v {
+		if rf.depth[u] < rf.depth[v] {
+			u, v = v, u
+		}
+		e := rf.parentEdge[u]
+		if heaviest == nil || e.Weight > heaviest.Weight {
+			heaviest = e
+		}
+		u = rf.parent[u]
+	}
+	return heaviest
+}
@@ -0,0 +1,44 @@
+package mst
+
+import (
+	"fmt"
+	"testing"
+)
+
+// TestUpdateMST tests incremental repair against a full recompute
+func TestUpdateMST(t *testing.T) {
+	fmt.Println("\n=== INCREMENTAL MST UPDATE TEST ===")
+
+	g := buildCompleteGraph(12)
+	mst, _ := g.Kruskal()
+
+	// Apply a sequence of weight changes covering every case
+	changes := []struct{ index, weight int }{
+		{0, 500}, {5, 0}, {17, 1}, {30, 200}, {3, -5}, {40, 90},
+	}
+	for _, c := range changes {
+		edge := g.Edges[c.index]
+		g.SetEdgeWeight(edge, c.weight)
+
+		var weight int
+		mst, weight = g.UpdateMST(mst, edge)
+		_, expected := g.Kruskal()
+		fmt.Printf("  edge %s -> MST weight %d\n", edge, weight)
+
+		if weight != expected {
+			t.Errorf("After changing %s: expected MST weight %d, got %d", edge, expected, weight)
+		}
+		if len(mst) != g.VertexCount()-1 {
+			t.Errorf("Expected %d edges, got %d", g.VertexCount()-1, len(mst))
+		}
+	}
+
+	// The Prim result uses reverse adjacency copies, which must be recognized too
+	primMST, _ := g.Prim(0)
+	edge := primMST[0]
+	g.SetEdgeWeight(edge, 1000)
+	_, weight := g.UpdateMST(primMST, edge.twin)
+	if _, expected := g.Kruskal(); weight != expected {
+		t.Errorf("Expected MST weight %d after updating a Prim tree, got %d", expected, weight)
+	}
+}
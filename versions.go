@@ -0,0 +1,383 @@
+package mst
+
+import (
+	"iter"
+	"maps"
+	"math/bits"
+)
+
+// ==================== COPY-ON-WRITE VERSIONS ====================
+
+// GraphVersion is an immutable snapshot of a graph taken by Graph.Snapshot
+// Versions are persistent tries that share every unchanged node with the
+// versions before them, so a new version costs memory proportional to the
+// changes since the previous one rather than to the graph size. Vertex and
+// edge Attrs and edge Weights are copied, while Data is shared with the graph
+type GraphVersion struct {
+	seq      int
+	directed bool
+	unit     WeightUnit
+	vertices pmap[Vertex]
+	edges    pmap[versionEdge]
+}
+
+// versionEdge is an edge as stored in a GraphVersion
+type versionEdge struct {
+	from, to, weight int
+	data             any
+	weights          map[string]int
+	attrs            Attributes
+}
+
+// versionTracker turns recorded mutations into the next GraphVersion
+type versionTracker struct {
+	latest GraphVersion
+	ids    map[*Edge]uint64 // live edges of g.Edges and their slot in latest.edges
+	nextID uint64
+	stale  bool // the graph changed without notifications and must be rescanned
+
+	dirtyEdges    map[*Edge]bool
+	removedEdges  []uint64
+	dirtyVertices map[int]bool
+}
+
+// OnMutation records which vertices and edges the next version must update
+func (vt *versionTracker) OnMutation(m Mutation) {
+	switch m.Kind {
+	case MutationVertexAdded, MutationVertexRemoved, MutationVertexWeightChanged, MutationVertexDataChanged, MutationVertexNameChanged, MutationVertexAttrChanged:
+		vt.dirtyVertices[m.Vertex.ID] = true
+	case MutationEdgeAdded:
+		// Slots are taken in insertion order, which Edges preserves
+		vt.ids[m.Edge] = vt.nextID
+		vt.nextID++
+		vt.dirtyEdges[m.Edge] = true
+	case MutationEdgeWeightChanged, MutationEdgeCriterionChanged, MutationEdgeAttrChanged:
+		edge := m.Edge
+		if _, live := vt.ids[edge]; !live && edge.twin != nil {
+			edge = edge.twin
+		}
+		vt.dirtyEdges[edge] = true
+	case MutationEdgeRemoved:
+		edge := m.Edge
+		if _, live := vt.ids[edge]; !live && edge.twin != nil {
+			edge = edge.twin
+		}
+		if id, live := vt.ids[edge]; live {
+			vt.removedEdges = append(vt.removedEdges, id)
+			delete(vt.ids, edge)
+		}
+		delete(vt.dirtyEdges, edge)
+	case MutationGraphCleared:
+		// The edges may live in a reset arena, so forget them and rescan
+		clear(vt.ids)
+		vt.reset()
+		vt.stale = true
+	}
+}
+
+// Snapshot returns an immutable version of the graph's current state
+// The first call records the whole graph; later calls only apply the
+// changes made since the previous call, sharing everything else with it,
+// so hundreds of versions of a large graph fit in memory. Changes must be
+// made through the Graph methods; direct writes to g.Vertices or g.Edges
+// are only picked up after Clear
+func (g *Graph) Snapshot() GraphVersion {
+	vt := g.versions
+	if vt == nil {
+		vt = &versionTracker{}
+		g.versions = vt
+		g.Observe(vt)
+		vt.stale = true
+	}
+	edit := new(editToken)
+
+	if vt.stale {
+		next := GraphVersion{seq: vt.latest.seq + 1, directed: g.Directed, unit: g.Unit}
+		vt.ids = make(map[*Edge]uint64, len(g.Edges))
+		vt.nextID = 0
+		for id, v := range g.Vertices {
+			next.vertices = next.vertices.set(vertexKey(id), versionVertexOf(v), edit)
+		}
+		for _, e := range g.Edges {
+			vt.ids[e] = vt.nextID
+			next.edges = next.edges.set(vt.nextID, versionEdgeOf(e), edit)
+			vt.nextID++
+		}
+		vt.latest, vt.stale = next, false
+		vt.reset()
+		return next
+	}
+
+	next := vt.latest
+	next.seq++
+	next.unit = g.Unit
+	for _, id := range vt.removedEdges {
+		next.edges = next.edges.delete(id, edit)
+	}
+	for e := range vt.dirtyEdges {
+		if id, live := vt.ids[e]; live {
+			next.edges = next.edges.set(id, versionEdgeOf(e), edit)
+		}
+	}
+	for id := range vt.dirtyVertices {
+		if v, exists := g.Vertices[id]; exists {
+			next.vertices = next.vertices.set(vertexKey(id), versionVertexOf(v), edit)
+		} else {
+			next.vertices = next.vertices.delete(vertexKey(id), edit)
+		}
+	}
+	vt.latest = next
+	vt.reset()
+	return next
+}
+
+// reset forgets the recorded changes after a version was taken
+func (vt *versionTracker) reset() {
+	vt.dirtyEdges = make(map[*Edge]bool)
+	vt.removedEdges = vt.removedEdges[:0]
+	vt.dirtyVertices = make(map[int]bool)
+}
+
+// versionVertexOf copies a vertex for a GraphVersion, without its adjacency list
+func versionVertexOf(v Vertex) Vertex {
+	v.Edges = nil
+	v.Attrs = maps.Clone(v.Attrs)
+	return v
+}
+
+// versionEdgeOf copies an edge for a GraphVersion
+func versionEdgeOf(e *Edge) versionEdge {
+	return versionEdge{
+		from:    e.From.ID,
+		to:      e.To.ID,
+		weight:  e.Weight,
+		data:    e.Data,
+		weights: maps.Clone(e.Weights),
+		attrs:   maps.Clone(e.Attrs),
+	}
+}
+
+// vertexKey maps a vertex ID to a trie key, keeping small negative IDs small
+func vertexKey(id int) uint64 {
+	return uint64(id<<1) ^ uint64(id>>(bits.UintSize-1))
+}
+
+// Seq returns the sequence number of the version, counting from 1
+func (v GraphVersion) Seq() int {
+	return v.seq
+}
+
+// VertexCount returns the number of vertices in the version
+func (v GraphVersion) VertexCount() int {
+	return v.vertices.size
+}
+
+// EdgeCount returns the number of edges in the version
+func (v GraphVersion) EdgeCount() int {
+	return v.edges.size
+}
+
+// Vertex returns the vertex with the given ID, without its adjacency list
+// Its Attrs are a copy, so changing them leaves the version intact
+func (v GraphVersion) Vertex(id int) (Vertex, bool) {
+	vertex, ok := v.vertices.get(vertexKey(id))
+	vertex.Attrs = maps.Clone(vertex.Attrs)
+	return vertex, ok
+}
+
+// Edges yields the edges of the version in the order they were added
+func (v GraphVersion) Edges() iter.Seq[EdgeRecord] {
+	return func(yield func(EdgeRecord) bool) {
+		v.edges.each(func(_ uint64, e versionEdge) bool {
+			return yield(EdgeRecord{From: e.from, To: e.to, Weight: e.weight})
+		})
+	}
+}
+
+// Graph materializes the version as an ordinary mutable graph
+func (v GraphVersion) Graph() Graph {
+	g := NewGraph(v.directed)
+	g.Unit = v.unit
+	vertices := make(map[int]*Vertex, v.vertices.size)
+	v.vertices.each(func(_ uint64, vertex Vertex) bool {
+		vertices[vertex.ID] = g.AddVertex(vertex)
+		return true
+	})
+	edges := make([]Edge, 0, v.edges.size)
+	v.edges.each(func(_ uint64, e versionEdge) bool {
+		edges = append(edges, Edge{From: vertices[e.from], To: vertices[e.to], Weight: e.weight, Data: e.data, Weights: e.weights, Attrs: e.attrs})
+		return true
+	})
+	g.AddEdges(edges)
+	return g
+}
+
+// Kruskal computes a minimum spanning forest of the version without
+// materializing it as a Graph, returning its edges and total weight
+func (v GraphVersion) Kruskal() ([]EdgeRecord, int) {
+	if v.directed {
+		panic("Kruskal algorithm only works for undirected graphs")
+	}
+	edges := make([]EdgeRecord, 0, v.edges.size)
+	for e := range v.Edges() {
+		edges = append(edges, e)
+	}
+	sortRecords(edges)
+
+	uf := NewUnionFind()
+	v.vertices.each(func(_ uint64, vertex Vertex) bool {
+		uf.MakeSet(vertex.ID)
+		return true
+	})
+	forest := make([]EdgeRecord, 0)
+	total := 0
+	for _, e := range edges {
+		if uf.Union(e.From, e.To) {
+			forest = append(forest, e)
+			total += e.Weight
+		}
+	}
+	return forest, total
+}
+
+// ==================== PERSISTENT TRIE ====================
+
+// editToken marks the trie nodes created while building one version, which
+// may be updated in place until the version is returned
+type editToken struct {
+	_ byte // zero-size values may share an address
+}
+
+// pnode is a node of a persistent 32-way trie; leaves hold values
+type pnode[V any] struct {
+	edit *editToken
+	kids *[32]*pnode[V] // interior nodes only
+	vals *[32]V         // leaves only
+	has  uint32         // leaves: which vals are set
+}
+
+// pmap is a persistent map from uint64 keys to values
+// A trie of height h holds keys below 32^h and updates copy at most h nodes
+type pmap[V any] struct {
+	root   *pnode[V]
+	height int
+	size   int
+}
+
+// fits reports whether a trie of the given height can hold key
+func fits(key uint64, height int) bool {
+	return 5*height >= 64 || key < 1<<(5*height)
+}
+
+func (m pmap[V]) get(key uint64) (V, bool) {
+	var zero V
+	if m.root == nil || !fits(key, m.height) {
+		return zero, false
+	}
+	n := m.root
+	for h := m.height; h > 1; h-- {
+		if n = n.kids[key>>(5*(h-1))&31]; n == nil {
+			return zero, false
+		}
+	}
+	i := key & 31
+	return n.vals[i], n.has>>i&1 == 1
+}
+
+// own returns n itself if it belongs to edit, otherwise a copy that does
+func (n *pnode[V]) own(edit *editToken) *pnode[V] {
+	if n.edit == edit {
+		return n
+	}
+	c := &pnode[V]{edit: edit, has: n.has}
+	if n.kids != nil {
+		kids := *n.kids
+		c.kids = &kids
+	}
+	if n.vals != nil {
+		vals := *n.vals
+		c.vals = &vals
+	}
+	return c
+}
+
+func newPnode[V any](leaf bool, edit *editToken) *pnode[V] {
+	if leaf {
+		return &pnode[V]{edit: edit, vals: new([32]V)}
+	}
+	return &pnode[V]{edit: edit, kids: new([32]*pnode[V])}
+}
+
+func (m pmap[V]) set(key uint64, v V, edit *editToken) pmap[V] {
+	if m.root == nil {
+		m.root, m.height = newPnode[V](true, edit), 1
+	}
+	for !fits(key, m.height) {
+		root := newPnode[V](false, edit)
+		root.kids[0] = m.root
+		m.root = root
+		m.height++
+	}
+
+	m.root = m.root.own(edit)
+	n := m.root
+	for h := m.height; h > 1; h-- {
+		slot := &n.kids[key>>(5*(h-1))&31]
+		if *slot == nil {
+			*slot = newPnode[V](h == 2, edit)
+		} else {
+			*slot = (*slot).own(edit)
+		}
+		n = *slot
+	}
+	i := key & 31
+	if n.has>>i&1 == 0 {
+		m.size++
+	}
+	n.vals[i] = v
+	n.has |= 1 << i
+	return m
+}
+
+func (m pmap[V]) delete(key uint64, edit *editToken) pmap[V] {
+	if _, exists := m.get(key); !exists {
+		return m
+	}
+	m.root = m.root.own(edit)
+	n := m.root
+	for h := m.height; h > 1; h-- {
+		slot := &n.kids[key>>(5*(h-1))&31]
+		*slot = (*slot).own(edit)
+		n = *slot
+	}
+	i := key & 31
+	var zero V
+	n.vals[i] = zero
+	n.has &^= 1 << i
+	m.size--
+	return m
+}
+
+// each calls yield for every entry in ascending key order until it returns false
+func (m pmap[V]) each(yield func(key uint64, v V) bool) {
+	if m.root != nil {
+		m.root.each(m.height, 0, yield)
+	}
+}
+
+func (n *pnode[V]) each(h int, prefix uint64, yield func(key uint64, v V) bool) bool {
+	if h == 1 {
+		for i := range 32 {
+			if n.has>>i&1 == 1 && !yield(prefix<<5|uint64(i), n.vals[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	for i, kid := range n.kids {
+		if kid != nil && !kid.each(h-1, prefix<<5|uint64(i), yield) {
+			return false
+		}
+	}
+	return true
+}
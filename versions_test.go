@@ -0,0 +1,158 @@
+package mst
+
+import (
+	"fmt"
+	"testing"
+)
+
+// TestGraphVersions tests copy-on-write snapshots of a changing graph
+func TestGraphVersions(t *testing.T) {
+	fmt.Println("\n=== GRAPH VERSION TEST ===")
+
+	g := buildCompleteGraph(8)
+	v1 := g.Snapshot()
+	_, weight1 := g.Kruskal()
+
+	// Mutate the graph in every way a version has to track
+	g.AddEdge(Edge{From: &Vertex{ID: 0}, To: &Vertex{ID: 1}, Weight: 0})
+	g.RemoveEdge(g.Edges[3])
+	g.SetEdgeWeight(g.Edges[5].twin, 1000)
+	g.AddVertex(Vertex{ID: 8, Name: "I"})
+	g.AddEdge(Edge{From: &Vertex{ID: 7}, To: &Vertex{ID: 8}, Weight: 4})
+	g.RemoveVertex(2)
+	v2 := g.Snapshot()
+
+	if v1.Seq() != 1 || v2.Seq() != 2 {
+		t.Errorf("Expected sequence numbers 1 and 2, got %d and %d", v1.Seq(), v2.Seq())
+	}
+	if v1.VertexCount() != 8 || v1.EdgeCount() != 28 {
+		t.Errorf("Expected the first version unchanged, got %d vertices and %d edges", v1.VertexCount(), v1.EdgeCount())
+	}
+	if _, total := v1.Kruskal(); total != weight1 {
+		t.Errorf("Expected the first version's MST weight %d, got %d", weight1, total)
+	}
+	if v2.VertexCount() != g.VertexCount() || v2.EdgeCount() != g.EdgeCount() {
+		t.Errorf("Expected the second version to match the graph, got %d vertices and %d edges",
+			v2.VertexCount(), v2.EdgeCount())
+	}
+	if v, ok := v2.Vertex(8); !ok || v.Name != "I" {
+		t.Error("Expected the added vertex in the second version")
+	}
+	if _, ok := v2.Vertex(2); ok {
+		t.Error("Expected the removed vertex to be absent from the second version")
+	}
+	if _, ok := v1.Vertex(2); !ok {
+		t.Error("Expected the removed vertex to remain in the first version")
+	}
+
+	forest, total := v2.Kruskal()
+	_, want := g.Kruskal()
+	fmt.Printf("Version 2 MST: %d edges, weight %d\n", len(forest), total)
+	if total != want {
+		t.Errorf("Expected the second version's MST weight %d, got %d", want, total)
+	}
+	materialized := v2.Graph()
+	if materialized.Hash() != g.Hash() {
+		t.Error("Expected the materialized version to hash like the graph")
+	}
+
+	// Edges keep insertion order, with new edges at the end
+	var last EdgeRecord
+	for e := range v2.Edges() {
+		last = e
+	}
+	if last != (EdgeRecord{From: 7, To: 8, Weight: 4}) {
+		t.Errorf("Expected the newest edge last, got %+v", last)
+	}
+
+	// Clear marks the tracker stale, so the next version rescans
+	g.Clear()
+	g.AddEdge(Edge{From: &Vertex{ID: 0}, To: &Vertex{ID: 1}, Weight: 3})
+	v3 := g.Snapshot()
+	if v3.VertexCount() != 2 || v3.EdgeCount() != 1 {
+		t.Errorf("Expected a rescan after Clear, got %d vertices and %d edges", v3.VertexCount(), v3.EdgeCount())
+	}
+	if v2.EdgeCount() != materialized.EdgeCount() {
+		t.Error("Expected Clear to leave earlier versions intact")
+	}
+}
+
+// TestGraphVersionSharing tests that versions share unchanged trie nodes
+func TestGraphVersionSharing(t *testing.T) {
+	fmt.Println("\n=== GRAPH VERSION SHARING TEST ===")
+
+	g := buildCompleteGraph(40)
+	versions := []GraphVersion{g.Snapshot()}
+	for i := range 200 {
+		g.SetEdgeWeight(g.Edges[i*7%len(g.Edges)], 1+i%50)
+		versions = append(versions, g.Snapshot())
+	}
+
+	// A single-edge change copies one root-to-leaf path and nothing more
+	first, second := versions[0].edges, versions[1].edges
+	shared := 0
+	for i := range first.root.kids {
+		if first.root.kids[i] != nil && first.root.kids[i] == second.root.kids[i] {
+			shared++
+		}
+	}
+	fmt.Printf("Root children shared between versions 1 and 2: %d\n", shared)
+	if first.root == second.root || shared == 0 {
+		t.Error("Expected consecutive versions to share unchanged subtries")
+	}
+
+	_, want := g.Kruskal()
+	if _, total := versions[len(versions)-1].Kruskal(); total != want {
+		t.Errorf("Expected the latest version's MST weight %d, got %d", want, total)
+	}
+	original := buildCompleteGraph(40)
+	_, want = original.Kruskal()
+	if _, total := versions[0].Kruskal(); total != want {
+		t.Errorf("Expected the first version to keep the original weights, got %d", total)
+	}
+}
+
+// TestGraphVersionMaps tests that versions keep edge Weights and copy
+// Attrs, so later changes to the graph leave them intact
+func TestGraphVersionMaps(t *testing.T) {
+	fmt.Println("\n=== GRAPH VERSION MAPS TEST ===")
+
+	g := NewGraph(false)
+	edge := g.AddEdge(Edge{
+		From:    &Vertex{ID: 0, Attrs: Attributes{"city": "Baku"}},
+		To:      &Vertex{ID: 1},
+		Weight:  2,
+		Weights: map[string]int{"latency": 5},
+		Attrs:   Attributes{"cable": "fiber"},
+	})
+	v1 := g.Snapshot()
+
+	g.SetEdgeWeightBy(edge, "latency", 9)
+	g.SetEdgeAttr(edge, "cable", "copper")
+	g.SetVertexAttr(0, "city", "Ganja")
+	v2 := g.Snapshot()
+
+	check := func(name string, v GraphVersion, latency int, cable, city string) {
+		vg := v.Graph()
+		e := vg.Edges[0]
+		if e.Weights["latency"] != latency {
+			t.Errorf("%s: expected latency %d, got %d", name, latency, e.Weights["latency"])
+		}
+		if s, _ := e.GetString("cable"); s != cable {
+			t.Errorf("%s: expected cable %q, got %q", name, cable, s)
+		}
+		vertex, _ := v.Vertex(0)
+		if s, _ := vertex.Attrs.GetString("city"); s != city {
+			t.Errorf("%s: expected city %q, got %q", name, city, s)
+		}
+	}
+	check("version 1", v1, 5, "fiber", "Baku")
+	check("version 2", v2, 9, "copper", "Ganja")
+
+	// Maps handed out by a version are copies too
+	vertex, _ := v2.Vertex(0)
+	vertex.SetAttr("city", "Sheki")
+	vg := v2.Graph()
+	vg.Edges[0].Weights["latency"] = 1
+	check("version 2 after edits to its copies", v2, 9, "copper", "Ganja")
+}